@@ -65,6 +65,10 @@ func (o *Options) run(ctx context.Context) {
 		controllercmd.LogErrAndExit(err, "Could not update manager scheme")
 	}
 
+	if err := extensionscontroller.AddClusterCacheToManager(mgr); err != nil {
+		controllercmd.LogErrAndExit(err, "Could not add cluster cache to manager")
+	}
+
 	ctrlConfig := o.certOptions.Completed()
 
 	ctrlConfig.Apply(&lifecycle.ServiceConfig)
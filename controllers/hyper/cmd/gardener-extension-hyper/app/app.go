@@ -4,6 +4,7 @@ import (
 	"context"
 
 	certservice "github.com/gardener/gardener-extensions/controllers/extension-certificate-service/cmd/app"
+	networkingcalico "github.com/gardener/gardener-extensions/controllers/networking-calico/cmd/gardener-extension-networking-calico/app"
 	coreosalicloud "github.com/gardener/gardener-extensions/controllers/os-coreos-alicloud/cmd/gardener-extension-os-coreos-alicloud/app"
 	coreos "github.com/gardener/gardener-extensions/controllers/os-coreos/cmd/gardener-extension-os-coreos/app"
 	jeos "github.com/gardener/gardener-extensions/controllers/os-suse-jeos/cmd/gardener-extension-os-suse-jeos/app"
@@ -32,6 +33,7 @@ func NewHyperCommand(ctx context.Context) *cobra.Command {
 		provideropenstack.NewControllerManagerCommand(ctx),
 		provideralicloud.NewControllerManagerCommand(ctx),
 		providerpacket.NewControllerManagerCommand(ctx),
+		networkingcalico.NewControllerManagerCommand(ctx),
 		certservice.NewServiceControllerCommand(ctx),
 	)
 
@@ -0,0 +1,65 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calico
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Backend defines whether a backend should be used or not (e.g., bird or none)
+type Backend string
+
+const (
+	// Bird is the default Calico backend.
+	Bird Backend = "bird"
+	// None disables the Calico backend, e.g. if the routes are managed by the cloud provider.
+	None Backend = "none"
+)
+
+// IPAMType defines the IP address management plugin used by Calico.
+type IPAMType string
+
+const (
+	// IPAMCalico is the Calico IPAM plugin.
+	IPAMCalico IPAMType = "calico-ipam"
+	// IPAMHostLocal is the host-local IPAM plugin.
+	IPAMHostLocal IPAMType = "host-local"
+)
+
+// CIDR defines a subnet in CIDR notation, or "usePodCIDR" to use the node's pod CIDR.
+type CIDR string
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NetworkConfig configuration for the calico networking plugin
+type NetworkConfig struct {
+	metav1.TypeMeta
+
+	// Backend defines whether a backend should be used or not (e.g., bird or none)
+	// +optional
+	Backend *Backend
+	// IPAM to use for the Calico Plugin (e.g., host-local or Calico)
+	// +optional
+	IPAM *IPAM
+}
+
+// IPAM defines the block that configures IPAM used by the Calico plugin.
+type IPAM struct {
+	// Type defines the IPAM plugin type
+	Type IPAMType
+	// CIDR defines the CIDR block to be used
+	// +optional
+	CIDR *CIDR
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calico
+
+import "path/filepath"
+
+const (
+	// Name is the name of the Calico networking controller.
+	Name = "networking-calico"
+	// Type is the type of Network resources the Calico actuator / predicate are built for.
+	Type = "calico"
+
+	// CNINodeImageName is the name of the calico-node image.
+	CNINodeImageName = "calico-node"
+	// CNIPluginImageName is the name of the calico-cni image.
+	CNIPluginImageName = "calico-cni"
+	// KubeControllersImageName is the name of the calico-kube-controllers image.
+	KubeControllersImageName = "calico-kube-controllers"
+
+	// ReleaseName is the name of the Calico release.
+	ReleaseName = "calico"
+	// ManagedResourceName is the name of the managed resource (and its secret) containing the rendered Calico chart.
+	ManagedResourceName = "extension-networking-calico-config"
+)
+
+var (
+	// ChartsPath is the path to the charts
+	ChartsPath = filepath.Join("controllers", Name, "charts")
+	// InternalChartsPath is the path to the internal charts
+	InternalChartsPath = filepath.Join(ChartsPath, "internal")
+	// CalicoChartPath is the path for the internal Calico chart.
+	CalicoChartPath = filepath.Join(InternalChartsPath, "calico")
+)
@@ -0,0 +1,64 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package charts
+
+import (
+	calicoapi "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	defaultBackend  = calicoapi.Bird
+	defaultIPAMType = calicoapi.IPAMHostLocal
+	// defaultIPAMSubnet instructs the host-local IPAM plugin to use the pod CIDR assigned to the node.
+	defaultIPAMSubnet = "usePodCidr"
+)
+
+// ComputeCalicoChartValues computes the values for the calico chart.
+func ComputeCalicoChartValues(network *extensionsv1alpha1.Network, config *calicoapi.NetworkConfig) map[string]interface{} {
+	var (
+		backend    = defaultBackend
+		ipamType   = defaultIPAMType
+		ipamSubnet = defaultIPAMSubnet
+	)
+
+	if config != nil {
+		if config.Backend != nil {
+			backend = *config.Backend
+		}
+		if config.IPAM != nil {
+			if len(config.IPAM.Type) > 0 {
+				ipamType = config.IPAM.Type
+			}
+			if config.IPAM.CIDR != nil {
+				ipamSubnet = string(*config.IPAM.CIDR)
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"global": map[string]interface{}{
+			"podCIDR": network.Spec.ClusterCIDR,
+		},
+		"config": map[string]interface{}{
+			"backend": string(backend),
+			"ipam": map[string]interface{}{
+				"type":   string(ipamType),
+				"subnet": ipamSubnet,
+			},
+		},
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package charts_test
+
+import (
+	calicoapi "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico"
+	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/charts"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Chart package test", func() {
+	var network *extensionsv1alpha1.Network
+
+	BeforeEach(func() {
+		network = &extensionsv1alpha1.Network{
+			Spec: extensionsv1alpha1.NetworkSpec{
+				ClusterCIDR: "100.96.0.0/11",
+			},
+		}
+	})
+
+	Describe("#ComputeCalicoChartValues", func() {
+		It("should use the defaults if no network config is given", func() {
+			values := charts.ComputeCalicoChartValues(network, nil)
+
+			Expect(values).To(Equal(map[string]interface{}{
+				"global": map[string]interface{}{
+					"podCIDR": "100.96.0.0/11",
+				},
+				"config": map[string]interface{}{
+					"backend": "bird",
+					"ipam": map[string]interface{}{
+						"type":   "host-local",
+						"subnet": "usePodCidr",
+					},
+				},
+			}))
+		})
+
+		It("should use the values of the network config", func() {
+			var (
+				backend = calicoapi.None
+				cidr    = calicoapi.CIDR("10.0.0.0/8")
+			)
+
+			values := charts.ComputeCalicoChartValues(network, &calicoapi.NetworkConfig{
+				Backend: &backend,
+				IPAM: &calicoapi.IPAM{
+					Type: calicoapi.IPAMCalico,
+					CIDR: &cidr,
+				},
+			})
+
+			Expect(values).To(Equal(map[string]interface{}{
+				"global": map[string]interface{}{
+					"podCIDR": "100.96.0.0/11",
+				},
+				"config": map[string]interface{}{
+					"backend": "none",
+					"ipam": map[string]interface{}{
+						"type":   "calico-ipam",
+						"subnet": "10.0.0.0/8",
+					},
+				},
+			}))
+		})
+	})
+})
@@ -0,0 +1,47 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/calico"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	"github.com/gardener/gardener-resource-manager/pkg/manager"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/pkg/errors"
+)
+
+func (a *actuator) delete(ctx context.Context, network *extensionsv1alpha1.Network, _ *extensionscontroller.Cluster) error {
+	// Delete the managed resource
+	a.logger.Info("Deleting managed resource containing calico chart", "network", util.ObjectName(network), "name", calico.ManagedResourceName)
+	if err := manager.NewManagedResource(a.client).
+		WithNamespacedName(network.Namespace, calico.ManagedResourceName).
+		Delete(ctx); err != nil {
+		return errors.Wrapf(err, "could not delete managed resource '%s/%s' containing calico chart for network '%s'", network.Namespace, calico.ManagedResourceName, util.ObjectName(network))
+	}
+
+	// Delete the secret referenced by the managed resource
+	a.logger.Info("Deleting secret of managed resource containing calico chart", "network", util.ObjectName(network), "name", calico.ManagedResourceName)
+	if err := manager.NewSecret(a.client).
+		WithNamespacedName(network.Namespace, calico.ManagedResourceName).
+		Delete(ctx); err != nil {
+		return errors.Wrapf(err, "could not delete secret '%s/%s' of managed resource containing calico chart for network '%s'", network.Namespace, calico.ManagedResourceName, util.ObjectName(network))
+	}
+
+	return nil
+}
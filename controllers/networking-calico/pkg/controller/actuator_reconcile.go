@@ -0,0 +1,75 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	calicoapi "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico"
+	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/calico"
+	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/charts"
+	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/imagevector"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	"github.com/gardener/gardener-resource-manager/pkg/manager"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func (a *actuator) reconcile(ctx context.Context, network *extensionsv1alpha1.Network, cluster *extensionscontroller.Cluster) error {
+	var networkConfig *calicoapi.NetworkConfig
+	if network.Spec.ProviderConfig != nil {
+		networkConfig = &calicoapi.NetworkConfig{}
+		if _, _, err := a.decoder.Decode(network.Spec.ProviderConfig.Raw, nil, networkConfig); err != nil {
+			return fmt.Errorf("could not decode provider config: %+v", err)
+		}
+	}
+
+	version := cluster.Shoot.Spec.Kubernetes.Version
+	chartRenderer, err := util.NewChartRendererForShoot(version)
+	if err != nil {
+		return errors.Wrapf(err, "could not create chart renderer for shoot '%s'", network.Namespace)
+	}
+
+	// Render calico chart
+	a.logger.Info("Rendering calico chart", "network", util.ObjectName(network))
+	name, data, err := calicoChart.Render(chartRenderer, metav1.NamespaceSystem, imagevector.ImageVector(), version, version, charts.ComputeCalicoChartValues(network, networkConfig))
+	if err != nil {
+		return errors.Wrapf(err, "could not render calico chart for network '%s'", util.ObjectName(network))
+	}
+
+	// Create or update secret containing the rendered calico chart
+	a.logger.Info("Creating secret of managed resource containing calico chart", "network", util.ObjectName(network), "name", calico.ManagedResourceName)
+	if err := manager.NewSecret(a.client).
+		WithNamespacedName(network.Namespace, calico.ManagedResourceName).
+		WithKeyValues(map[string][]byte{name: data}).
+		Reconcile(ctx); err != nil {
+		return errors.Wrapf(err, "could not create or update secret '%s/%s' of managed resource containing calico chart for network '%s'", network.Namespace, calico.ManagedResourceName, util.ObjectName(network))
+	}
+
+	// Create or update managed resource referencing the previously created secret
+	a.logger.Info("Creating managed resource containing calico chart", "network", util.ObjectName(network), "name", calico.ManagedResourceName)
+	if err := manager.NewManagedResource(a.client).
+		WithNamespacedName(network.Namespace, calico.ManagedResourceName).
+		WithSecretRef(calico.ManagedResourceName).
+		Reconcile(ctx); err != nil {
+		return errors.Wrapf(err, "could not create or update managed resource '%s/%s' containing calico chart for network '%s'", network.Namespace, calico.ManagedResourceName, util.ObjectName(network))
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"path/filepath"
+
+	calicoinstall "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico/install"
+	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/calico"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/network"
+
+	resourcemanagerv1alpha1 "github.com/gardener/gardener-resource-manager/pkg/apis/resources/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+var _ = Describe("Actuator", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx = context.TODO()
+
+		c        client.Client
+		actuator network.Actuator
+
+		chartPath string
+
+		networkObj = &extensionsv1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{Name: "network", Namespace: namespace},
+			Spec: extensionsv1alpha1.NetworkSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: calico.Type},
+				ClusterCIDR: "100.96.0.0/11",
+				ServiceCIDR: "100.64.0.0/13",
+			},
+		}
+		cluster = &extensionscontroller.Cluster{
+			Shoot: &gardenv1beta1.Shoot{
+				Spec: gardenv1beta1.ShootSpec{
+					Kubernetes: gardenv1beta1.Kubernetes{Version: "1.14.0"},
+				},
+			},
+		}
+
+		secretKey          = client.ObjectKey{Namespace: namespace, Name: calico.ManagedResourceName}
+		managedResourceKey = client.ObjectKey{Namespace: namespace, Name: calico.ManagedResourceName}
+	)
+
+	BeforeEach(func() {
+		// The chart path is relative to the root of the repository, the tests are run in the package directory.
+		chartPath = calicoChart.Path
+		calicoChart.Path = filepath.Join("..", "..", "..", "..", calico.CalicoChartPath)
+
+		scheme := runtime.NewScheme()
+		Expect(extensionscontroller.AddToScheme(scheme)).To(Succeed())
+		Expect(calicoinstall.AddToScheme(scheme)).To(Succeed())
+		c = fake.NewFakeClientWithScheme(scheme)
+
+		actuator = NewActuator()
+		Expect(inject.SchemeInto(scheme, actuator)).To(BeTrue())
+		Expect(inject.ClientInto(c, actuator)).To(BeTrue())
+	})
+
+	AfterEach(func() {
+		calicoChart.Path = chartPath
+	})
+
+	Describe("#Reconcile", func() {
+		It("should create the managed resource and its secret containing the rendered calico chart", func() {
+			Expect(actuator.Reconcile(ctx, networkObj, cluster)).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(c.Get(ctx, secretKey, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKey(calico.ReleaseName))
+			Expect(secret.Data[calico.ReleaseName]).NotTo(BeEmpty())
+
+			managedResource := &resourcemanagerv1alpha1.ManagedResource{}
+			Expect(c.Get(ctx, managedResourceKey, managedResource)).To(Succeed())
+			Expect(managedResource.Spec.SecretRefs).To(ConsistOf(corev1.LocalObjectReference{Name: calico.ManagedResourceName}))
+		})
+
+		It("should fail if the provider config cannot be decoded", func() {
+			invalidNetwork := networkObj.DeepCopy()
+			invalidNetwork.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte("{")}
+
+			Expect(actuator.Reconcile(ctx, invalidNetwork, cluster)).NotTo(Succeed())
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete the managed resource and its secret", func() {
+			Expect(actuator.Reconcile(ctx, networkObj, cluster)).To(Succeed())
+
+			Expect(actuator.Delete(ctx, networkObj, cluster)).To(Succeed())
+
+			Expect(apierrors.IsNotFound(c.Get(ctx, secretKey, &corev1.Secret{}))).To(BeTrue())
+			Expect(apierrors.IsNotFound(c.Get(ctx, managedResourceKey, &resourcemanagerv1alpha1.ManagedResource{}))).To(BeTrue())
+		})
+
+		It("should succeed if the managed resource and its secret do not exist", func() {
+			Expect(actuator.Delete(ctx, networkObj, cluster)).To(Succeed())
+		})
+	})
+})
@@ -14,7 +14,13 @@
 
 package client
 
-import alicloudvpc "github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+import (
+	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	alicloudvpc "github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
 
 // FactoryFunc is a function that implements the Factory interface. Used for consuming the
 // `alicloudvpc.NewClientWithAccessKey` function.
@@ -25,6 +31,26 @@ func (f FactoryFunc) NewVPC(region, accessKeyID, accessKeySecret string) (VPC, e
 	return f(region, accessKeyID, accessKeySecret)
 }
 
+// NewOSS implements Factory.
+func (f FactoryFunc) NewOSS(region, accessKeyID, accessKeySecret string) (OSS, error) {
+	client, err := oss.New(alicloud.ComputeStorageEndpoint(region), accessKeyID, accessKeySecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ossClient{client}, nil
+}
+
+// NewSLB implements Factory.
+func (f FactoryFunc) NewSLB(region, accessKeyID, accessKeySecret string) (SLB, error) {
+	client, err := sdk.NewClientWithAccessKey(region, accessKeyID, accessKeySecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &slbClient{client, region}, nil
+}
+
 // DefaultFactory instantiates a default Factory.
 func DefaultFactory() Factory {
 	return FactoryFunc(alicloudvpc.NewClientWithAccessKey)
@@ -0,0 +1,98 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+const (
+	ossErrorCodeBucketAlreadyExists = "BucketAlreadyExists"
+	ossErrorCodeNoSuchBucket        = "NoSuchBucket"
+
+	// ossMaxKeysPerDeletion is the maximum number of objects which can be deleted with a single request.
+	ossMaxKeysPerDeletion = 1000
+)
+
+type ossClient struct {
+	client *oss.Client
+}
+
+// CreateBucketIfNotExists implements OSS.
+func (o *ossClient) CreateBucketIfNotExists(_ context.Context, bucketName string) error {
+	if err := o.client.CreateBucket(bucketName, oss.ACL(oss.ACLPrivate)); err != nil && !isOSSServiceError(err, ossErrorCodeBucketAlreadyExists) {
+		return err
+	}
+	return nil
+}
+
+// DeleteBucketIfExists implements OSS.
+func (o *ossClient) DeleteBucketIfExists(ctx context.Context, bucketName string) error {
+	if err := o.DeleteObjectsWithPrefix(ctx, bucketName, ""); err != nil {
+		if isOSSServiceError(err, ossErrorCodeNoSuchBucket) {
+			return nil
+		}
+		return err
+	}
+
+	if err := o.client.DeleteBucket(bucketName); err != nil && !isOSSServiceError(err, ossErrorCodeNoSuchBucket) {
+		return err
+	}
+	return nil
+}
+
+// DeleteObjectsWithPrefix implements OSS.
+func (o *ossClient) DeleteObjectsWithPrefix(_ context.Context, bucketName, prefix string) error {
+	bucket, err := o.client.Bucket(bucketName)
+	if err != nil {
+		return err
+	}
+
+	marker := oss.Marker("")
+	for {
+		result, err := bucket.ListObjects(oss.Prefix(prefix), marker, oss.MaxKeys(ossMaxKeysPerDeletion))
+		if err != nil {
+			return err
+		}
+
+		if len(result.Objects) > 0 {
+			keys := make([]string, 0, len(result.Objects))
+			for _, object := range result.Objects {
+				keys = append(keys, object.Key)
+			}
+
+			if _, err := bucket.DeleteObjects(keys, oss.DeleteObjectsQuiet(true)); err != nil {
+				return err
+			}
+		}
+
+		if !result.IsTruncated {
+			return nil
+		}
+		marker = oss.Marker(result.NextMarker)
+	}
+}
+
+func isOSSServiceError(err error, code string) bool {
+	switch e := err.(type) {
+	case oss.ServiceError:
+		return e.Code == code
+	case *oss.ServiceError:
+		return e.Code == code
+	}
+	return false
+}
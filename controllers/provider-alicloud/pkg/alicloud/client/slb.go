@@ -0,0 +1,121 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+)
+
+const (
+	slbProduct     = "Slb"
+	slbVersion     = "2014-05-15"
+	slbServiceCode = "slb"
+
+	// slbLoadBalancersPageSize is the maximum number of load balancers which can be described with a single request.
+	slbLoadBalancersPageSize = 100
+)
+
+type slbClient struct {
+	client *sdk.Client
+	region string
+}
+
+type describeLoadBalancersResponse struct {
+	TotalCount    int `json:"TotalCount"`
+	LoadBalancers struct {
+		LoadBalancer []struct {
+			LoadBalancerId string `json:"LoadBalancerId"`
+		} `json:"LoadBalancer"`
+	} `json:"LoadBalancers"`
+}
+
+type describeTagsResponse struct {
+	TagSets struct {
+		TagSet []struct {
+			TagKey string `json:"TagKey"`
+		} `json:"TagSet"`
+	} `json:"TagSets"`
+}
+
+// ListLoadBalancerIDs implements SLB.
+func (s *slbClient) ListLoadBalancerIDs(vswitchID string) ([]string, error) {
+	var loadBalancerIDs []string
+
+	for pageNumber := 1; ; pageNumber++ {
+		res := &describeLoadBalancersResponse{}
+		if err := s.do("DescribeLoadBalancers", map[string]string{
+			"VSwitchId":  vswitchID,
+			"PageNumber": strconv.Itoa(pageNumber),
+			"PageSize":   strconv.Itoa(slbLoadBalancersPageSize),
+		}, res); err != nil {
+			return nil, err
+		}
+
+		for _, loadBalancer := range res.LoadBalancers.LoadBalancer {
+			loadBalancerIDs = append(loadBalancerIDs, loadBalancer.LoadBalancerId)
+		}
+
+		if len(res.LoadBalancers.LoadBalancer) == 0 || pageNumber*slbLoadBalancersPageSize >= res.TotalCount {
+			return loadBalancerIDs, nil
+		}
+	}
+}
+
+// ListTagKeys implements SLB.
+func (s *slbClient) ListTagKeys(loadBalancerID string) ([]string, error) {
+	res := &describeTagsResponse{}
+	if err := s.do("DescribeTags", map[string]string{"LoadBalancerId": loadBalancerID}, res); err != nil {
+		return nil, err
+	}
+
+	tagKeys := make([]string, 0, len(res.TagSets.TagSet))
+	for _, tag := range res.TagSets.TagSet {
+		tagKeys = append(tagKeys, tag.TagKey)
+	}
+	return tagKeys, nil
+}
+
+// DeleteLoadBalancer implements SLB.
+func (s *slbClient) DeleteLoadBalancer(loadBalancerID string) error {
+	return s.do("DeleteLoadBalancer", map[string]string{"LoadBalancerId": loadBalancerID}, nil)
+}
+
+// do sends the SLB API request with the given name and parameters and decodes the response into `out` if given.
+func (s *slbClient) do(apiName string, params map[string]string, out interface{}) error {
+	req := requests.NewCommonRequest()
+	req.Product = slbProduct
+	req.Version = slbVersion
+	req.ServiceCode = slbServiceCode
+	req.ApiName = apiName
+	req.RegionId = s.region
+	req.QueryParams["RegionId"] = s.region
+	for key, value := range params {
+		req.QueryParams[key] = value
+	}
+
+	res, err := s.client.ProcessCommonRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(res.GetHttpContentBytes(), out)
+}
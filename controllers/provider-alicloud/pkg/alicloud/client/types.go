@@ -15,6 +15,8 @@
 package client
 
 import (
+	"context"
+
 	alicloudvpc "github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 )
 
@@ -31,8 +33,33 @@ type VPC interface {
 	DescribeEipAddresses(req *alicloudvpc.DescribeEipAddressesRequest) (*alicloudvpc.DescribeEipAddressesResponse, error)
 }
 
+// OSS is the interface to the Alicloud OSS service.
+type OSS interface {
+	// CreateBucketIfNotExists creates the bucket with the given name. If it already exists, no error is returned.
+	CreateBucketIfNotExists(ctx context.Context, bucketName string) error
+	// DeleteBucketIfExists deletes the bucket with the given name including all of its objects. If it does not
+	// exist, no error is returned.
+	DeleteBucketIfExists(ctx context.Context, bucketName string) error
+	// DeleteObjectsWithPrefix deletes all objects in the given bucket whose keys start with the given prefix.
+	DeleteObjectsWithPrefix(ctx context.Context, bucketName, prefix string) error
+}
+
+// SLB is the interface to the Alicloud SLB service.
+type SLB interface {
+	// ListLoadBalancerIDs lists the IDs of all load balancers in the given VSwitch.
+	ListLoadBalancerIDs(vswitchID string) ([]string, error)
+	// ListTagKeys lists the keys of all tags of the load balancer with the given ID.
+	ListTagKeys(loadBalancerID string) ([]string, error)
+	// DeleteLoadBalancer deletes the load balancer with the given ID.
+	DeleteLoadBalancer(loadBalancerID string) error
+}
+
 // Factory is the factory to instantiate Alicloud clients.
 type Factory interface {
 	// NewVPC creates a new VPC client from the given credentials and region.
 	NewVPC(region, accessKeyID, accessKeySecret string) (VPC, error)
+	// NewOSS creates a new OSS client from the given credentials and region.
+	NewOSS(region, accessKeyID, accessKeySecret string) (OSS, error)
+	// NewSLB creates a new SLB client from the given credentials and region.
+	NewSLB(region, accessKeyID, accessKeySecret string) (SLB, error)
 }
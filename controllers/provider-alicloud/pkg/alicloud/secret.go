@@ -56,3 +56,8 @@ func ReadSecretCredentials(secret *corev1.Secret) (*Credentials, error) {
 		AccessKeySecret: string(accessKeySecret),
 	}, nil
 }
+
+// ComputeStorageEndpoint computes the OSS storage endpoint for the given region.
+func ComputeStorageEndpoint(region string) string {
+	return fmt.Sprintf("oss-%s.aliyuncs.com", region)
+}
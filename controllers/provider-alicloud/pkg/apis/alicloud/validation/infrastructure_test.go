@@ -0,0 +1,116 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud/validation"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		infrastructureConfig *apisalicloud.InfrastructureConfig
+
+		vpcCIDR = gardencorev1alpha1.CIDR("10.0.0.0/8")
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC: apisalicloud.VPC{
+					CIDR: &vpcCIDR,
+				},
+				Zones: []apisalicloud.Zone{
+					{
+						Name:   "cn-beijing-a",
+						Worker: "10.250.0.0/19",
+					},
+					{
+						Name:   "cn-beijing-b",
+						Worker: "10.250.32.0/19",
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should accept a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid specifying both a VPC id and a VPC CIDR", func() {
+			id := "vpc-123456"
+			infrastructureConfig.Networks.VPC.ID = &id
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.vpc.cidr"),
+			}))))
+		})
+
+		It("should require at least one zone", func() {
+			infrastructureConfig.Networks.Zones = nil
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.zones"),
+			}))))
+		})
+
+		It("should forbid duplicate zone names", func() {
+			infrastructureConfig.Networks.Zones[1].Name = infrastructureConfig.Networks.Zones[0].Name
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("networks.zones[1].name"),
+			}))))
+		})
+
+		It("should forbid overlapping worker CIDRs", func() {
+			infrastructureConfig.Networks.Zones[1].Worker = "10.250.16.0/20"
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[1].worker"),
+			}))))
+		})
+
+		It("should forbid worker CIDRs outside of the VPC CIDR", func() {
+			infrastructureConfig.Networks.Zones[0].Worker = "192.168.0.0/19"
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].worker"),
+			}))))
+		})
+	})
+})
@@ -18,9 +18,11 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/config"
 	. "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/config/helper"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const imageID = "id-1234"
@@ -44,6 +46,26 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found (version does not exist)", makeMachineImages("ubuntu", "2"), "ubuntu", "1", ""),
 		Entry("entry", makeMachineImages("ubuntu", "1"), "ubuntu", "1", imageID),
 	)
+
+	DescribeTable("#FindImageFromCloudProfile",
+		func(cloudProfile *gardenv1beta1.CloudProfile, imageName, version string, expectedImage string) {
+			image, err := FindImageFromCloudProfile(cloudProfile, imageName, version)
+
+			Expect(image).To(Equal(expectedImage))
+			if expectedImage != "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+
+		Entry("cloud profile is nil", nil, "ubuntu", "1", ""),
+		Entry("machine images annotation is missing", &gardenv1beta1.CloudProfile{}, "ubuntu", "1", ""),
+		Entry("machine images annotation is invalid", makeCloudProfile(`{`), "ubuntu", "1", ""),
+		Entry("entry not found (image does not exist)", makeCloudProfile(`[{"name":"debian","version":"1","id":"id-1234"}]`), "ubuntu", "1", ""),
+		Entry("entry not found (version does not exist)", makeCloudProfile(`[{"name":"ubuntu","version":"2","id":"id-1234"}]`), "ubuntu", "1", ""),
+		Entry("entry", makeCloudProfile(`[{"name":"ubuntu","version":"1","id":"id-1234"}]`), "ubuntu", "1", imageID),
+	)
 })
 
 func makeMachineImages(name, version string) []config.MachineImage {
@@ -55,3 +77,12 @@ func makeMachineImages(name, version string) []config.MachineImage {
 		},
 	}
 }
+
+func makeCloudProfile(machineImages string) *gardenv1beta1.CloudProfile {
+	return &gardenv1beta1.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "cloud-profile",
+			Annotations: map[string]string{AnnotationMachineImages: machineImages},
+		},
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
+	mockalicloudclient "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/mock/provider-alicloud/alicloud/client"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Actuator", func() {
+	var (
+		ctrl *gomock.Controller
+		ctx  context.Context
+
+		alicloudClientFactory *mockalicloudclient.MockFactory
+		be                    *extensionsv1alpha1.BackupEntry
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+
+		alicloudClientFactory = mockalicloudclient.NewMockFactory(ctrl)
+		be = &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar--abcde"},
+			Spec: extensionsv1alpha1.BackupEntrySpec{
+				BucketName: "bucket",
+				Region:     "eu-central-1",
+				SecretRef:  corev1.SecretReference{Namespace: "garden", Name: "backupprovider"},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#GetETCDSecretData", func() {
+		It("should add the bucket name and the default storage endpoint", func() {
+			data, err := newActuator(alicloudClientFactory).GetETCDSecretData(ctx, be, map[string][]byte{})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(map[string][]byte{
+				alicloud.BucketName:      []byte("bucket"),
+				alicloud.StorageEndpoint: []byte("oss-eu-central-1.aliyuncs.com"),
+			}))
+		})
+
+		It("should not overwrite a given storage endpoint", func() {
+			data, err := newActuator(alicloudClientFactory).GetETCDSecretData(ctx, be, map[string][]byte{
+				alicloud.StorageEndpoint: []byte("endpoint"),
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(HaveKeyWithValue(alicloud.StorageEndpoint, []byte("endpoint")))
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete all objects under the shoot prefix", func() {
+			var (
+				c         = mockclient.NewMockClient(ctrl)
+				ossClient = mockalicloudclient.NewMockOSS(ctrl)
+				a         = &actuator{client: c, alicloudClientFactory: alicloudClientFactory}
+			)
+
+			c.EXPECT().Get(ctx, kutil.Key("garden", "backupprovider"), gomock.AssignableToTypeOf(&corev1.Secret{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, actual *corev1.Secret) error {
+					actual.Data = map[string][]byte{
+						alicloud.AccessKeyID:     []byte("id"),
+						alicloud.AccessKeySecret: []byte("secret"),
+					}
+					return nil
+				})
+			alicloudClientFactory.EXPECT().NewOSS("eu-central-1", "id", "secret").Return(ossClient, nil)
+			ossClient.EXPECT().DeleteObjectsWithPrefix(ctx, "bucket", "shoot--foo--bar--abcde/")
+
+			Expect(a.Delete(ctx, be)).To(Succeed())
+		})
+	})
+})
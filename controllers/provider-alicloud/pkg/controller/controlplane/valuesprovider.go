@@ -357,12 +357,17 @@ func getControlPlaneChartValues(
 		return nil, errors.Wrap(err, "could not inject CSI images")
 	}
 
+	podNetwork, err := extensionscontroller.GetPodNetwork(cluster.Shoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not determine pod network of controlplane '%s'", util.ObjectName(cp))
+	}
+
 	values := map[string]interface{}{
 		"alicloud-cloud-controller-manager": map[string]interface{}{
 			"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster.Shoot, scaledDown, 1),
 			"clusterName":       cp.Namespace,
 			"kubernetesVersion": cluster.Shoot.Spec.Kubernetes.Version,
-			"podNetwork":        extensionscontroller.GetPodNetwork(cluster.Shoot),
+			"podNetwork":        podNetwork,
 			"podAnnotations": map[string]interface{}{
 				"checksum/secret-cloud-controller-manager": checksums["cloud-controller-manager"],
 				// TODO Use constant from github.com/gardener/gardener/pkg/apis/core/v1alpha1 when available
@@ -35,6 +35,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
+	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -55,8 +56,14 @@ var StatusTypeMeta = func() metav1.TypeMeta {
 	}
 }()
 
+// Actuator is an infrastructure.Actuator that is also able to detect drifts of the infrastructure.
+type Actuator interface {
+	infrastructure.Actuator
+	infrastructure.DriftDetector
+}
+
 // NewActuator instantiates an actuator with the default dependencies.
-func NewActuator() infrastructure.Actuator {
+func NewActuator() Actuator {
 	return NewActuatorWithDeps(
 		log.Log.WithName("infrastructure-actuator"),
 		alicloudclient.DefaultFactory(),
@@ -73,7 +80,7 @@ func NewActuatorWithDeps(
 	terraformerFactory extensionsterraformer.Factory,
 	chartRendererFactory extensionschartrenderer.Factory,
 	terraformChartOps TerraformChartOps,
-) infrastructure.Actuator {
+) Actuator {
 	a := &actuator{
 		logger: logger,
 
@@ -271,29 +278,38 @@ func computeProviderStatusVSwitches(infrastructure *alicloudv1alpha1.Infrastruct
 	return vswitchesToReturn, nil
 }
 
-// Reconcile implements infrastructure.Actuator.
-func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) error {
+func (a *actuator) newInitializedTerraformer(ctx context.Context, infra *extensionsv1alpha1.Infrastructure) (extensionsterraformer.Interface, *alicloudv1alpha1.InfrastructureConfig, error) {
 	config, credentials, err := a.getConfigAndCredentialsForInfra(ctx, infra)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	tf, err := a.newTerraformer(infra, credentials)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	initializerValues, err := a.getInitializerValues(tf, infra, config, credentials)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	initializer, err := a.newInitializer(infra, config, initializerValues)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tf.InitializeWith(initializer), config, nil
+}
+
+// Reconcile implements infrastructure.Actuator.
+func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) error {
+	tf, config, err := a.newInitializedTerraformer(ctx, infra)
 	if err != nil {
 		return err
 	}
 
-	if err := tf.InitializeWith(initializer).Apply(); err != nil {
+	if err := tf.Apply(); err != nil {
 		a.logger.Error(err, "failed to apply the terraform config", "infrastructure", infra.Name)
 		return &controllererrors.RequeueAfterError{
 			Cause:        err,
@@ -312,9 +328,27 @@ func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infr
 	})
 }
 
+// DetectDrift implements infrastructure.DriftDetector.
+func (a *actuator) DetectDrift(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) (*infrastructure.Drift, error) {
+	tf, _, err := a.newInitializedTerraformer(ctx, infra)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := tf.Plan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !plan.HasChanges {
+		return nil, nil
+	}
+
+	return &infrastructure.Drift{Summary: plan.String()}, nil
+}
+
 // Delete implements infrastructure.Actuator.
 func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensioncontroller.Cluster) error {
-	_, credentials, err := a.getConfigAndCredentialsForInfra(ctx, infra)
+	config, credentials, err := a.getConfigAndCredentialsForInfra(ctx, infra)
 	if err != nil {
 		return err
 	}
@@ -332,5 +366,66 @@ func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrast
 		return nil
 	}
 
-	return tf.Destroy()
+	slbClient, err := a.alicloudClientFactory.NewSLB(infra.Spec.Region, credentials.AccessKeyID, credentials.AccessKeySecret)
+	if err != nil {
+		return err
+	}
+
+	var (
+		g                              = flow.NewGraph("Alicloud infrastructure destruction")
+		destroyKubernetesLoadBalancers = g.Add(flow.Task{
+			Name: "Destroying Kubernetes load balancers",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return a.destroyKubernetesLoadBalancers(slbClient, tf, config)
+			}).RetryUntilTimeout(10*time.Second, 5*time.Minute),
+		})
+
+		_ = g.Add(flow.Task{
+			Name:         "Destroying Shoot infrastructure",
+			Fn:           flow.SimpleTaskFn(tf.Destroy),
+			Dependencies: flow.NewTaskIDs(destroyKubernetesLoadBalancers),
+		})
+
+		f = g.Compile()
+	)
+
+	if err := f.Run(flow.Opts{Context: ctx}); err != nil {
+		return &controllererrors.RequeueAfterError{
+			Cause:        flow.Causes(err),
+			RequeueAfter: 30 * time.Second,
+		}
+	}
+	return nil
+}
+
+// destroyKubernetesLoadBalancers deletes all load balancers the cloud-controller-manager has created in the VSwitches
+// of the infrastructure. Such load balancers would otherwise prevent the deletion of the VSwitches and the VPC.
+func (a *actuator) destroyKubernetesLoadBalancers(slbClient alicloudclient.SLB, tf extensionsterraformer.Interface, config *alicloudv1alpha1.InfrastructureConfig) error {
+	outputVarKeys := make([]string, 0, len(config.Networks.Zones))
+	for zoneIndex := range config.Networks.Zones {
+		outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", TerraformerOutputKeyVSwitchNodesPrefix, zoneIndex))
+	}
+
+	vars, err := tf.GetStateOutputVariables(outputVarKeys...)
+	if err != nil {
+		if apierrors.IsNotFound(err) || terraformer.IsVariablesNotFoundError(err) {
+			a.logger.Info("Skipping explicit Alicloud load balancer deletion because not all variables have been found in the Terraform state.")
+			return nil
+		}
+		return err
+	}
+
+	for _, key := range outputVarKeys {
+		loadBalancerIDs, err := ListKubernetesLoadBalancers(slbClient, vars[key])
+		if err != nil {
+			return err
+		}
+
+		for _, loadBalancerID := range loadBalancerIDs {
+			if err := DeleteLoadBalancer(slbClient, loadBalancerID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
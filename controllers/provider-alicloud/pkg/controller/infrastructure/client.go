@@ -101,3 +101,34 @@ func FetchEIPInternetChargeType(vpcClient alicloudclient.VPC, vpcID string) (str
 
 	return eipResp.EipAddresses.EipAddress[0].InternetChargeType, nil
 }
+
+// ListKubernetesLoadBalancers lists the IDs of all load balancers in the given VSwitch that have been created by the
+// Alicloud cloud-controller-manager, i.e., that carry the `kubernetes.do.not.delete` tag.
+func ListKubernetesLoadBalancers(slbClient alicloudclient.SLB, vswitchID string) ([]string, error) {
+	allLoadBalancerIDs, err := slbClient.ListLoadBalancerIDs(vswitchID)
+	if err != nil {
+		return nil, err
+	}
+
+	var loadBalancerIDs []string
+	for _, loadBalancerID := range allLoadBalancerIDs {
+		tagKeys, err := slbClient.ListTagKeys(loadBalancerID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tagKey := range tagKeys {
+			if tagKey == KubernetesLoadBalancerTagKey {
+				loadBalancerIDs = append(loadBalancerIDs, loadBalancerID)
+				break
+			}
+		}
+	}
+
+	return loadBalancerIDs, nil
+}
+
+// DeleteLoadBalancer deletes the load balancer with the given ID.
+func DeleteLoadBalancer(slbClient alicloudclient.SLB, loadBalancerID string) error {
+	return slbClient.DeleteLoadBalancer(loadBalancerID)
+}
@@ -34,6 +34,10 @@ const (
 	// TerraformerOutputKeyVSwitchNodesPrefix is the prefix for the vswitches.
 	TerraformerOutputKeyVSwitchNodesPrefix = "vswitch_id_z"
 
+	// KubernetesLoadBalancerTagKey is the key of the tag the Alicloud cloud-controller-manager puts on all load
+	// balancers it creates.
+	KubernetesLoadBalancerTagKey = "kubernetes.do.not.delete"
+
 	// TerraformDefaultVPCID is the default value for the VPC ID in the chart.
 	TerraformDefaultVPCID = "${alicloud_vpc.vpc.id}"
 	// TerraformDefaultNATGatewayID is the default value for the NAT gateway ID in the chart.
@@ -36,6 +36,8 @@ type AddOptions struct {
 	Controller controller.Options
 	// MachineImages is the default list of machine images.
 	MachineImages []config.MachineImage
+	// Deletion are the options for deleting the machines of a worker.
+	Deletion worker.DeletionOptions
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -50,7 +52,7 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 	}
 
 	return worker.Add(mgr, worker.AddArgs{
-		Actuator:          NewActuator(opts.MachineImages),
+		Actuator:          NewActuator(opts.MachineImages, opts.Deletion),
 		ControllerOptions: opts.Controller,
 		Predicates:        worker.DefaultPredicates(mgr.GetClient(), alicloud.Type),
 	})
@@ -26,6 +26,7 @@ import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -104,10 +105,14 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	machineTypes := w.cloudProfileMachineTypes()
+	excludedZones := worker.ExcludedZonesFromAnnotations(w.worker.Annotations)
+
 	for _, pool := range w.worker.Spec.Pools {
-		zoneLen := len(pool.Zones)
+		// Worker pools do not have a provider config yet, hence their zones cannot be weighted.
+		zoneDistribution := worker.NewZoneDistribution(pool.Zones, nil, excludedZones)
 
-		machineImage, err := confighelper.FindImage(w.machineImages, pool.MachineImage.Name, pool.MachineImage.Version)
+		machineImage, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version)
 		if err != nil {
 			return err
 		}
@@ -155,17 +160,26 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 			)
 
+			minimum, maximum := zoneDistribution.DistributeMinMax(zoneIndex, pool.Minimum, pool.Maximum)
+
 			machineDeployments = append(machineDeployments, worker.MachineDeployment{
 				Name:           deploymentName,
 				ClassName:      className,
 				SecretName:     className,
-				Minimum:        worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
-				Maximum:        worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
+				Minimum:        minimum,
+				Maximum:        maximum,
+				MaxSurge:       zoneDistribution.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, pool.Maximum),
+				MaxUnavailable: zoneDistribution.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, pool.Minimum),
 				Labels:         pool.Labels,
 				Annotations:    pool.Annotations,
 				Taints:         pool.Taints,
+				NodeTemplate:   worker.NodeTemplateForMachineType(machineTypes, pool.MachineType, w.worker.Spec.Region, zone),
+				MachineImage: worker.MachineImage{
+					Pool:    pool.Name,
+					Name:    pool.MachineImage.Name,
+					Version: pool.MachineImage.Version,
+					ID:      machineImage,
+				},
 			})
 
 			machineClassSpec["name"] = className
@@ -181,3 +195,30 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+// cloudProfileMachineTypes returns the machine types of the CloudProfile which are used to compute the node
+// templates of the machine deployments.
+func (w *workerDelegate) cloudProfileMachineTypes() []gardenv1beta1.MachineType {
+	if w.cluster.CloudProfile == nil || w.cluster.CloudProfile.Spec.Alicloud == nil {
+		return nil
+	}
+
+	var machineTypes []gardenv1beta1.MachineType
+	for _, machineType := range w.cluster.CloudProfile.Spec.Alicloud.Constraints.MachineTypes {
+		machineTypes = append(machineTypes, machineType.MachineType)
+	}
+	return machineTypes
+}
+
+// findMachineImage returns the image of the given machine image. The versioned machine images of the CloudProfile take
+// precedence over the ones of the controller configuration.
+func (w *workerDelegate) findMachineImage(name, version string) (string, error) {
+	image, err := confighelper.FindImageFromCloudProfile(w.cluster.CloudProfile, name, version)
+	if err == nil {
+		return image, nil
+	}
+	if image, configErr := confighelper.FindImage(w.machineImages, name, version); configErr == nil {
+		return image, nil
+	}
+	return "", err
+}
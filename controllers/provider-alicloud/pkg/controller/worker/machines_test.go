@@ -356,6 +356,11 @@ var _ = Describe("Machines", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				// Test workerDelegate.GenerateMachineDeployments()
+				var (
+					machineImagePool1 = worker.MachineImage{Pool: namePool1, Name: machineImageName, Version: machineImageVersion, ID: machineImageID}
+					machineImagePool2 = worker.MachineImage{Pool: namePool2, Name: machineImageName, Version: machineImageVersion, ID: machineImageID}
+				)
+
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1Zone1,
@@ -365,6 +370,7 @@ var _ = Describe("Machines", func() {
 						Maximum:        worker.DistributeOverZones(0, maxPool1, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool1, 2, maxPool1),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool1, 2, minPool1),
+						MachineImage:   machineImagePool1,
 					},
 					{
 						Name:           machineClassNamePool1Zone2,
@@ -374,6 +380,7 @@ var _ = Describe("Machines", func() {
 						Maximum:        worker.DistributeOverZones(1, maxPool1, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool1, 2, maxPool1),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool1, 2, minPool1),
+						MachineImage:   machineImagePool1,
 					},
 					{
 						Name:           machineClassNamePool2Zone1,
@@ -383,6 +390,7 @@ var _ = Describe("Machines", func() {
 						Maximum:        worker.DistributeOverZones(0, maxPool2, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool2, 2, maxPool2),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool2, 2, minPool2),
+						MachineImage:   machineImagePool2,
 					},
 					{
 						Name:           machineClassNamePool2Zone2,
@@ -392,6 +400,7 @@ var _ = Describe("Machines", func() {
 						Maximum:        worker.DistributeOverZones(1, maxPool2, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool2, 2, maxPool2),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool2, 2, minPool2),
+						MachineImage:   machineImagePool2,
 					},
 				}
 
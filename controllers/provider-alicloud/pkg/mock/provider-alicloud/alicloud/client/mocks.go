@@ -1,10 +1,11 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud/client (interfaces: VPC,Factory)
+// Source: github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud/client (interfaces: VPC,OSS,SLB,Factory)
 
 // Package client is a generated GoMock package.
 package client
 
 import (
+	context "context"
 	vpc "github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	client "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud/client"
 	gomock "github.com/golang/mock/gomock"
@@ -79,6 +80,138 @@ func (mr *MockVPCMockRecorder) DescribeVpcs(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcs", reflect.TypeOf((*MockVPC)(nil).DescribeVpcs), arg0)
 }
 
+// MockOSS is a mock of OSS interface
+type MockOSS struct {
+	ctrl     *gomock.Controller
+	recorder *MockOSSMockRecorder
+}
+
+// MockOSSMockRecorder is the mock recorder for MockOSS
+type MockOSSMockRecorder struct {
+	mock *MockOSS
+}
+
+// NewMockOSS creates a new mock instance
+func NewMockOSS(ctrl *gomock.Controller) *MockOSS {
+	mock := &MockOSS{ctrl: ctrl}
+	mock.recorder = &MockOSSMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockOSS) EXPECT() *MockOSSMockRecorder {
+	return m.recorder
+}
+
+// CreateBucketIfNotExists mocks base method
+func (m *MockOSS) CreateBucketIfNotExists(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBucketIfNotExists", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBucketIfNotExists indicates an expected call of CreateBucketIfNotExists
+func (mr *MockOSSMockRecorder) CreateBucketIfNotExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBucketIfNotExists", reflect.TypeOf((*MockOSS)(nil).CreateBucketIfNotExists), arg0, arg1)
+}
+
+// DeleteBucketIfExists mocks base method
+func (m *MockOSS) DeleteBucketIfExists(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBucketIfExists", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBucketIfExists indicates an expected call of DeleteBucketIfExists
+func (mr *MockOSSMockRecorder) DeleteBucketIfExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBucketIfExists", reflect.TypeOf((*MockOSS)(nil).DeleteBucketIfExists), arg0, arg1)
+}
+
+// DeleteObjectsWithPrefix mocks base method
+func (m *MockOSS) DeleteObjectsWithPrefix(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObjectsWithPrefix", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObjectsWithPrefix indicates an expected call of DeleteObjectsWithPrefix
+func (mr *MockOSSMockRecorder) DeleteObjectsWithPrefix(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectsWithPrefix", reflect.TypeOf((*MockOSS)(nil).DeleteObjectsWithPrefix), arg0, arg1, arg2)
+}
+
+// MockSLB is a mock of SLB interface
+type MockSLB struct {
+	ctrl     *gomock.Controller
+	recorder *MockSLBMockRecorder
+}
+
+// MockSLBMockRecorder is the mock recorder for MockSLB
+type MockSLBMockRecorder struct {
+	mock *MockSLB
+}
+
+// NewMockSLB creates a new mock instance
+func NewMockSLB(ctrl *gomock.Controller) *MockSLB {
+	mock := &MockSLB{ctrl: ctrl}
+	mock.recorder = &MockSLBMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSLB) EXPECT() *MockSLBMockRecorder {
+	return m.recorder
+}
+
+// DeleteLoadBalancer mocks base method
+func (m *MockSLB) DeleteLoadBalancer(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoadBalancer", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLoadBalancer indicates an expected call of DeleteLoadBalancer
+func (mr *MockSLBMockRecorder) DeleteLoadBalancer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancer", reflect.TypeOf((*MockSLB)(nil).DeleteLoadBalancer), arg0)
+}
+
+// ListLoadBalancerIDs mocks base method
+func (m *MockSLB) ListLoadBalancerIDs(arg0 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoadBalancerIDs", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoadBalancerIDs indicates an expected call of ListLoadBalancerIDs
+func (mr *MockSLBMockRecorder) ListLoadBalancerIDs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerIDs", reflect.TypeOf((*MockSLB)(nil).ListLoadBalancerIDs), arg0)
+}
+
+// ListTagKeys mocks base method
+func (m *MockSLB) ListTagKeys(arg0 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagKeys", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagKeys indicates an expected call of ListTagKeys
+func (mr *MockSLBMockRecorder) ListTagKeys(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagKeys", reflect.TypeOf((*MockSLB)(nil).ListTagKeys), arg0)
+}
+
 // MockFactory is a mock of Factory interface
 type MockFactory struct {
 	ctrl     *gomock.Controller
@@ -102,6 +235,36 @@ func (m *MockFactory) EXPECT() *MockFactoryMockRecorder {
 	return m.recorder
 }
 
+// NewOSS mocks base method
+func (m *MockFactory) NewOSS(arg0, arg1, arg2 string) (client.OSS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewOSS", arg0, arg1, arg2)
+	ret0, _ := ret[0].(client.OSS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewOSS indicates an expected call of NewOSS
+func (mr *MockFactoryMockRecorder) NewOSS(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewOSS", reflect.TypeOf((*MockFactory)(nil).NewOSS), arg0, arg1, arg2)
+}
+
+// NewSLB mocks base method
+func (m *MockFactory) NewSLB(arg0, arg1, arg2 string) (client.SLB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSLB", arg0, arg1, arg2)
+	ret0, _ := ret[0].(client.SLB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewSLB indicates an expected call of NewSLB
+func (mr *MockFactoryMockRecorder) NewSLB(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSLB", reflect.TypeOf((*MockFactory)(nil).NewSLB), arg0, arg1, arg2)
+}
+
 // NewVPC mocks base method
 func (m *MockFactory) NewVPC(arg0, arg1, arg2 string) (client.VPC, error) {
 	m.ctrl.T.Helper()
@@ -128,6 +128,11 @@ resource "aws_security_group_rule" "nodes_egress_all" {
 }
 
 {{ range $index, $zone := .Values.zones }}
+{{- $workerSubnetID := default (print "${aws_subnet.nodes_z" $index ".id}") $zone.workerSubnetID }}
+{{- $internalSubnetID := default (print "${aws_subnet.private_utility_z" $index ".id}") $zone.internalSubnetID }}
+{{- $publicSubnetID := default (print "${aws_subnet.public_utility_z" $index ".id}") $zone.publicSubnetID }}
+{{- $elasticIPAllocationID := default (print "${aws_eip.eip_natgw_z" $index ".id}") $zone.elasticIPAllocationID }}
+{{ if not $zone.workerSubnetID -}}
 resource "aws_subnet" "nodes_z{{ $index }}" {
   vpc_id            = "{{ required "vpc.id is required" $.Values.vpc.id }}"
   cidr_block        = "{{ required "zone.worker is required" $zone.worker }}"
@@ -135,11 +140,13 @@ resource "aws_subnet" "nodes_z{{ $index }}" {
 
 {{ include "aws-infra.tags-with-suffix" (set $.Values "suffix" (print "nodes-z" $index)) }}
 }
+{{- end }}
 
 output "{{ $.Values.outputKeys.subnetsNodesPrefix }}{{ $index }}" {
-  value = "${aws_subnet.nodes_z{{ $index }}.id}"
+  value = "{{ $workerSubnetID }}"
 }
 
+{{ if not $zone.internalSubnetID -}}
 resource "aws_subnet" "private_utility_z{{ $index }}" {
   vpc_id            = "{{ required "vpc.id is required" $.Values.vpc.id }}"
   cidr_block        = "{{ required "zone.internal is required" $zone.internal }}"
@@ -151,6 +158,7 @@ resource "aws_subnet" "private_utility_z{{ $index }}" {
     "kubernetes.io/role/internal-elb" = "use"
   }
 }
+{{- end }}
 
 resource "aws_security_group_rule" "nodes_tcp_internal_z{{ $index }}" {
   type              = "ingress"
@@ -170,6 +178,7 @@ resource "aws_security_group_rule" "nodes_udp_internal_z{{ $index }}" {
   security_group_id = "${aws_security_group.nodes.id}"
 }
 
+{{ if not $zone.publicSubnetID -}}
 resource "aws_subnet" "public_utility_z{{ $index }}" {
   vpc_id            = "{{ required "vpc.id is required" $.Values.vpc.id }}"
   cidr_block        = "{{ required "zone.public is required" $zone.public }}"
@@ -181,9 +190,10 @@ resource "aws_subnet" "public_utility_z{{ $index }}" {
     "kubernetes.io/role/elb" = "use"
   }
 }
+{{- end }}
 
 output "{{ $.Values.outputKeys.subnetsPublicPrefix }}{{ $index }}" {
-  value = "${aws_subnet.public_utility_z{{ $index }}.id}"
+  value = "{{ $publicSubnetID }}"
 }
 
 resource "aws_security_group_rule" "nodes_tcp_public_z{{ $index }}" {
@@ -204,6 +214,7 @@ resource "aws_security_group_rule" "nodes_udp_public_z{{ $index }}" {
   security_group_id = "${aws_security_group.nodes.id}"
 }
 
+{{ if not $zone.elasticIPAllocationID -}}
 resource "aws_eip" "eip_natgw_z{{ $index }}" {
   vpc = true
 
@@ -212,10 +223,11 @@ resource "aws_eip" "eip_natgw_z{{ $index }}" {
     "kubernetes.io/cluster/{{ required "clusterName is required" $.Values.clusterName }}"  = "1"
   }
 }
+{{- end }}
 
 resource "aws_nat_gateway" "natgw_z{{ $index }}" {
-  allocation_id = "${aws_eip.eip_natgw_z{{ $index }}.id}"
-  subnet_id     = "${aws_subnet.public_utility_z{{ $index }}.id}"
+  allocation_id = "{{ $elasticIPAllocationID }}"
+  subnet_id     = "{{ $publicSubnetID }}"
 
   tags {
     Name = "{{ required "clusterName is required" $.Values.clusterName }}-natgw-z{{ $index }}"
@@ -223,6 +235,14 @@ resource "aws_nat_gateway" "natgw_z{{ $index }}" {
   }
 }
 
+output "{{ $.Values.outputKeys.natGatewayIPPrefix }}{{ $index }}" {
+  value = "${aws_nat_gateway.natgw_z{{ $index }}.public_ip}"
+}
+
+output "{{ $.Values.outputKeys.natGatewayEIPPrefix }}{{ $index }}" {
+  value = "${aws_nat_gateway.natgw_z{{ $index }}.allocation_id}"
+}
+
 resource "aws_route_table" "routetable_private_utility_z{{ $index }}" {
   vpc_id = "{{ required "vpc.id is required" $.Values.vpc.id }}"
 
@@ -236,21 +256,36 @@ resource "aws_route" "private_utility_z{{ $index }}_nat" {
 }
 
 resource "aws_route_table_association" "routetable_private_utility_z{{ $index }}_association_private_utility_z{{ $index }}" {
-  subnet_id      = "${aws_subnet.private_utility_z{{ $index }}.id}"
+  subnet_id      = "{{ $internalSubnetID }}"
   route_table_id = "${aws_route_table.routetable_private_utility_z{{ $index }}.id}"
 }
 
 resource "aws_route_table_association" "routetable_main_association_public_utility_z{{ $index }}" {
-  subnet_id      = "${aws_subnet.public_utility_z{{ $index }}.id}"
+  subnet_id      = "{{ $publicSubnetID }}"
   route_table_id = "${aws_route_table.routetable_main.id}"
 }
 
 resource "aws_route_table_association" "routetable_private_utility_z{{ $index }}_association_nodes_z{{ $index }}" {
-  subnet_id      = "${aws_subnet.nodes_z{{ $index }}.id}"
+  subnet_id      = "{{ $workerSubnetID }}"
   route_table_id = "${aws_route_table.routetable_private_utility_z{{ $index }}.id}"
 }
 {{end}}
 
+{{- range $service := .Values.vpc.gatewayEndpoints }}
+
+resource "aws_vpc_endpoint" "vpc_gwep_{{ $service }}" {
+  vpc_id            = "{{ required "vpc.id is required" $.Values.vpc.id }}"
+  service_name      = "com.amazonaws.{{ required "aws.region is required" $.Values.aws.region }}.{{ $service }}"
+  vpc_endpoint_type = "Gateway"
+  route_table_ids   = [
+    "${aws_route_table.routetable_main.id}",
+{{- range $index, $zone := $.Values.zones }}
+    "${aws_route_table.routetable_private_utility_z{{ $index }}.id}",
+{{- end }}
+  ]
+}
+{{- end }}
+
 //=====================================================================
 //= IAM instance profiles
 //=====================================================================
@@ -73,7 +73,9 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		infraReconcileOpts = &infrastructure.ReconcilerOptions{
 			IgnoreOperationAnnotation: true,
 		}
-		infraCtrlOptsUnprefixed = controllercmd.NewOptionAggregator(infraCtrlOpts, infraReconcileOpts)
+		infraNativeOpts         = &awscmd.InfrastructureOptions{}
+		infraDriftDetectionOpts = &infrastructure.DriftDetectionOptions{}
+		infraCtrlOptsUnprefixed = controllercmd.NewOptionAggregator(infraCtrlOpts, infraReconcileOpts, infraNativeOpts, infraDriftDetectionOpts)
 
 		// options for the worker controller
 		workerCtrlOpts = &controllercmd.ControllerOptions{
@@ -136,6 +138,10 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 				controllercmd.LogErrAndExit(err, "Could not update manager scheme")
 			}
 
+			if err := controller.AddClusterCacheToManager(mgr); err != nil {
+				controllercmd.LogErrAndExit(err, "Could not add cluster cache to manager")
+			}
+
 			if err := awsinstall.AddToScheme(mgr.GetScheme()); err != nil {
 				controllercmd.LogErrAndExit(err, "Could not update manager scheme")
 			}
@@ -148,7 +154,10 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			controlPlaneCtrlOpts.Completed().Apply(&awscontrolplane.Options)
 			infraCtrlOpts.Completed().Apply(&awsinfrastructure.DefaultAddOptions.Controller)
 			infraReconcileOpts.Completed().Apply(&awsinfrastructure.DefaultAddOptions.IgnoreOperationAnnotation)
+			infraNativeOpts.Completed().Apply(&awsinfrastructure.DefaultAddOptions.UseNativeReconciler)
+			infraDriftDetectionOpts.Completed().Apply(&awsinfrastructure.DefaultAddOptions.DriftDetection)
 			workerCtrlOpts.Completed().Apply(&awsworker.DefaultAddOptions.Controller)
+			workerReconcileOpts.Completed().ApplyDeletion(&awsworker.DefaultAddOptions.Deletion)
 
 			if err := controllerSwitches.Completed().AddToManager(mgr); err != nil {
 				controllercmd.LogErrAndExit(err, "Could not add controllers to manager")
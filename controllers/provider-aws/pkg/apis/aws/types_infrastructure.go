@@ -62,6 +62,22 @@ type Zone struct {
 	Public gardencore.CIDR
 	// Workers isis the workers subnet range to create  (used for the VMs).
 	Workers gardencore.CIDR
+	// ElasticIPAllocationID is the allocation ID of an existing elastic IP that is attached to the NAT gateway of
+	// this zone. If not given, a new elastic IP is allocated.
+	ElasticIPAllocationID *string
+	// Subnets contains the IDs of existing subnets that are used instead of creating new ones. The CIDRs of the
+	// zone must match the ones of the existing subnets.
+	Subnets *ZoneSubnets
+}
+
+// ZoneSubnets contains the IDs of existing subnets of a zone.
+type ZoneSubnets struct {
+	// Internal is the ID of an existing private subnet (used for internal load balancers).
+	Internal *string
+	// Public is the ID of an existing public subnet (used for bastion and load balancers).
+	Public *string
+	// Workers is the ID of an existing workers subnet (used for the VMs).
+	Workers *string
 }
 
 // EC2 contains information about the AWS EC2 resources.
@@ -84,6 +100,9 @@ type VPC struct {
 	ID *string
 	// CIDR is the VPC CIDR
 	CIDR *gardencore.CIDR
+	// GatewayEndpoints is a list of AWS services (`s3` or `dynamodb`) for which VPC gateway endpoints are created.
+	// The endpoints are attached to all route tables of the cluster.
+	GatewayEndpoints []string
 }
 
 // VPCStatus contains information about a generated VPC or resources inside an existing VPC.
@@ -94,6 +113,8 @@ type VPCStatus struct {
 	Subnets []Subnet
 	// SecurityGroups is a list of security groups that have been created.
 	SecurityGroups []SecurityGroup
+	// NATGateways is a list of NAT gateways through which the nodes reach the internet.
+	NATGateways []NATGateway
 }
 
 const (
@@ -138,3 +159,13 @@ type SecurityGroup struct {
 	// ID is the subnet id.
 	ID string
 }
+
+// NATGateway contains information about a NAT gateway.
+type NATGateway struct {
+	// Zone is the availability zone of the NAT gateway.
+	Zone string
+	// ElasticIPAllocationID is the allocation ID of the elastic IP attached to the NAT gateway.
+	ElasticIPAllocationID string
+	// PublicIP is the public egress IP of the NAT gateway.
+	PublicIP string
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta
+
+	// Volume contains configuration for the root disks attached to VMs.
+	Volume *Volume
+	// DataVolumes contains configuration for the additional disks attached to VMs.
+	DataVolumes []DataVolume
+	// InstanceMetadataOptions contains configuration for controlling access to the metadata API.
+	InstanceMetadataOptions *InstanceMetadataOptions
+	// MachineLifecycle contains settings for the lifecycle of the machines of the worker pool.
+	MachineLifecycle *MachineLifecycle
+	// ZoneWeights contains weights for the zones of the worker pool. The machines of the worker pool are
+	// distributed over its zones proportionally to their weights. Zones without weight have a weight of 1.
+	ZoneWeights []ZoneWeight
+}
+
+// ZoneWeight is the weight of a zone of a worker pool.
+type ZoneWeight struct {
+	// Zone is the name of the zone.
+	Zone string
+	// Weight is the weight of the zone.
+	Weight int32
+}
+
+// MachineLifecycle contains settings for the lifecycle of the machines of a worker pool. Unset values are taken from
+// the annotations of the Worker or defaulted. The machine-controller-manager only supports timeouts for all of its
+// machines, hence the longest timeouts of all worker pools apply.
+type MachineLifecycle struct {
+	// MinReadySeconds is the minimum number of seconds a new machine must be ready before it is considered available.
+	MinReadySeconds *int32
+	// DrainTimeout is the timeout for draining a machine before it is deleted.
+	DrainTimeout *metav1.Duration
+	// HealthTimeout is the timeout after which an unhealthy machine is replaced.
+	HealthTimeout *metav1.Duration
+	// CreationTimeout is the timeout for creating a machine and for its node to join the cluster.
+	CreationTimeout *metav1.Duration
+}
+
+// Volume contains EBS configuration settings for a disk attached to VMs.
+type Volume struct {
+	// IOPS is the number of I/O operations per second (IOPS) that the volume supports.
+	// It is only allowed for volumes of type io1.
+	IOPS *int64
+	// Encrypted determines whether the volume is encrypted.
+	Encrypted *bool
+	// KMSKeyID is the ID or ARN of the KMS key which is used to encrypt the volume.
+	// If it is not set, the default EBS encryption key of the account is used.
+	KMSKeyID *string
+}
+
+// DataVolume contains configuration for an additional disk attached to VMs.
+type DataVolume struct {
+	// Name is the name of the data volume. It must be unique within the worker pool.
+	Name string
+	// Size is the size of the data volume, e.g. 50Gi.
+	Size string
+	// Type is the EBS volume type of the data volume, e.g. gp2 or io1.
+	Type string
+	// DeviceName is a hint under which device name the volume is attached to the VM, e.g. /dev/sdf.
+	// If it is not set, device names are assigned in the order of the data volumes starting with /dev/sdf.
+	DeviceName *string
+	// Volume contains the EBS configuration settings of the data volume.
+	Volume
+}
+
+// InstanceMetadataOptions contains configuration for controlling access to the metadata API.
+type InstanceMetadataOptions struct {
+	// HTTPTokens enforces the use of IMDSv2 session tokens if set to "required".
+	HTTPTokens *HTTPTokensValue
+	// HTTPPutResponseHopLimit is the maximum number of hops for metadata PUT responses.
+	// Valid values are between 1 and 64.
+	HTTPPutResponseHopLimit *int64
+}
+
+// HTTPTokensValue is the value of the HTTPTokens instance metadata option.
+type HTTPTokensValue string
+
+const (
+	// HTTPTokensRequired requires session tokens for accessing the metadata API (IMDSv2).
+	HTTPTokensRequired HTTPTokensValue = "required"
+	// HTTPTokensOptional allows accessing the metadata API without session tokens (IMDSv1).
+	HTTPTokensOptional HTTPTokensValue = "optional"
+)
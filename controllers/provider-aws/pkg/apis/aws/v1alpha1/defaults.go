@@ -21,3 +21,12 @@ import (
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
 }
+
+// SetDefaults_Volume sets default values for Volume objects.
+func SetDefaults_Volume(obj *Volume) {
+	// A KMS key can only be used for encrypted volumes.
+	if obj.KMSKeyID != nil && obj.Encrypted == nil {
+		encrypted := true
+		obj.Encrypted = &encrypted
+	}
+}
@@ -62,6 +62,27 @@ type Zone struct {
 	Public gardencorev1alpha1.CIDR `json:"public"`
 	// Workers is the  workers  subnet range  to create (used for the VMs).
 	Workers gardencorev1alpha1.CIDR `json:"workers"`
+	// ElasticIPAllocationID is the allocation ID of an existing elastic IP that is attached to the NAT gateway of
+	// this zone. If not given, a new elastic IP is allocated.
+	// +optional
+	ElasticIPAllocationID *string `json:"elasticIPAllocationID,omitempty"`
+	// Subnets contains the IDs of existing subnets that are used instead of creating new ones. The CIDRs of the
+	// zone must match the ones of the existing subnets.
+	// +optional
+	Subnets *ZoneSubnets `json:"subnets,omitempty"`
+}
+
+// ZoneSubnets contains the IDs of existing subnets of a zone.
+type ZoneSubnets struct {
+	// Internal is the ID of an existing private subnet (used for internal load balancers).
+	// +optional
+	Internal *string `json:"internal,omitempty"`
+	// Public is the ID of an existing public subnet (used for bastion and load balancers).
+	// +optional
+	Public *string `json:"public,omitempty"`
+	// Workers is the ID of an existing workers subnet (used for the VMs).
+	// +optional
+	Workers *string `json:"workers,omitempty"`
 }
 
 // EC2 contains information about the  AWS EC2 resources.
@@ -86,6 +107,10 @@ type VPC struct {
 	// gardencorev1alpha1.CIDR is the VPC gardencorev1alpha1.CIDR
 	// +optional
 	CIDR *gardencorev1alpha1.CIDR `json:"cidr,omitempty"`
+	// GatewayEndpoints is a list of AWS services (`s3` or `dynamodb`) for which VPC gateway endpoints are created.
+	// The endpoints are attached to all route tables of the cluster.
+	// +optional
+	GatewayEndpoints []string `json:"gatewayEndpoints,omitempty"`
 }
 
 // VPCStatus contains information about a generated VPC or resources inside an existing VPC.
@@ -96,6 +121,9 @@ type VPCStatus struct {
 	Subnets []Subnet `json:"subnets"`
 	// SecurityGroups is a list of security groups that have been created.
 	SecurityGroups []SecurityGroup `json:"securityGroups"`
+	// NATGateways is a list of NAT gateways through which the nodes reach the internet.
+	// +optional
+	NATGateways []NATGateway `json:"natGateways,omitempty"`
 }
 
 const (
@@ -140,3 +168,13 @@ type SecurityGroup struct {
 	// ID is the subnet id.
 	ID string `json:"id"`
 }
+
+// NATGateway contains information about a NAT gateway.
+type NATGateway struct {
+	// Zone is the availability zone of the NAT gateway.
+	Zone string `json:"zone"`
+	// ElasticIPAllocationID is the allocation ID of the elastic IP attached to the NAT gateway.
+	ElasticIPAllocationID string `json:"elasticIPAllocationID"`
+	// PublicIP is the public egress IP of the NAT gateway.
+	PublicIP string `json:"publicIP"`
+}
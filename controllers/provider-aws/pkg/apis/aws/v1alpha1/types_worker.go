@@ -0,0 +1,121 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Volume contains configuration for the root disks attached to VMs.
+	// +optional
+	Volume *Volume `json:"volume,omitempty"`
+	// DataVolumes contains configuration for the additional disks attached to VMs.
+	// +optional
+	DataVolumes []DataVolume `json:"dataVolumes,omitempty"`
+	// InstanceMetadataOptions contains configuration for controlling access to the metadata API.
+	// +optional
+	InstanceMetadataOptions *InstanceMetadataOptions `json:"instanceMetadataOptions,omitempty"`
+	// MachineLifecycle contains settings for the lifecycle of the machines of the worker pool.
+	// +optional
+	MachineLifecycle *MachineLifecycle `json:"machineLifecycle,omitempty"`
+	// ZoneWeights contains weights for the zones of the worker pool. The machines of the worker pool are
+	// distributed over its zones proportionally to their weights. Zones without weight have a weight of 1.
+	// +optional
+	ZoneWeights []ZoneWeight `json:"zoneWeights,omitempty"`
+}
+
+// ZoneWeight is the weight of a zone of a worker pool.
+type ZoneWeight struct {
+	// Zone is the name of the zone.
+	Zone string `json:"zone"`
+	// Weight is the weight of the zone.
+	Weight int32 `json:"weight"`
+}
+
+// MachineLifecycle contains settings for the lifecycle of the machines of a worker pool. Unset values are taken from
+// the annotations of the Worker or defaulted. The machine-controller-manager only supports timeouts for all of its
+// machines, hence the longest timeouts of all worker pools apply.
+type MachineLifecycle struct {
+	// MinReadySeconds is the minimum number of seconds a new machine must be ready before it is considered available.
+	// +optional
+	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
+	// DrainTimeout is the timeout for draining a machine before it is deleted.
+	// +optional
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
+	// HealthTimeout is the timeout after which an unhealthy machine is replaced.
+	// +optional
+	HealthTimeout *metav1.Duration `json:"healthTimeout,omitempty"`
+	// CreationTimeout is the timeout for creating a machine and for its node to join the cluster.
+	// +optional
+	CreationTimeout *metav1.Duration `json:"creationTimeout,omitempty"`
+}
+
+// Volume contains EBS configuration settings for a disk attached to VMs.
+type Volume struct {
+	// IOPS is the number of I/O operations per second (IOPS) that the volume supports.
+	// It is only allowed for volumes of type io1.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+	// Encrypted determines whether the volume is encrypted.
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+	// KMSKeyID is the ID or ARN of the KMS key which is used to encrypt the volume.
+	// If it is not set, the default EBS encryption key of the account is used.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+}
+
+// DataVolume contains configuration for an additional disk attached to VMs.
+type DataVolume struct {
+	// Name is the name of the data volume. It must be unique within the worker pool.
+	Name string `json:"name"`
+	// Size is the size of the data volume, e.g. 50Gi.
+	Size string `json:"size"`
+	// Type is the EBS volume type of the data volume, e.g. gp2 or io1.
+	Type string `json:"type"`
+	// DeviceName is a hint under which device name the volume is attached to the VM, e.g. /dev/sdf.
+	// If it is not set, device names are assigned in the order of the data volumes starting with /dev/sdf.
+	// +optional
+	DeviceName *string `json:"deviceName,omitempty"`
+	// Volume contains the EBS configuration settings of the data volume.
+	Volume `json:",inline"`
+}
+
+// InstanceMetadataOptions contains configuration for controlling access to the metadata API.
+type InstanceMetadataOptions struct {
+	// HTTPTokens enforces the use of IMDSv2 session tokens if set to "required".
+	// +optional
+	HTTPTokens *HTTPTokensValue `json:"httpTokens,omitempty"`
+	// HTTPPutResponseHopLimit is the maximum number of hops for metadata PUT responses.
+	// Valid values are between 1 and 64.
+	// +optional
+	HTTPPutResponseHopLimit *int64 `json:"httpPutResponseHopLimit,omitempty"`
+}
+
+// HTTPTokensValue is the value of the HTTPTokens instance metadata option.
+type HTTPTokensValue string
+
+const (
+	// HTTPTokensRequired requires session tokens for accessing the metadata API (IMDSv2).
+	HTTPTokensRequired HTTPTokensValue = "required"
+	// HTTPTokensOptional allows accessing the metadata API without session tokens (IMDSv1).
+	HTTPTokensOptional HTTPTokensValue = "optional"
+)
@@ -26,6 +26,7 @@ import (
 	aws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
 	core "github.com/gardener/gardener/pkg/apis/core"
 	corev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -57,6 +58,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DataVolume)(nil), (*aws.DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DataVolume_To_aws_DataVolume(a.(*DataVolume), b.(*aws.DataVolume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.DataVolume)(nil), (*DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_DataVolume_To_v1alpha1_DataVolume(a.(*aws.DataVolume), b.(*DataVolume), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EC2)(nil), (*aws.EC2)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_EC2_To_aws_EC2(a.(*EC2), b.(*aws.EC2), scope)
 	}); err != nil {
@@ -97,6 +108,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InstanceMetadataOptions)(nil), (*aws.InstanceMetadataOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions(a.(*InstanceMetadataOptions), b.(*aws.InstanceMetadataOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.InstanceMetadataOptions)(nil), (*InstanceMetadataOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(a.(*aws.InstanceMetadataOptions), b.(*InstanceMetadataOptions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstanceProfile)(nil), (*aws.InstanceProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InstanceProfile_To_aws_InstanceProfile(a.(*InstanceProfile), b.(*aws.InstanceProfile), scope)
 	}); err != nil {
@@ -107,6 +128,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineLifecycle)(nil), (*aws.MachineLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineLifecycle_To_aws_MachineLifecycle(a.(*MachineLifecycle), b.(*aws.MachineLifecycle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.MachineLifecycle)(nil), (*MachineLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_MachineLifecycle_To_v1alpha1_MachineLifecycle(a.(*aws.MachineLifecycle), b.(*MachineLifecycle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NATGateway)(nil), (*aws.NATGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NATGateway_To_aws_NATGateway(a.(*NATGateway), b.(*aws.NATGateway), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.NATGateway)(nil), (*NATGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_NATGateway_To_v1alpha1_NATGateway(a.(*aws.NATGateway), b.(*NATGateway), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Networks)(nil), (*aws.Networks)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Networks_To_aws_Networks(a.(*Networks), b.(*aws.Networks), scope)
 	}); err != nil {
@@ -167,6 +208,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Volume)(nil), (*aws.Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Volume_To_aws_Volume(a.(*Volume), b.(*aws.Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.Volume)(nil), (*Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_Volume_To_v1alpha1_Volume(a.(*aws.Volume), b.(*Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*aws.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_aws_WorkerConfig(a.(*WorkerConfig), b.(*aws.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*aws.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Zone)(nil), (*aws.Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Zone_To_aws_Zone(a.(*Zone), b.(*aws.Zone), scope)
 	}); err != nil {
@@ -177,6 +238,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ZoneSubnets)(nil), (*aws.ZoneSubnets)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZoneSubnets_To_aws_ZoneSubnets(a.(*ZoneSubnets), b.(*aws.ZoneSubnets), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.ZoneSubnets)(nil), (*ZoneSubnets)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ZoneSubnets_To_v1alpha1_ZoneSubnets(a.(*aws.ZoneSubnets), b.(*ZoneSubnets), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ZoneWeight)(nil), (*aws.ZoneWeight)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZoneWeight_To_aws_ZoneWeight(a.(*ZoneWeight), b.(*aws.ZoneWeight), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.ZoneWeight)(nil), (*ZoneWeight)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ZoneWeight_To_v1alpha1_ZoneWeight(a.(*aws.ZoneWeight), b.(*ZoneWeight), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -220,6 +301,38 @@ func Convert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *aws.Contr
 	return autoConvert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_DataVolume_To_aws_DataVolume(in *DataVolume, out *aws.DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Size = in.Size
+	out.Type = in.Type
+	out.DeviceName = (*string)(unsafe.Pointer(in.DeviceName))
+	if err := Convert_v1alpha1_Volume_To_aws_Volume(&in.Volume, &out.Volume, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_DataVolume_To_aws_DataVolume is an autogenerated conversion function.
+func Convert_v1alpha1_DataVolume_To_aws_DataVolume(in *DataVolume, out *aws.DataVolume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DataVolume_To_aws_DataVolume(in, out, s)
+}
+
+func autoConvert_aws_DataVolume_To_v1alpha1_DataVolume(in *aws.DataVolume, out *DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Size = in.Size
+	out.Type = in.Type
+	out.DeviceName = (*string)(unsafe.Pointer(in.DeviceName))
+	if err := Convert_aws_Volume_To_v1alpha1_Volume(&in.Volume, &out.Volume, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_aws_DataVolume_To_v1alpha1_DataVolume is an autogenerated conversion function.
+func Convert_aws_DataVolume_To_v1alpha1_DataVolume(in *aws.DataVolume, out *DataVolume, s conversion.Scope) error {
+	return autoConvert_aws_DataVolume_To_v1alpha1_DataVolume(in, out, s)
+}
+
 func autoConvert_v1alpha1_EC2_To_aws_EC2(in *EC2, out *aws.EC2, s conversion.Scope) error {
 	out.KeyName = in.KeyName
 	return nil
@@ -322,6 +435,28 @@ func Convert_aws_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *aws.I
 	return autoConvert_aws_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions(in *InstanceMetadataOptions, out *aws.InstanceMetadataOptions, s conversion.Scope) error {
+	out.HTTPTokens = (*aws.HTTPTokensValue)(unsafe.Pointer(in.HTTPTokens))
+	out.HTTPPutResponseHopLimit = (*int64)(unsafe.Pointer(in.HTTPPutResponseHopLimit))
+	return nil
+}
+
+// Convert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions is an autogenerated conversion function.
+func Convert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions(in *InstanceMetadataOptions, out *aws.InstanceMetadataOptions, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions(in, out, s)
+}
+
+func autoConvert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(in *aws.InstanceMetadataOptions, out *InstanceMetadataOptions, s conversion.Scope) error {
+	out.HTTPTokens = (*HTTPTokensValue)(unsafe.Pointer(in.HTTPTokens))
+	out.HTTPPutResponseHopLimit = (*int64)(unsafe.Pointer(in.HTTPPutResponseHopLimit))
+	return nil
+}
+
+// Convert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions is an autogenerated conversion function.
+func Convert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(in *aws.InstanceMetadataOptions, out *InstanceMetadataOptions, s conversion.Scope) error {
+	return autoConvert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(in, out, s)
+}
+
 func autoConvert_v1alpha1_InstanceProfile_To_aws_InstanceProfile(in *InstanceProfile, out *aws.InstanceProfile, s conversion.Scope) error {
 	out.Purpose = in.Purpose
 	out.Name = in.Name
@@ -344,6 +479,56 @@ func Convert_aws_InstanceProfile_To_v1alpha1_InstanceProfile(in *aws.InstancePro
 	return autoConvert_aws_InstanceProfile_To_v1alpha1_InstanceProfile(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineLifecycle_To_aws_MachineLifecycle(in *MachineLifecycle, out *aws.MachineLifecycle, s conversion.Scope) error {
+	out.MinReadySeconds = (*int32)(unsafe.Pointer(in.MinReadySeconds))
+	out.DrainTimeout = (*v1.Duration)(unsafe.Pointer(in.DrainTimeout))
+	out.HealthTimeout = (*v1.Duration)(unsafe.Pointer(in.HealthTimeout))
+	out.CreationTimeout = (*v1.Duration)(unsafe.Pointer(in.CreationTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_MachineLifecycle_To_aws_MachineLifecycle is an autogenerated conversion function.
+func Convert_v1alpha1_MachineLifecycle_To_aws_MachineLifecycle(in *MachineLifecycle, out *aws.MachineLifecycle, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineLifecycle_To_aws_MachineLifecycle(in, out, s)
+}
+
+func autoConvert_aws_MachineLifecycle_To_v1alpha1_MachineLifecycle(in *aws.MachineLifecycle, out *MachineLifecycle, s conversion.Scope) error {
+	out.MinReadySeconds = (*int32)(unsafe.Pointer(in.MinReadySeconds))
+	out.DrainTimeout = (*v1.Duration)(unsafe.Pointer(in.DrainTimeout))
+	out.HealthTimeout = (*v1.Duration)(unsafe.Pointer(in.HealthTimeout))
+	out.CreationTimeout = (*v1.Duration)(unsafe.Pointer(in.CreationTimeout))
+	return nil
+}
+
+// Convert_aws_MachineLifecycle_To_v1alpha1_MachineLifecycle is an autogenerated conversion function.
+func Convert_aws_MachineLifecycle_To_v1alpha1_MachineLifecycle(in *aws.MachineLifecycle, out *MachineLifecycle, s conversion.Scope) error {
+	return autoConvert_aws_MachineLifecycle_To_v1alpha1_MachineLifecycle(in, out, s)
+}
+
+func autoConvert_v1alpha1_NATGateway_To_aws_NATGateway(in *NATGateway, out *aws.NATGateway, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.ElasticIPAllocationID = in.ElasticIPAllocationID
+	out.PublicIP = in.PublicIP
+	return nil
+}
+
+// Convert_v1alpha1_NATGateway_To_aws_NATGateway is an autogenerated conversion function.
+func Convert_v1alpha1_NATGateway_To_aws_NATGateway(in *NATGateway, out *aws.NATGateway, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGateway_To_aws_NATGateway(in, out, s)
+}
+
+func autoConvert_aws_NATGateway_To_v1alpha1_NATGateway(in *aws.NATGateway, out *NATGateway, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.ElasticIPAllocationID = in.ElasticIPAllocationID
+	out.PublicIP = in.PublicIP
+	return nil
+}
+
+// Convert_aws_NATGateway_To_v1alpha1_NATGateway is an autogenerated conversion function.
+func Convert_aws_NATGateway_To_v1alpha1_NATGateway(in *aws.NATGateway, out *NATGateway, s conversion.Scope) error {
+	return autoConvert_aws_NATGateway_To_v1alpha1_NATGateway(in, out, s)
+}
+
 func autoConvert_v1alpha1_Networks_To_aws_Networks(in *Networks, out *aws.Networks, s conversion.Scope) error {
 	if err := Convert_v1alpha1_VPC_To_aws_VPC(&in.VPC, &out.VPC, s); err != nil {
 		return err
@@ -441,6 +626,7 @@ func Convert_aws_Subnet_To_v1alpha1_Subnet(in *aws.Subnet, out *Subnet, s conver
 func autoConvert_v1alpha1_VPC_To_aws_VPC(in *VPC, out *aws.VPC, s conversion.Scope) error {
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.CIDR = (*core.CIDR)(unsafe.Pointer(in.CIDR))
+	out.GatewayEndpoints = *(*[]string)(unsafe.Pointer(&in.GatewayEndpoints))
 	return nil
 }
 
@@ -452,6 +638,7 @@ func Convert_v1alpha1_VPC_To_aws_VPC(in *VPC, out *aws.VPC, s conversion.Scope)
 func autoConvert_aws_VPC_To_v1alpha1_VPC(in *aws.VPC, out *VPC, s conversion.Scope) error {
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.CIDR = (*corev1alpha1.CIDR)(unsafe.Pointer(in.CIDR))
+	out.GatewayEndpoints = *(*[]string)(unsafe.Pointer(&in.GatewayEndpoints))
 	return nil
 }
 
@@ -464,6 +651,7 @@ func autoConvert_v1alpha1_VPCStatus_To_aws_VPCStatus(in *VPCStatus, out *aws.VPC
 	out.ID = in.ID
 	out.Subnets = *(*[]aws.Subnet)(unsafe.Pointer(&in.Subnets))
 	out.SecurityGroups = *(*[]aws.SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.NATGateways = *(*[]aws.NATGateway)(unsafe.Pointer(&in.NATGateways))
 	return nil
 }
 
@@ -476,6 +664,7 @@ func autoConvert_aws_VPCStatus_To_v1alpha1_VPCStatus(in *aws.VPCStatus, out *VPC
 	out.ID = in.ID
 	out.Subnets = *(*[]Subnet)(unsafe.Pointer(&in.Subnets))
 	out.SecurityGroups = *(*[]SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.NATGateways = *(*[]NATGateway)(unsafe.Pointer(&in.NATGateways))
 	return nil
 }
 
@@ -484,11 +673,65 @@ func Convert_aws_VPCStatus_To_v1alpha1_VPCStatus(in *aws.VPCStatus, out *VPCStat
 	return autoConvert_aws_VPCStatus_To_v1alpha1_VPCStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_Volume_To_aws_Volume(in *Volume, out *aws.Volume, s conversion.Scope) error {
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_v1alpha1_Volume_To_aws_Volume is an autogenerated conversion function.
+func Convert_v1alpha1_Volume_To_aws_Volume(in *Volume, out *aws.Volume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Volume_To_aws_Volume(in, out, s)
+}
+
+func autoConvert_aws_Volume_To_v1alpha1_Volume(in *aws.Volume, out *Volume, s conversion.Scope) error {
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_aws_Volume_To_v1alpha1_Volume is an autogenerated conversion function.
+func Convert_aws_Volume_To_v1alpha1_Volume(in *aws.Volume, out *Volume, s conversion.Scope) error {
+	return autoConvert_aws_Volume_To_v1alpha1_Volume(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerConfig_To_aws_WorkerConfig(in *WorkerConfig, out *aws.WorkerConfig, s conversion.Scope) error {
+	out.Volume = (*aws.Volume)(unsafe.Pointer(in.Volume))
+	out.DataVolumes = *(*[]aws.DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.InstanceMetadataOptions = (*aws.InstanceMetadataOptions)(unsafe.Pointer(in.InstanceMetadataOptions))
+	out.MachineLifecycle = (*aws.MachineLifecycle)(unsafe.Pointer(in.MachineLifecycle))
+	out.ZoneWeights = *(*[]aws.ZoneWeight)(unsafe.Pointer(&in.ZoneWeights))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_aws_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_aws_WorkerConfig(in *WorkerConfig, out *aws.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_aws_WorkerConfig(in, out, s)
+}
+
+func autoConvert_aws_WorkerConfig_To_v1alpha1_WorkerConfig(in *aws.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.Volume = (*Volume)(unsafe.Pointer(in.Volume))
+	out.DataVolumes = *(*[]DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.InstanceMetadataOptions = (*InstanceMetadataOptions)(unsafe.Pointer(in.InstanceMetadataOptions))
+	out.MachineLifecycle = (*MachineLifecycle)(unsafe.Pointer(in.MachineLifecycle))
+	out.ZoneWeights = *(*[]ZoneWeight)(unsafe.Pointer(&in.ZoneWeights))
+	return nil
+}
+
+// Convert_aws_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_aws_WorkerConfig_To_v1alpha1_WorkerConfig(in *aws.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_aws_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_Zone_To_aws_Zone(in *Zone, out *aws.Zone, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Internal = core.CIDR(in.Internal)
 	out.Public = core.CIDR(in.Public)
 	out.Workers = core.CIDR(in.Workers)
+	out.ElasticIPAllocationID = (*string)(unsafe.Pointer(in.ElasticIPAllocationID))
+	out.Subnets = (*aws.ZoneSubnets)(unsafe.Pointer(in.Subnets))
 	return nil
 }
 
@@ -502,6 +745,8 @@ func autoConvert_aws_Zone_To_v1alpha1_Zone(in *aws.Zone, out *Zone, s conversion
 	out.Internal = corev1alpha1.CIDR(in.Internal)
 	out.Public = corev1alpha1.CIDR(in.Public)
 	out.Workers = corev1alpha1.CIDR(in.Workers)
+	out.ElasticIPAllocationID = (*string)(unsafe.Pointer(in.ElasticIPAllocationID))
+	out.Subnets = (*ZoneSubnets)(unsafe.Pointer(in.Subnets))
 	return nil
 }
 
@@ -509,3 +754,49 @@ func autoConvert_aws_Zone_To_v1alpha1_Zone(in *aws.Zone, out *Zone, s conversion
 func Convert_aws_Zone_To_v1alpha1_Zone(in *aws.Zone, out *Zone, s conversion.Scope) error {
 	return autoConvert_aws_Zone_To_v1alpha1_Zone(in, out, s)
 }
+
+func autoConvert_v1alpha1_ZoneSubnets_To_aws_ZoneSubnets(in *ZoneSubnets, out *aws.ZoneSubnets, s conversion.Scope) error {
+	out.Internal = (*string)(unsafe.Pointer(in.Internal))
+	out.Public = (*string)(unsafe.Pointer(in.Public))
+	out.Workers = (*string)(unsafe.Pointer(in.Workers))
+	return nil
+}
+
+// Convert_v1alpha1_ZoneSubnets_To_aws_ZoneSubnets is an autogenerated conversion function.
+func Convert_v1alpha1_ZoneSubnets_To_aws_ZoneSubnets(in *ZoneSubnets, out *aws.ZoneSubnets, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ZoneSubnets_To_aws_ZoneSubnets(in, out, s)
+}
+
+func autoConvert_aws_ZoneSubnets_To_v1alpha1_ZoneSubnets(in *aws.ZoneSubnets, out *ZoneSubnets, s conversion.Scope) error {
+	out.Internal = (*string)(unsafe.Pointer(in.Internal))
+	out.Public = (*string)(unsafe.Pointer(in.Public))
+	out.Workers = (*string)(unsafe.Pointer(in.Workers))
+	return nil
+}
+
+// Convert_aws_ZoneSubnets_To_v1alpha1_ZoneSubnets is an autogenerated conversion function.
+func Convert_aws_ZoneSubnets_To_v1alpha1_ZoneSubnets(in *aws.ZoneSubnets, out *ZoneSubnets, s conversion.Scope) error {
+	return autoConvert_aws_ZoneSubnets_To_v1alpha1_ZoneSubnets(in, out, s)
+}
+
+func autoConvert_v1alpha1_ZoneWeight_To_aws_ZoneWeight(in *ZoneWeight, out *aws.ZoneWeight, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1alpha1_ZoneWeight_To_aws_ZoneWeight is an autogenerated conversion function.
+func Convert_v1alpha1_ZoneWeight_To_aws_ZoneWeight(in *ZoneWeight, out *aws.ZoneWeight, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ZoneWeight_To_aws_ZoneWeight(in, out, s)
+}
+
+func autoConvert_aws_ZoneWeight_To_v1alpha1_ZoneWeight(in *aws.ZoneWeight, out *ZoneWeight, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_aws_ZoneWeight_To_v1alpha1_ZoneWeight is an autogenerated conversion function.
+func Convert_aws_ZoneWeight_To_v1alpha1_ZoneWeight(in *aws.ZoneWeight, out *ZoneWeight, s conversion.Scope) error {
+	return autoConvert_aws_ZoneWeight_To_v1alpha1_ZoneWeight(in, out, s)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	cidrvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// supportedGatewayEndpoints are the AWS services for which VPC gateway endpoints can be created.
+var supportedGatewayEndpoints = sets.NewString("dynamodb", "s3")
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object.
+func ValidateInfrastructureConfig(infra *apisaws.InfrastructureConfig) field.ErrorList {
+	var (
+		allErrs      = field.ErrorList{}
+		networksPath = field.NewPath("networks")
+		vpcPath      = networksPath.Child("vpc")
+		zonesPath    = networksPath.Child("zones")
+		vpcCIDR      *cidrvalidation.CIDR
+	)
+
+	switch {
+	case infra.Networks.VPC.ID != nil && infra.Networks.VPC.CIDR != nil:
+		allErrs = append(allErrs, field.Forbidden(vpcPath.Child("cidr"), "must not be set if an existing VPC id is given"))
+	case infra.Networks.VPC.ID != nil:
+		if len(*infra.Networks.VPC.ID) == 0 {
+			allErrs = append(allErrs, field.Required(vpcPath.Child("id"), "must not be empty"))
+		}
+	case infra.Networks.VPC.CIDR != nil:
+		vpcCIDR = cidrvalidation.NewCIDR(string(*infra.Networks.VPC.CIDR), vpcPath.Child("cidr"))
+		allErrs = append(allErrs, vpcCIDR.ValidateParse()...)
+	default:
+		allErrs = append(allErrs, field.Required(vpcPath, "either an existing VPC id or a CIDR for a new VPC must be given"))
+	}
+
+	gatewayEndpoints := sets.NewString()
+	for i, service := range infra.Networks.VPC.GatewayEndpoints {
+		gatewayEndpointPath := vpcPath.Child("gatewayEndpoints").Index(i)
+		if !supportedGatewayEndpoints.Has(service) {
+			allErrs = append(allErrs, field.NotSupported(gatewayEndpointPath, service, supportedGatewayEndpoints.List()))
+		} else if gatewayEndpoints.Has(service) {
+			allErrs = append(allErrs, field.Duplicate(gatewayEndpointPath, service))
+		}
+		gatewayEndpoints.Insert(service)
+	}
+
+	if len(infra.Networks.Zones) == 0 {
+		allErrs = append(allErrs, field.Required(zonesPath, "must specify at least one zone"))
+	}
+
+	var (
+		zoneNames     = sets.NewString()
+		allocationIDs = sets.NewString()
+		cidrs         []*cidrvalidation.CIDR
+	)
+	for i, zone := range infra.Networks.Zones {
+		zonePath := zonesPath.Index(i)
+
+		if len(zone.Name) == 0 {
+			allErrs = append(allErrs, field.Required(zonePath.Child("name"), "must specify the zone name"))
+		} else if zoneNames.Has(zone.Name) {
+			allErrs = append(allErrs, field.Duplicate(zonePath.Child("name"), zone.Name))
+		}
+		zoneNames.Insert(zone.Name)
+
+		if zone.ElasticIPAllocationID != nil {
+			if len(*zone.ElasticIPAllocationID) == 0 {
+				allErrs = append(allErrs, field.Required(zonePath.Child("elasticIPAllocationID"), "must not be empty"))
+			} else if allocationIDs.Has(*zone.ElasticIPAllocationID) {
+				allErrs = append(allErrs, field.Duplicate(zonePath.Child("elasticIPAllocationID"), *zone.ElasticIPAllocationID))
+			}
+			allocationIDs.Insert(*zone.ElasticIPAllocationID)
+		}
+
+		if zone.Subnets != nil {
+			subnetsPath := zonePath.Child("subnets")
+			if infra.Networks.VPC.ID == nil {
+				allErrs = append(allErrs, field.Forbidden(subnetsPath, "existing subnets can only be used together with an existing VPC"))
+			}
+			allErrs = append(allErrs, validateSubnetID(zone.Subnets.Internal, subnetsPath.Child("internal"))...)
+			allErrs = append(allErrs, validateSubnetID(zone.Subnets.Public, subnetsPath.Child("public"))...)
+			allErrs = append(allErrs, validateSubnetID(zone.Subnets.Workers, subnetsPath.Child("workers"))...)
+		}
+
+		internalCIDR := cidrvalidation.NewCIDR(string(zone.Internal), zonePath.Child("internal"))
+		publicCIDR := cidrvalidation.NewCIDR(string(zone.Public), zonePath.Child("public"))
+		workersCIDR := cidrvalidation.NewCIDR(string(zone.Workers), zonePath.Child("workers"))
+
+		allErrs = append(allErrs, internalCIDR.ValidateParse()...)
+		allErrs = append(allErrs, publicCIDR.ValidateParse()...)
+		allErrs = append(allErrs, workersCIDR.ValidateParse()...)
+
+		cidrs = append(cidrs, internalCIDR, publicCIDR, workersCIDR)
+	}
+
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDRsNotOverlap(cidrs...)...)
+
+	if vpcCIDR != nil {
+		allErrs = append(allErrs, vpcCIDR.ValidateSubset(cidrs...)...)
+	}
+
+	return allErrs
+}
+
+func validateSubnetID(id *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if id != nil && len(*id) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "must not be empty"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/validation"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		infrastructureConfig *apisaws.InfrastructureConfig
+
+		vpcCIDR = gardencore.CIDR("10.0.0.0/8")
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisaws.InfrastructureConfig{
+			Networks: apisaws.Networks{
+				VPC: apisaws.VPC{
+					CIDR: &vpcCIDR,
+				},
+				Zones: []apisaws.Zone{
+					{
+						Name:     "eu-west-1a",
+						Internal: "10.250.112.0/22",
+						Public:   "10.250.96.0/22",
+						Workers:  "10.250.0.0/19",
+					},
+					{
+						Name:     "eu-west-1b",
+						Internal: "10.250.116.0/22",
+						Public:   "10.250.100.0/22",
+						Workers:  "10.250.32.0/19",
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should accept a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid specifying both a VPC id and a VPC CIDR", func() {
+			id := "vpc-123456"
+			infrastructureConfig.Networks.VPC.ID = &id
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.vpc.cidr"),
+			}))))
+		})
+
+		It("should require either a VPC id or a VPC CIDR", func() {
+			infrastructureConfig.Networks.VPC.CIDR = nil
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.vpc"),
+			}))))
+		})
+
+		It("should require at least one zone", func() {
+			infrastructureConfig.Networks.Zones = nil
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.zones"),
+			}))))
+		})
+
+		It("should forbid duplicate zone names", func() {
+			infrastructureConfig.Networks.Zones[1].Name = infrastructureConfig.Networks.Zones[0].Name
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("networks.zones[1].name"),
+			}))))
+		})
+
+		It("should forbid invalid CIDRs", func() {
+			infrastructureConfig.Networks.Zones[0].Public = "not-a-cidr"
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].public"),
+			}))))
+		})
+
+		It("should forbid overlapping zone CIDRs", func() {
+			infrastructureConfig.Networks.Zones[1].Workers = "10.250.16.0/20"
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[1].workers"),
+			}))))
+		})
+
+		It("should forbid zone CIDRs outside of the VPC CIDR", func() {
+			infrastructureConfig.Networks.Zones[0].Workers = "192.168.0.0/19"
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].workers"),
+			}))))
+		})
+
+		It("should forbid unsupported and duplicate gateway endpoints", func() {
+			infrastructureConfig.Networks.VPC.GatewayEndpoints = []string{"s3", "ec2", "s3"}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("networks.vpc.gatewayEndpoints[1]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("networks.vpc.gatewayEndpoints[2]"),
+			}))))
+		})
+
+		It("should forbid empty and duplicate elastic IP allocation ids", func() {
+			allocationID, empty := "eipalloc-123456", ""
+			infrastructureConfig.Networks.Zones[0].ElasticIPAllocationID = &allocationID
+			infrastructureConfig.Networks.Zones[1].ElasticIPAllocationID = &allocationID
+			infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, apisaws.Zone{
+				Name:                  "eu-west-1c",
+				Internal:              "10.250.120.0/22",
+				Public:                "10.250.104.0/22",
+				Workers:               "10.250.64.0/19",
+				ElasticIPAllocationID: &empty,
+			})
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("networks.zones[1].elasticIPAllocationID"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.zones[2].elasticIPAllocationID"),
+			}))))
+		})
+
+		It("should allow existing subnets in an existing VPC", func() {
+			vpcID, subnetID := "vpc-123456", "subnet-123456"
+			infrastructureConfig.Networks.VPC = apisaws.VPC{ID: &vpcID}
+			infrastructureConfig.Networks.Zones[0].Subnets = &apisaws.ZoneSubnets{Workers: &subnetID}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid existing subnets without an existing VPC", func() {
+			subnetID, empty := "subnet-123456", ""
+			infrastructureConfig.Networks.Zones[0].Subnets = &apisaws.ZoneSubnets{Workers: &subnetID, Public: &empty}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.zones[0].subnets"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.zones[0].subnets.public"),
+			}))))
+		})
+	})
+})
@@ -0,0 +1,155 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// VolumeTypeIO1 is the EBS volume type with provisioned IOPS.
+	VolumeTypeIO1 = "io1"
+
+	minHTTPPutResponseHopLimit = 1
+	maxHTTPPutResponseHopLimit = 64
+)
+
+var supportedHTTPTokens = sets.NewString(string(apisaws.HTTPTokensRequired), string(apisaws.HTTPTokensOptional))
+
+// ValidateWorkerConfig validates a WorkerConfig object. The given root volume type and zones are the type of the
+// root disk and the zones of the worker pool the configuration belongs to.
+func ValidateWorkerConfig(workerConfig *apisaws.WorkerConfig, rootVolumeType string, zones []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig.Volume != nil {
+		allErrs = append(allErrs, validateVolume(workerConfig.Volume, rootVolumeType, fldPath.Child("volume"))...)
+	}
+
+	var (
+		names       = sets.NewString()
+		deviceNames = sets.NewString()
+	)
+	for i, dataVolume := range workerConfig.DataVolumes {
+		dataVolumePath := fldPath.Child("dataVolumes").Index(i)
+
+		if len(dataVolume.Name) == 0 {
+			allErrs = append(allErrs, field.Required(dataVolumePath.Child("name"), "must specify the data volume name"))
+		} else if names.Has(dataVolume.Name) {
+			allErrs = append(allErrs, field.Duplicate(dataVolumePath.Child("name"), dataVolume.Name))
+		}
+		names.Insert(dataVolume.Name)
+
+		if quantity, err := resource.ParseQuantity(dataVolume.Size); err != nil {
+			allErrs = append(allErrs, field.Invalid(dataVolumePath.Child("size"), dataVolume.Size, fmt.Sprintf("must be a valid quantity: %v", err)))
+		} else if quantity.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(dataVolumePath.Child("size"), dataVolume.Size, "must be greater than 0"))
+		}
+
+		if len(dataVolume.Type) == 0 {
+			allErrs = append(allErrs, field.Required(dataVolumePath.Child("type"), "must specify the data volume type"))
+		}
+
+		if dataVolume.DeviceName != nil {
+			if !strings.HasPrefix(*dataVolume.DeviceName, "/dev/") {
+				allErrs = append(allErrs, field.Invalid(dataVolumePath.Child("deviceName"), *dataVolume.DeviceName, "must start with /dev/"))
+			} else if deviceNames.Has(*dataVolume.DeviceName) {
+				allErrs = append(allErrs, field.Duplicate(dataVolumePath.Child("deviceName"), *dataVolume.DeviceName))
+			}
+			deviceNames.Insert(*dataVolume.DeviceName)
+		}
+
+		allErrs = append(allErrs, validateVolume(&dataVolume.Volume, dataVolume.Type, dataVolumePath)...)
+	}
+
+	if options := workerConfig.InstanceMetadataOptions; options != nil {
+		optionsPath := fldPath.Child("instanceMetadataOptions")
+
+		if options.HTTPTokens != nil && !supportedHTTPTokens.Has(string(*options.HTTPTokens)) {
+			allErrs = append(allErrs, field.NotSupported(optionsPath.Child("httpTokens"), *options.HTTPTokens, supportedHTTPTokens.List()))
+		}
+		if limit := options.HTTPPutResponseHopLimit; limit != nil && (*limit < minHTTPPutResponseHopLimit || *limit > maxHTTPPutResponseHopLimit) {
+			allErrs = append(allErrs, field.Invalid(optionsPath.Child("httpPutResponseHopLimit"), *limit, fmt.Sprintf("must be between %d and %d", minHTTPPutResponseHopLimit, maxHTTPPutResponseHopLimit)))
+		}
+	}
+
+	if lifecycle := workerConfig.MachineLifecycle; lifecycle != nil {
+		lifecyclePath := fldPath.Child("machineLifecycle")
+
+		if lifecycle.MinReadySeconds != nil && *lifecycle.MinReadySeconds < 0 {
+			allErrs = append(allErrs, field.Invalid(lifecyclePath.Child("minReadySeconds"), *lifecycle.MinReadySeconds, "must not be negative"))
+		}
+		for name, timeout := range map[string]*metav1.Duration{
+			"drainTimeout":    lifecycle.DrainTimeout,
+			"healthTimeout":   lifecycle.HealthTimeout,
+			"creationTimeout": lifecycle.CreationTimeout,
+		} {
+			if timeout != nil && timeout.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(lifecyclePath.Child(name), timeout.Duration.String(), "must be a positive duration"))
+			}
+		}
+	}
+
+	var (
+		poolZones     = sets.NewString(zones...)
+		weightedZones = sets.NewString()
+	)
+	for i, zoneWeight := range workerConfig.ZoneWeights {
+		zoneWeightPath := fldPath.Child("zoneWeights").Index(i)
+
+		if !poolZones.Has(zoneWeight.Zone) {
+			allErrs = append(allErrs, field.NotSupported(zoneWeightPath.Child("zone"), zoneWeight.Zone, zones))
+		} else if weightedZones.Has(zoneWeight.Zone) {
+			allErrs = append(allErrs, field.Duplicate(zoneWeightPath.Child("zone"), zoneWeight.Zone))
+		}
+		weightedZones.Insert(zoneWeight.Zone)
+
+		if zoneWeight.Weight <= 0 {
+			allErrs = append(allErrs, field.Invalid(zoneWeightPath.Child("weight"), zoneWeight.Weight, "must be greater than 0"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateVolume(volume *apisaws.Volume, volumeType string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if volume.IOPS != nil {
+		if volumeType != VolumeTypeIO1 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("iops"), fmt.Sprintf("is only allowed for volumes of type %s", VolumeTypeIO1)))
+		} else if *volume.IOPS <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("iops"), *volume.IOPS, "must be greater than 0"))
+		}
+	}
+
+	if volume.KMSKeyID != nil {
+		if len(*volume.KMSKeyID) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("kmsKeyID"), "must not be empty"))
+		}
+		if volume.Encrypted != nil && !*volume.Encrypted {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("kmsKeyID"), "must not be set for unencrypted volumes"))
+		}
+	}
+
+	return allErrs
+}
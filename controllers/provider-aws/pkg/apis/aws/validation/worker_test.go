@@ -0,0 +1,190 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	"time"
+
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("WorkerConfig validation", func() {
+	var (
+		workerConfig *apisaws.WorkerConfig
+		fldPath      = field.NewPath("config")
+
+		iops       = int64(1000)
+		kmsKeyID   = "arn:aws:kms:eu-west-1:123456789012:key/abc"
+		deviceName = "/dev/sdg"
+		httpTokens = apisaws.HTTPTokensRequired
+		hopLimit   = int64(2)
+		zones      = []string{"eu-west-1a", "eu-west-1b"}
+	)
+
+	BeforeEach(func() {
+		workerConfig = &apisaws.WorkerConfig{
+			Volume: &apisaws.Volume{
+				IOPS:     &iops,
+				KMSKeyID: &kmsKeyID,
+			},
+			DataVolumes: []apisaws.DataVolume{
+				{
+					Name:       "data",
+					Size:       "50Gi",
+					Type:       "gp2",
+					DeviceName: &deviceName,
+				},
+			},
+			InstanceMetadataOptions: &apisaws.InstanceMetadataOptions{
+				HTTPTokens:              &httpTokens,
+				HTTPPutResponseHopLimit: &hopLimit,
+			},
+			ZoneWeights: []apisaws.ZoneWeight{
+				{Zone: "eu-west-1a", Weight: 2},
+			},
+		}
+	})
+
+	Describe("#ValidateWorkerConfig", func() {
+		It("should accept a valid configuration", func() {
+			Expect(ValidateWorkerConfig(workerConfig, "io1", zones, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid IOPS for volumes which are not of type io1", func() {
+			errorList := ValidateWorkerConfig(workerConfig, "gp2", zones, fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("config.volume.iops"),
+			}))))
+		})
+
+		It("should forbid a KMS key for unencrypted volumes", func() {
+			encrypted := false
+			workerConfig.Volume.Encrypted = &encrypted
+
+			errorList := ValidateWorkerConfig(workerConfig, "io1", zones, fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("config.volume.kmsKeyID"),
+			}))))
+		})
+
+		It("should forbid invalid data volumes", func() {
+			invalidDeviceName := "sdh"
+			workerConfig.DataVolumes = append(workerConfig.DataVolumes,
+				apisaws.DataVolume{Name: "data", Size: "foo", Type: "gp2"},
+				apisaws.DataVolume{Name: "other", Size: "10Gi", DeviceName: &invalidDeviceName},
+			)
+
+			errorList := ValidateWorkerConfig(workerConfig, "io1", zones, fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("config.dataVolumes[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.dataVolumes[1].size"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.dataVolumes[2].type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.dataVolumes[2].deviceName"),
+				})),
+			))
+		})
+
+		It("should forbid invalid machine lifecycle settings", func() {
+			minReadySeconds := int32(-1)
+			workerConfig.MachineLifecycle = &apisaws.MachineLifecycle{
+				MinReadySeconds: &minReadySeconds,
+				DrainTimeout:    &metav1.Duration{Duration: time.Hour},
+				HealthTimeout:   &metav1.Duration{Duration: -time.Minute},
+			}
+
+			errorList := ValidateWorkerConfig(workerConfig, "io1", zones, fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.machineLifecycle.minReadySeconds"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.machineLifecycle.healthTimeout"),
+				})),
+			))
+		})
+
+		It("should forbid invalid instance metadata options", func() {
+			invalidHTTPTokens := apisaws.HTTPTokensValue("foo")
+			invalidHopLimit := int64(65)
+			workerConfig.InstanceMetadataOptions.HTTPTokens = &invalidHTTPTokens
+			workerConfig.InstanceMetadataOptions.HTTPPutResponseHopLimit = &invalidHopLimit
+
+			errorList := ValidateWorkerConfig(workerConfig, "io1", zones, fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.instanceMetadataOptions.httpTokens"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.instanceMetadataOptions.httpPutResponseHopLimit"),
+				})),
+			))
+		})
+
+		It("should forbid invalid zone weights", func() {
+			workerConfig.ZoneWeights = []apisaws.ZoneWeight{
+				{Zone: "eu-west-1a", Weight: 1},
+				{Zone: "eu-west-1a", Weight: 0},
+				{Zone: "eu-west-1c", Weight: 1},
+			}
+
+			errorList := ValidateWorkerConfig(workerConfig, "io1", zones, fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("config.zoneWeights[1].zone"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.zoneWeights[1].weight"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.zoneWeights[2].zone"),
+				})),
+			))
+		})
+
+	})
+})
@@ -15,9 +15,13 @@
 package helper
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/config"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/config/v1alpha1"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 )
 
 // FindAMIForRegion takes a list of machine images, and the desired image name, version, and region. It tries
@@ -38,3 +42,44 @@ func FindAMIForRegion(machineImages []config.MachineImage, imageName, version, r
 
 	return "", fmt.Errorf("could not find an AMI for region %q and machine image %q in version %q", regionName, imageName, version)
 }
+
+// AnnotationMachineImages is the annotation on a CloudProfile containing a versioned mapping of machine images to
+// AMIs in the format of the `machineImages` of the controller configuration. The AWS section of the CloudProfile
+// does not version its machine images, hence it cannot serve this purpose.
+const AnnotationMachineImages = "aws.provider.extensions.gardener.cloud/machine-images"
+
+// MachineImagesFromCloudProfile decodes the versioned machine images of the given CloudProfile (see
+// AnnotationMachineImages). It returns nil if the CloudProfile has none.
+func MachineImagesFromCloudProfile(cloudProfile *gardenv1beta1.CloudProfile) ([]config.MachineImage, error) {
+	if cloudProfile == nil {
+		return nil, nil
+	}
+	data, ok := cloudProfile.Annotations[AnnotationMachineImages]
+	if !ok {
+		return nil, nil
+	}
+
+	var versionedMachineImages []v1alpha1.MachineImage
+	if err := json.Unmarshal([]byte(data), &versionedMachineImages); err != nil {
+		return nil, fmt.Errorf("could not decode the machine images of cloud profile %q: %v", cloudProfile.Name, err)
+	}
+
+	machineImages := make([]config.MachineImage, len(versionedMachineImages))
+	for i := range versionedMachineImages {
+		if err := v1alpha1.Convert_v1alpha1_MachineImage_To_config_MachineImage(&versionedMachineImages[i], &machineImages[i], nil); err != nil {
+			return nil, err
+		}
+	}
+	return machineImages, nil
+}
+
+// FindAMIForRegionFromCloudProfile takes a CloudProfile, an image name, a version and a region name and tries to find
+// the AMI of the machine image in the given region in the versioned machine images of the CloudProfile (see
+// AnnotationMachineImages). If it cannot be found then an error is returned.
+func FindAMIForRegionFromCloudProfile(cloudProfile *gardenv1beta1.CloudProfile, imageName, version, regionName string) (string, error) {
+	machineImages, err := MachineImagesFromCloudProfile(cloudProfile)
+	if err != nil {
+		return "", err
+	}
+	return FindAMIForRegion(machineImages, imageName, version, regionName)
+}
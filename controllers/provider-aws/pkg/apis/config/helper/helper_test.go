@@ -18,9 +18,11 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/config"
 	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/config/helper"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("Helper", func() {
@@ -43,6 +45,26 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found (region does not exist)", makeMachineImages("ubuntu", "1", "asia", "0"), "ubuntu", "1", "europe", ""),
 		Entry("entry", makeMachineImages("ubuntu", "1", "europe", "ami-1234"), "ubuntu", "1", "europe", "ami-1234"),
 	)
+
+	DescribeTable("#FindAMIForRegionFromCloudProfile",
+		func(cloudProfile *gardenv1beta1.CloudProfile, imageName, version, regionName string, expectedAMI string) {
+			ami, err := FindAMIForRegionFromCloudProfile(cloudProfile, imageName, version, regionName)
+
+			Expect(ami).To(Equal(expectedAMI))
+			if expectedAMI != "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+
+		Entry("cloud profile is nil", nil, "ubuntu", "1", "europe", ""),
+		Entry("machine images annotation is missing", &gardenv1beta1.CloudProfile{}, "ubuntu", "1", "europe", ""),
+		Entry("machine images annotation is invalid", makeCloudProfile(`{`), "ubuntu", "1", "europe", ""),
+		Entry("entry not found (image does not exist)", makeCloudProfile(`[{"name":"debian","version":"1","regions":[{"name":"europe","ami":"ami-1234"}]}]`), "ubuntu", "1", "europe", ""),
+		Entry("entry not found (version does not exist)", makeCloudProfile(`[{"name":"ubuntu","version":"2","regions":[{"name":"europe","ami":"ami-1234"}]}]`), "ubuntu", "1", "europe", ""),
+		Entry("entry", makeCloudProfile(`[{"name":"ubuntu","version":"1","regions":[{"name":"europe","ami":"ami-1234"}]}]`), "ubuntu", "1", "europe", "ami-1234"),
+	)
 })
 
 func makeMachineImages(name, version, region, ami string) []config.MachineImage {
@@ -62,3 +84,12 @@ func makeMachineImages(name, version, region, ami string) []config.MachineImage
 		},
 	}
 }
+
+func makeCloudProfile(machineImages string) *gardenv1beta1.CloudProfile {
+	return &gardenv1beta1.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "cloud-profile",
+			Annotations: map[string]string{AnnotationMachineImages: machineImages},
+		},
+	}
+}
@@ -61,6 +61,10 @@ const (
 	NodesRole = "nodes_role_arn"
 	// BastionsRole role for bastions
 	BastionsRole = "bastions_role_arn"
+	// NATGatewayIPPrefix is the prefix for the public IPs of the NAT gateways
+	NATGatewayIPPrefix = "nat_gateway_ip_z"
+	// NATGatewayEIPAllocationIDPrefix is the prefix for the elastic IP allocation ids of the NAT gateways
+	NATGatewayEIPAllocationIDPrefix = "nat_gateway_eip_allocation_id_z"
 
 	// CloudProviderConfigName is the name of the configmap containing the cloud provider config.
 	CloudProviderConfigName = "cloud-provider-config"
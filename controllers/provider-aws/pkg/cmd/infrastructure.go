@@ -0,0 +1,58 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/spf13/pflag"
+)
+
+// NativeReconcilerFlag is the name of the command line flag to specify whether the infrastructure is reconciled by
+// calling the AWS API directly instead of running Terraformer.
+const NativeReconcilerFlag = "native-reconciler"
+
+// InfrastructureOptions are command line options for the AWS infrastructure controller.
+type InfrastructureOptions struct {
+	// NativeReconciler defines whether the infrastructure is reconciled by calling the AWS API directly.
+	NativeReconciler bool
+
+	config *InfrastructureConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (i *InfrastructureOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&i.NativeReconciler, NativeReconcilerFlag, i.NativeReconciler, "Reconcile the infrastructure by calling the AWS API directly instead of running Terraformer (experimental).")
+}
+
+// Complete implements Completer.Complete.
+func (i *InfrastructureOptions) Complete() error {
+	i.config = &InfrastructureConfig{i.NativeReconciler}
+	return nil
+}
+
+// Completed returns the completed InfrastructureConfig. Only call this if `Complete` was successful.
+func (i *InfrastructureOptions) Completed() *InfrastructureConfig {
+	return i.config
+}
+
+// InfrastructureConfig is a completed infrastructure controller configuration.
+type InfrastructureConfig struct {
+	// NativeReconciler defines whether the infrastructure is reconciled by calling the AWS API directly.
+	NativeReconciler bool
+}
+
+// Apply sets the values of this InfrastructureConfig in the given flag.
+func (i *InfrastructureConfig) Apply(useNativeReconciler *bool) {
+	*useNativeReconciler = i.NativeReconciler
+}
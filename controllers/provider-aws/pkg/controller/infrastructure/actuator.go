@@ -21,6 +21,8 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionschartrenderer "github.com/gardener/gardener-extensions/pkg/gardener/chartrenderer"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	glogger "github.com/gardener/gardener/pkg/logger"
@@ -37,6 +39,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
 
+// Actuator is an infrastructure.Actuator that is also able to detect drifts of the infrastructure.
+type Actuator interface {
+	infrastructure.Actuator
+	infrastructure.DriftDetector
+}
+
 type actuator struct {
 	logger logr.Logger
 
@@ -45,12 +53,17 @@ type actuator struct {
 	client  client.Client
 	scheme  *runtime.Scheme
 	decoder runtime.Decoder
+
+	terraformerFactory   extensionsterraformer.Factory
+	chartRendererFactory extensionschartrenderer.Factory
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled Infrastructure resources.
-func NewActuator() infrastructure.Actuator {
+func NewActuator() Actuator {
 	return &actuator{
-		logger: log.Log.WithName("infrastructure-actuator"),
+		logger:               log.Log.WithName("infrastructure-actuator"),
+		terraformerFactory:   extensionsterraformer.DefaultFactory(),
+		chartRendererFactory: extensionschartrenderer.DefaultFactory(),
 	}
 }
 
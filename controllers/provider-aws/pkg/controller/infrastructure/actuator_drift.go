@@ -0,0 +1,64 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/imagevector"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	glogger "github.com/gardener/gardener/pkg/logger"
+)
+
+// DetectDrift implements infrastructure.DriftDetector.
+func (a *actuator) DetectDrift(ctx context.Context, config *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (*infrastructure.Drift, error) {
+	infrastructureConfig, providerSecret, err := a.getConfigAndProviderSecret(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := a.renderTerraformerChart(ctx, config, infrastructureConfig, providerSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	tf, err := a.terraformerFactory.NewForConfig(glogger.NewLogger("info"), a.restConfig, aws.TerraformerPurposeInfra, config.Namespace, config.Name, imagevector.TerraformerImage())
+	if err != nil {
+		return nil, fmt.Errorf("could not create terraformer object: %+v", err)
+	}
+
+	plan, err := tf.
+		SetVariablesEnvironment(generateTerraformInfraVariablesEnvironment(providerSecret)).
+		InitializeWith(a.terraformerFactory.DefaultInitializer(
+			a.client,
+			release.FileContent("main.tf"),
+			release.FileContent("variables.tf"),
+			[]byte(release.FileContent("terraform.tfvars"))),
+		).
+		Plan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !plan.HasChanges {
+		return nil, nil
+	}
+
+	return &infrastructure.Drift{Summary: plan.String()}, nil
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/install"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	mockchartrenderer "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/gardener/chartrenderer"
+	mockterraformer "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/gardener/terraformer"
+	mockgardenerchartrenderer "github.com/gardener/gardener-extensions/pkg/mock/gardener/chartrenderer"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/helm/pkg/manifest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+var _ = Describe("Actuator", func() {
+	var (
+		ctrl *gomock.Controller
+		ctx  context.Context
+
+		c                    *mockclient.MockClient
+		chartRendererFactory *mockchartrenderer.MockFactory
+		chartRenderer        *mockgardenerchartrenderer.MockInterface
+		terraformerFactory   *mockterraformer.MockFactory
+		terraformer          *mockterraformer.MockInterface
+		initializer          *mockterraformer.MockInitializer
+		restConfig           *rest.Config
+		a                    *actuator
+
+		infra *extensionsv1alpha1.Infrastructure
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+
+		c = mockclient.NewMockClient(ctrl)
+		chartRendererFactory = mockchartrenderer.NewMockFactory(ctrl)
+		chartRenderer = mockgardenerchartrenderer.NewMockInterface(ctrl)
+		terraformerFactory = mockterraformer.NewMockFactory(ctrl)
+		terraformer = mockterraformer.NewMockInterface(ctrl)
+		initializer = mockterraformer.NewMockInitializer(ctrl)
+		restConfig = &rest.Config{}
+		a = &actuator{
+			logger:               log.Log.WithName("test"),
+			restConfig:           restConfig,
+			terraformerFactory:   terraformerFactory,
+			chartRendererFactory: chartRendererFactory,
+		}
+
+		scheme := runtime.NewScheme()
+		install.Install(scheme)
+		Expect(a.InjectScheme(scheme)).To(Succeed())
+		Expect(a.InjectClient(c)).To(Succeed())
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "infra"},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				Region:    "eu-west-1",
+				SecretRef: corev1.SecretReference{Namespace: "shoot--foo--bar", Name: "cloudprovider"},
+				ProviderConfig: &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"vpc":{"cidr":"10.250.0.0/16"},"zones":[{"name":"eu-west-1a","internal":"10.250.112.0/22","public":"10.250.96.0/22","workers":"10.250.0.0/19"}]}}`),
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#DetectDrift", func() {
+		expectPlan := func(plan *extensionsterraformer.Plan, err error) {
+			c.EXPECT().Get(ctx, kutil.Key("shoot--foo--bar", "cloudprovider"), gomock.AssignableToTypeOf(&corev1.Secret{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, actual *corev1.Secret) error {
+					actual.Data = map[string][]byte{
+						aws.AccessKeyID:     []byte("access-key-id"),
+						aws.SecretAccessKey: []byte("secret-access-key"),
+					}
+					return nil
+				})
+			chartRendererFactory.EXPECT().NewForConfig(restConfig).Return(chartRenderer, nil)
+			chartRenderer.EXPECT().Render(gomock.Any(), "aws-infra", infra.Namespace, gomock.Any()).Return(&chartrenderer.RenderedChart{
+				ChartName: "aws-infra",
+				Manifests: []manifest.Manifest{
+					{Name: "aws-infra/templates/main.tf", Content: "main"},
+					{Name: "aws-infra/templates/variables.tf", Content: "variables"},
+					{Name: "aws-infra/templates/terraform.tfvars", Content: "tfvars"},
+				},
+			}, nil)
+			terraformerFactory.EXPECT().NewForConfig(gomock.Any(), restConfig, aws.TerraformerPurposeInfra, infra.Namespace, infra.Name, gomock.Any()).Return(terraformer, nil)
+			terraformer.EXPECT().SetVariablesEnvironment(map[string]string{
+				"TF_VAR_ACCESS_KEY_ID":     "access-key-id",
+				"TF_VAR_SECRET_ACCESS_KEY": "secret-access-key",
+			}).Return(terraformer)
+			terraformerFactory.EXPECT().DefaultInitializer(c, "main", "variables", []byte("tfvars")).Return(initializer)
+			terraformer.EXPECT().InitializeWith(initializer).Return(terraformer)
+			terraformer.EXPECT().Plan(ctx).Return(plan, err)
+		}
+
+		It("should report no drift if the plan has no changes", func() {
+			expectPlan(&extensionsterraformer.Plan{}, nil)
+
+			Expect(a.DetectDrift(ctx, infra, nil)).To(BeNil())
+		})
+
+		It("should report the drift if the plan has changes", func() {
+			expectPlan(&extensionsterraformer.Plan{
+				HasChanges: true,
+				Changes:    []string{"~ aws_security_group.nodes"},
+				Summary:    "Plan: 0 to add, 1 to change, 0 to destroy.",
+			}, nil)
+
+			Expect(a.DetectDrift(ctx, infra, nil)).To(Equal(&infrastructure.Drift{
+				Summary: "Plan: 0 to add, 1 to change, 0 to destroy. Changes: ~ aws_security_group.nodes",
+			}))
+		})
+
+		It("should return the error of the plan", func() {
+			planErr := fmt.Errorf("error")
+			expectPlan(nil, planErr)
+
+			_, err := a.DetectDrift(ctx, infra, nil)
+			Expect(err).To(Equal(planErr))
+		})
+	})
+})
@@ -0,0 +1,184 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/controller/infrastructure/native"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	glogger "github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+type nativeActuator struct {
+	*actuator
+}
+
+// NewNativeActuator creates a new Actuator that reconciles the AWS infrastructure by calling the AWS API directly
+// instead of running Terraformer. Resources created by a previous Terraformer run are adopted.
+func NewNativeActuator() infrastructure.Actuator {
+	return &nativeActuator{
+		actuator: &actuator{
+			logger: log.Log.WithName("infrastructure-native-actuator"),
+		},
+	}
+}
+
+func (a *nativeActuator) Reconcile(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	req, providerSecret, err := a.newRequest(ctx, infrastructure)
+	if err != nil {
+		return err
+	}
+
+	reconciler, err := a.newReconciler(infrastructure, providerSecret)
+	if err != nil {
+		return err
+	}
+
+	status, err := reconciler.Reconcile(ctx, req)
+	if err != nil {
+		a.logger.Error(err, "failed to reconcile the infrastructure", "infrastructure", infrastructure.Name)
+		return &controllererrors.RequeueAfterError{
+			Cause:        err,
+			RequeueAfter: 30 * time.Second,
+		}
+	}
+
+	return a.setProviderStatus(ctx, infrastructure, status)
+}
+
+func (a *nativeActuator) Delete(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	req, providerSecret, err := a.newRequest(ctx, infrastructure)
+	if err != nil {
+		return err
+	}
+
+	reconciler, err := a.newReconciler(infrastructure, providerSecret)
+	if err != nil {
+		return err
+	}
+
+	awsClient, err := awsclient.NewClient(string(providerSecret.Data[aws.AccessKeyID]), string(providerSecret.Data[aws.SecretAccessKey]), infrastructure.Spec.Region)
+	if err != nil {
+		return err
+	}
+
+	vpcID, err := reconciler.VPCID(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	var (
+		g = flow.NewGraph("AWS infrastructure destruction")
+
+		destroyKubernetesLoadBalancersAndSecurityGroups = g.Add(flow.Task{
+			Name: "Destroying Kubernetes load balancers and security groups",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				if err := a.destroyKubernetesLoadBalancersAndSecurityGroups(ctx, awsClient, vpcID, infrastructure.Namespace); err != nil {
+					return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Failed to destroy load balancers and security groups: %+v", err.Error()))
+				}
+				return nil
+			}).RetryUntilTimeout(10*time.Second, 5*time.Minute).DoIf(len(vpcID) > 0),
+		})
+
+		_ = g.Add(flow.Task{
+			Name: "Destroying Shoot infrastructure",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return reconciler.Delete(ctx, req)
+			}),
+			Dependencies: flow.NewTaskIDs(destroyKubernetesLoadBalancersAndSecurityGroups),
+		})
+
+		f = g.Compile()
+	)
+
+	if err := f.Run(flow.Opts{Context: ctx, Logger: glogger.NewFieldLogger(glogger.NewLogger("info"), "infrastructure", infrastructure.Name)}); err != nil {
+		return &controllererrors.RequeueAfterError{
+			Cause:        flow.Causes(err),
+			RequeueAfter: 30 * time.Second,
+		}
+	}
+
+	return nil
+}
+
+func (a *nativeActuator) newRequest(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) (*native.Request, *corev1.Secret, error) {
+	infrastructureConfig := &awsapi.InfrastructureConfig{}
+	if _, _, err := a.decoder.Decode(infrastructure.Spec.ProviderConfig.Raw, nil, infrastructureConfig); err != nil {
+		return nil, nil, fmt.Errorf("could not decode provider config: %+v", err)
+	}
+
+	providerSecret := &corev1.Secret{}
+	if err := a.client.Get(ctx, kutil.Key(infrastructure.Spec.SecretRef.Namespace, infrastructure.Spec.SecretRef.Name), providerSecret); err != nil {
+		return nil, nil, err
+	}
+
+	state, err := a.terraformState(infrastructure)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not read Terraform state: %+v", err)
+	}
+
+	return &native.Request{
+		ClusterName:  infrastructure.Namespace,
+		Region:       infrastructure.Spec.Region,
+		SSHPublicKey: infrastructure.Spec.SSHPublicKey,
+		Config:       infrastructureConfig,
+		State:        state,
+	}, providerSecret, nil
+}
+
+func (a *nativeActuator) newReconciler(infrastructure *extensionsv1alpha1.Infrastructure, providerSecret *corev1.Secret) (*native.Reconciler, error) {
+	ec2Client, iamClient, err := native.NewClients(string(providerSecret.Data[aws.AccessKeyID]), string(providerSecret.Data[aws.SecretAccessKey]), infrastructure.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+	return native.NewReconciler(a.logger.WithValues("infrastructure", infrastructure.Name), ec2Client, iamClient), nil
+}
+
+// terraformState returns the resource IDs recorded by a previous Terraformer run for the given infrastructure so
+// that the native reconciler can adopt these resources. It returns nil if Terraformer has never been run.
+func (a *nativeActuator) terraformState(infrastructure *extensionsv1alpha1.Infrastructure) (native.TerraformState, error) {
+	tf, err := a.newTerraformer(aws.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	configExists, err := tf.ConfigExists()
+	if err != nil || !configExists {
+		return nil, err
+	}
+
+	state, err := tf.GetState()
+	if err != nil {
+		return nil, err
+	}
+	return native.ParseTerraformState(state)
+}
@@ -41,29 +41,14 @@ import (
 )
 
 func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
-	infrastructureConfig := &awsapi.InfrastructureConfig{}
-	if _, _, err := a.decoder.Decode(infrastructure.Spec.ProviderConfig.Raw, nil, infrastructureConfig); err != nil {
-		return fmt.Errorf("could not decode provider config: %+v", err)
-	}
-
-	providerSecret := &corev1.Secret{}
-	if err := a.client.Get(ctx, kutil.Key(infrastructure.Spec.SecretRef.Namespace, infrastructure.Spec.SecretRef.Name), providerSecret); err != nil {
-		return err
-	}
-
-	terraformConfig, err := generateTerraformInfraConfig(ctx, infrastructure, infrastructureConfig, providerSecret)
+	infrastructureConfig, providerSecret, err := a.getConfigAndProviderSecret(ctx, infrastructure)
 	if err != nil {
-		return fmt.Errorf("failed to generate Terraform config: %+v", err)
-	}
-
-	chartRenderer, err := chartrenderer.NewForConfig(a.restConfig)
-	if err != nil {
-		return fmt.Errorf("could not create chart renderer: %+v", err)
+		return err
 	}
 
-	release, err := chartRenderer.Render(filepath.Join(aws.InternalChartsPath, "aws-infra"), "aws-infra", infrastructure.Namespace, terraformConfig)
+	release, err := a.renderTerraformerChart(ctx, infrastructure, infrastructureConfig, providerSecret)
 	if err != nil {
-		return fmt.Errorf("could not render Terraform chart: %+v", err)
+		return err
 	}
 
 	tf, err := a.newTerraformer(aws.TerraformerPurposeInfra, infrastructure.Namespace, infrastructure.Name)
@@ -91,6 +76,39 @@ func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1al
 	return a.updateProviderStatus(ctx, tf, infrastructure, infrastructureConfig)
 }
 
+func (a *actuator) getConfigAndProviderSecret(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) (*awsapi.InfrastructureConfig, *corev1.Secret, error) {
+	infrastructureConfig := &awsapi.InfrastructureConfig{}
+	if _, _, err := a.decoder.Decode(infrastructure.Spec.ProviderConfig.Raw, nil, infrastructureConfig); err != nil {
+		return nil, nil, fmt.Errorf("could not decode provider config: %+v", err)
+	}
+
+	providerSecret := &corev1.Secret{}
+	if err := a.client.Get(ctx, kutil.Key(infrastructure.Spec.SecretRef.Namespace, infrastructure.Spec.SecretRef.Name), providerSecret); err != nil {
+		return nil, nil, err
+	}
+
+	return infrastructureConfig, providerSecret, nil
+}
+
+func (a *actuator) renderTerraformerChart(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig, providerSecret *corev1.Secret) (*chartrenderer.RenderedChart, error) {
+	terraformConfig, err := generateTerraformInfraConfig(ctx, infrastructure, infrastructureConfig, providerSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate Terraform config: %+v", err)
+	}
+
+	chartRenderer, err := a.chartRendererFactory.NewForConfig(a.restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not create chart renderer: %+v", err)
+	}
+
+	release, err := chartRenderer.Render(filepath.Join(aws.InternalChartsPath, "aws-infra"), "aws-infra", infrastructure.Namespace, terraformConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not render Terraform chart: %+v", err)
+	}
+
+	return release, nil
+}
+
 func generateTerraformInfraConfig(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig, providerSecret *corev1.Secret) (map[string]interface{}, error) {
 	var (
 		dhcpDomainName    = "ec2.internal"
@@ -124,12 +142,27 @@ func generateTerraformInfraConfig(ctx context.Context, infrastructure *extension
 
 	var zones []map[string]interface{}
 	for _, zone := range infrastructureConfig.Networks.Zones {
-		zones = append(zones, map[string]interface{}{
+		values := map[string]interface{}{
 			"name":     zone.Name,
 			"worker":   zone.Workers,
 			"public":   zone.Public,
 			"internal": zone.Internal,
-		})
+		}
+		if zone.ElasticIPAllocationID != nil {
+			values["elasticIPAllocationID"] = *zone.ElasticIPAllocationID
+		}
+		if zone.Subnets != nil {
+			if zone.Subnets.Workers != nil {
+				values["workerSubnetID"] = *zone.Subnets.Workers
+			}
+			if zone.Subnets.Public != nil {
+				values["publicSubnetID"] = *zone.Subnets.Public
+			}
+			if zone.Subnets.Internal != nil {
+				values["internalSubnetID"] = *zone.Subnets.Internal
+			}
+		}
+		zones = append(zones, values)
 	}
 
 	return map[string]interface{}{
@@ -145,6 +178,7 @@ func generateTerraformInfraConfig(ctx context.Context, infrastructure *extension
 			"cidr":              vpcCIDR,
 			"dhcpDomainName":    dhcpDomainName,
 			"internetGatewayID": internetGatewayID,
+			"gatewayEndpoints":  infrastructureConfig.Networks.VPC.GatewayEndpoints,
 		},
 		"clusterName": infrastructure.Namespace,
 		"zones":       zones,
@@ -158,6 +192,8 @@ func generateTerraformInfraConfig(ctx context.Context, infrastructure *extension
 			"iamInstanceProfileBastions": aws.IAMInstanceProfileBastions,
 			"nodesRole":                  aws.NodesRole,
 			"bastionsRole":               aws.BastionsRole,
+			"natGatewayIPPrefix":         aws.NATGatewayIPPrefix,
+			"natGatewayEIPPrefix":        aws.NATGatewayEIPAllocationIDPrefix,
 		},
 	}, nil
 }
@@ -174,6 +210,8 @@ func (a *actuator) updateProviderStatus(ctx context.Context, tf *terraformer.Ter
 	for zoneIndex := range infrastructureConfig.Networks.Zones {
 		outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.SubnetNodesPrefix, zoneIndex))
 		outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.SubnetPublicPrefix, zoneIndex))
+		outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.NATGatewayIPPrefix, zoneIndex))
+		outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.NATGatewayEIPAllocationIDPrefix, zoneIndex))
 	}
 
 	output, err := tf.GetStateOutputVariables(outputVarKeys...)
@@ -186,42 +224,55 @@ func (a *actuator) updateProviderStatus(ctx context.Context, tf *terraformer.Ter
 		return err
 	}
 
-	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, infrastructure, func() error {
-		infrastructure.Status.ProviderStatus = &runtime.RawExtension{
-			Object: &awsv1alpha1.InfrastructureStatus{
-				TypeMeta: metav1.TypeMeta{
-					APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
-					Kind:       "InfrastructureStatus",
-				},
-				VPC: awsv1alpha1.VPCStatus{
-					ID:      output[aws.VPCIDKey],
-					Subnets: subnets,
-					SecurityGroups: []awsv1alpha1.SecurityGroup{
-						{
-							Purpose: awsapi.PurposeNodes,
-							ID:      output[aws.SecurityGroupsNodes],
-						},
-					},
+	var natGateways []awsv1alpha1.NATGateway
+	for zoneIndex, zone := range infrastructureConfig.Networks.Zones {
+		natGateways = append(natGateways, awsv1alpha1.NATGateway{
+			Zone:                  zone.Name,
+			ElasticIPAllocationID: output[fmt.Sprintf("%s%d", aws.NATGatewayEIPAllocationIDPrefix, zoneIndex)],
+			PublicIP:              output[fmt.Sprintf("%s%d", aws.NATGatewayIPPrefix, zoneIndex)],
+		})
+	}
+
+	return a.setProviderStatus(ctx, infrastructure, &awsv1alpha1.InfrastructureStatus{
+		VPC: awsv1alpha1.VPCStatus{
+			ID:          output[aws.VPCIDKey],
+			Subnets:     subnets,
+			NATGateways: natGateways,
+			SecurityGroups: []awsv1alpha1.SecurityGroup{
+				{
+					Purpose: awsapi.PurposeNodes,
+					ID:      output[aws.SecurityGroupsNodes],
 				},
-				EC2: awsv1alpha1.EC2{
-					KeyName: output[aws.SSHKeyName],
+			},
+		},
+		EC2: awsv1alpha1.EC2{
+			KeyName: output[aws.SSHKeyName],
+		},
+		IAM: awsv1alpha1.IAM{
+			InstanceProfiles: []awsv1alpha1.InstanceProfile{
+				{
+					Purpose: awsapi.PurposeNodes,
+					Name:    output[aws.IAMInstanceProfileNodes],
 				},
-				IAM: awsv1alpha1.IAM{
-					InstanceProfiles: []awsv1alpha1.InstanceProfile{
-						{
-							Purpose: awsapi.PurposeNodes,
-							Name:    output[aws.IAMInstanceProfileNodes],
-						},
-					},
-					Roles: []awsv1alpha1.Role{
-						{
-							Purpose: awsapi.PurposeNodes,
-							ARN:     output[aws.NodesRole],
-						},
-					},
+			},
+			Roles: []awsv1alpha1.Role{
+				{
+					Purpose: awsapi.PurposeNodes,
+					ARN:     output[aws.NodesRole],
 				},
 			},
-		}
+		},
+	})
+}
+
+func (a *actuator) setProviderStatus(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, status *awsv1alpha1.InfrastructureStatus) error {
+	status.TypeMeta = metav1.TypeMeta{
+		APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+		Kind:       "InfrastructureStatus",
+	}
+
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, infrastructure, func() error {
+		infrastructure.Status.ProviderStatus = &runtime.RawExtension{Object: status}
 		return nil
 	})
 }
@@ -33,16 +33,35 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// UseNativeReconciler specifies whether the infrastructure is reconciled by calling the AWS API directly
+	// instead of running Terraformer.
+	UseNativeReconciler bool
+	// DriftDetection is the configuration for the periodic drift detection. It is not supported by the native
+	// reconciler.
+	DriftDetection infrastructure.DriftDetectionConfig
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
-	return infrastructure.Add(mgr, infrastructure.AddArgs{
-		Actuator:          infrastructure.OperationAnnotationWrapper(NewActuator()),
+	args := infrastructure.AddArgs{
 		ControllerOptions: opts.Controller,
 		Predicates:        infrastructure.DefaultPredicates(mgr.GetClient(), aws.Type, opts.IgnoreOperationAnnotation),
-	})
+	}
+
+	if opts.UseNativeReconciler {
+		args.Actuator = infrastructure.OperationAnnotationWrapper(NewNativeActuator())
+		return infrastructure.Add(mgr, args)
+	}
+
+	actuator := NewActuator()
+	args.Actuator = infrastructure.OperationAnnotationWrapper(actuator)
+	args.DriftDetection = &infrastructure.DriftDetectionArgs{
+		DriftDetector: actuator,
+		Type:          aws.Type,
+		Config:        opts.DriftDetection,
+	}
+	return infrastructure.Add(mgr, args)
 }
 
 // AddToManager adds a controller with the default Options.
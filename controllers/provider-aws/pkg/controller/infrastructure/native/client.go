@@ -0,0 +1,41 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+// NewClients creates the EC2 and IAM clients for the given AWS credentials <accessKeyID>, <secretAccessKey>, and
+// the AWS region <region>.
+func NewClients(accessKeyID, secretAccessKey, region string) (EC2, IAM, error) {
+	var (
+		awsConfig = &aws.Config{
+			Credentials: credentials.NewStaticCredentials(accessKeyID, secretAccessKey, ""),
+		}
+		config = &aws.Config{Region: aws.String(region)}
+	)
+
+	s, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ec2.New(s, config), iam.New(s, config), nil
+}
@@ -0,0 +1,810 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	allIPv4CIDR = "0.0.0.0/0"
+
+	errCodeDuplicatePermission = "InvalidPermission.Duplicate"
+)
+
+func clusterTagKey(clusterName string) string {
+	return TagKeyClusterPrefix + clusterName
+}
+
+func resourceTags(clusterName, name string, extraTags ...*ec2.Tag) []*ec2.Tag {
+	return append([]*ec2.Tag{
+		{Key: aws.String(TagKeyName), Value: aws.String(name)},
+		{Key: aws.String(clusterTagKey(clusterName)), Value: aws.String("1")},
+	}, extraTags...)
+}
+
+func filter(name string, values ...string) *ec2.Filter {
+	return &ec2.Filter{Name: aws.String(name), Values: aws.StringSlice(values)}
+}
+
+func tagFilters(clusterName, name string, extraFilters ...*ec2.Filter) []*ec2.Filter {
+	return append([]*ec2.Filter{
+		filter("tag:"+TagKeyName, name),
+		filter("tag:"+clusterTagKey(clusterName), "1"),
+	}, extraFilters...)
+}
+
+// candidates returns the filter sets used to look up a resource: first by the <id> recorded in the Terraform state
+// (if any), then by the given <filters>.
+func candidates(idFilterName, id string, filters []*ec2.Filter) [][]*ec2.Filter {
+	if len(id) == 0 {
+		return [][]*ec2.Filter{filters}
+	}
+	return [][]*ec2.Filter{{filter(idFilterName, id)}, filters}
+}
+
+func (r *Reconciler) tag(ctx context.Context, resourceID string, tags []*ec2.Tag) error {
+	_, err := r.ec2.CreateTagsWithContext(ctx, &ec2.CreateTagsInput{
+		Resources: aws.StringSlice([]string{resourceID}),
+		Tags:      tags,
+	})
+	return err
+}
+
+// VPC, DHCP options and internet gateway
+
+func (r *Reconciler) findVPC(ctx context.Context, req *Request) (*ec2.Vpc, error) {
+	for _, filters := range candidates("vpc-id", req.State.ID(addressVPC), tagFilters(req.ClusterName, req.ClusterName)) {
+		out, err := r.ec2.DescribeVpcsWithContext(ctx, &ec2.DescribeVpcsInput{Filters: filters})
+		if err != nil {
+			return nil, err
+		}
+		if len(out.Vpcs) > 0 {
+			return out.Vpcs[0], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *Reconciler) ensureVPC(ctx context.Context, req *Request, cidr, dhcpOptionsID string) (string, error) {
+	vpc, err := r.findVPC(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	if vpc == nil {
+		out, err := r.ec2.CreateVpcWithContext(ctx, &ec2.CreateVpcInput{CidrBlock: aws.String(cidr)})
+		if err != nil {
+			return "", err
+		}
+		vpc = out.Vpc
+		r.logger.Info("Created VPC", "cluster", req.ClusterName, "vpc", aws.StringValue(vpc.VpcId))
+
+		if err := r.tag(ctx, aws.StringValue(vpc.VpcId), resourceTags(req.ClusterName, req.ClusterName)); err != nil {
+			return "", err
+		}
+		// Only one attribute can be modified per request.
+		for _, input := range []*ec2.ModifyVpcAttributeInput{
+			{VpcId: vpc.VpcId, EnableDnsSupport: &ec2.AttributeBooleanValue{Value: aws.Bool(true)}},
+			{VpcId: vpc.VpcId, EnableDnsHostnames: &ec2.AttributeBooleanValue{Value: aws.Bool(true)}},
+		} {
+			if _, err := r.ec2.ModifyVpcAttributeWithContext(ctx, input); err != nil {
+				return "", err
+			}
+		}
+	} else if aws.StringValue(vpc.CidrBlock) != cidr {
+		return "", fmt.Errorf("VPC %s has CIDR %s but %s is desired", aws.StringValue(vpc.VpcId), aws.StringValue(vpc.CidrBlock), cidr)
+	}
+
+	if aws.StringValue(vpc.DhcpOptionsId) != dhcpOptionsID {
+		if _, err := r.ec2.AssociateDhcpOptionsWithContext(ctx, &ec2.AssociateDhcpOptionsInput{
+			DhcpOptionsId: aws.String(dhcpOptionsID),
+			VpcId:         vpc.VpcId,
+		}); err != nil {
+			return "", err
+		}
+	}
+
+	return aws.StringValue(vpc.VpcId), nil
+}
+
+func (r *Reconciler) findDHCPOptions(ctx context.Context, req *Request) (*ec2.DhcpOptions, error) {
+	for _, filters := range candidates("dhcp-options-id", req.State.ID(addressDHCPOptions), tagFilters(req.ClusterName, req.ClusterName)) {
+		out, err := r.ec2.DescribeDhcpOptionsWithContext(ctx, &ec2.DescribeDhcpOptionsInput{Filters: filters})
+		if err != nil {
+			return nil, err
+		}
+		if len(out.DhcpOptions) > 0 {
+			return out.DhcpOptions[0], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *Reconciler) ensureDHCPOptions(ctx context.Context, req *Request) (string, error) {
+	dhcpOptions, err := r.findDHCPOptions(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	if dhcpOptions != nil {
+		return aws.StringValue(dhcpOptions.DhcpOptionsId), nil
+	}
+
+	domainName := "ec2.internal"
+	if req.Region != "us-east-1" {
+		domainName = fmt.Sprintf("%s.compute.internal", req.Region)
+	}
+
+	out, err := r.ec2.CreateDhcpOptionsWithContext(ctx, &ec2.CreateDhcpOptionsInput{
+		DhcpConfigurations: []*ec2.NewDhcpConfiguration{
+			{Key: aws.String("domain-name"), Values: aws.StringSlice([]string{domainName})},
+			{Key: aws.String("domain-name-servers"), Values: aws.StringSlice([]string{"AmazonProvidedDNS"})},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	id := aws.StringValue(out.DhcpOptions.DhcpOptionsId)
+	r.logger.Info("Created DHCP options", "cluster", req.ClusterName, "dhcpOptions", id)
+
+	return id, r.tag(ctx, id, resourceTags(req.ClusterName, req.ClusterName))
+}
+
+func (r *Reconciler) findInternetGateway(ctx context.Context, req *Request) (*ec2.InternetGateway, error) {
+	for _, filters := range candidates("internet-gateway-id", req.State.ID(addressInternetGateway), tagFilters(req.ClusterName, req.ClusterName)) {
+		out, err := r.ec2.DescribeInternetGatewaysWithContext(ctx, &ec2.DescribeInternetGatewaysInput{Filters: filters})
+		if err != nil {
+			return nil, err
+		}
+		if len(out.InternetGateways) > 0 {
+			return out.InternetGateways[0], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *Reconciler) ensureInternetGateway(ctx context.Context, req *Request, vpcID string) (string, error) {
+	internetGateway, err := r.findInternetGateway(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	if internetGateway == nil {
+		out, err := r.ec2.CreateInternetGatewayWithContext(ctx, &ec2.CreateInternetGatewayInput{})
+		if err != nil {
+			return "", err
+		}
+		internetGateway = out.InternetGateway
+		r.logger.Info("Created internet gateway", "cluster", req.ClusterName, "internetGateway", aws.StringValue(internetGateway.InternetGatewayId))
+
+		if err := r.tag(ctx, aws.StringValue(internetGateway.InternetGatewayId), resourceTags(req.ClusterName, req.ClusterName)); err != nil {
+			return "", err
+		}
+	}
+
+	for _, attachment := range internetGateway.Attachments {
+		if aws.StringValue(attachment.VpcId) == vpcID {
+			return aws.StringValue(internetGateway.InternetGatewayId), nil
+		}
+	}
+
+	if _, err := r.ec2.AttachInternetGatewayWithContext(ctx, &ec2.AttachInternetGatewayInput{
+		InternetGatewayId: internetGateway.InternetGatewayId,
+		VpcId:             aws.String(vpcID),
+	}); err != nil {
+		return "", err
+	}
+	return aws.StringValue(internetGateway.InternetGatewayId), nil
+}
+
+// getAttachedInternetGateway returns the ID of the internet gateway attached to the given (existing) VPC.
+func (r *Reconciler) getAttachedInternetGateway(ctx context.Context, vpcID string) (string, error) {
+	out, err := r.ec2.DescribeInternetGatewaysWithContext(ctx, &ec2.DescribeInternetGatewaysInput{
+		Filters: []*ec2.Filter{filter("attachment.vpc-id", vpcID)},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(out.InternetGateways) == 0 {
+		return "", fmt.Errorf("no internet gateway attached to VPC %s", vpcID)
+	}
+	return aws.StringValue(out.InternetGateways[0].InternetGatewayId), nil
+}
+
+// Route tables
+
+func (r *Reconciler) findRouteTable(ctx context.Context, req *Request, vpcID, id, name string) (*ec2.RouteTable, error) {
+	for _, filters := range candidates("route-table-id", id, tagFilters(req.ClusterName, name, filter("vpc-id", vpcID))) {
+		out, err := r.ec2.DescribeRouteTablesWithContext(ctx, &ec2.DescribeRouteTablesInput{Filters: filters})
+		if err != nil {
+			return nil, err
+		}
+		if len(out.RouteTables) > 0 {
+			return out.RouteTables[0], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *Reconciler) ensureRouteTable(ctx context.Context, req *Request, vpcID, id, name string) (*ec2.RouteTable, error) {
+	routeTable, err := r.findRouteTable(ctx, req, vpcID, id, name)
+	if err != nil || routeTable != nil {
+		return routeTable, err
+	}
+
+	out, err := r.ec2.CreateRouteTableWithContext(ctx, &ec2.CreateRouteTableInput{VpcId: aws.String(vpcID)})
+	if err != nil {
+		return nil, err
+	}
+	r.logger.Info("Created route table", "cluster", req.ClusterName, "routeTable", aws.StringValue(out.RouteTable.RouteTableId))
+
+	return out.RouteTable, r.tag(ctx, aws.StringValue(out.RouteTable.RouteTableId), resourceTags(req.ClusterName, name))
+}
+
+// ensureRoute ensures that the given route table routes <destination> to either <gatewayID> or <natGatewayID>.
+func (r *Reconciler) ensureRoute(ctx context.Context, routeTable *ec2.RouteTable, destination, gatewayID, natGatewayID string) error {
+	for _, route := range routeTable.Routes {
+		if aws.StringValue(route.DestinationCidrBlock) != destination {
+			continue
+		}
+		if aws.StringValue(route.GatewayId) == gatewayID && aws.StringValue(route.NatGatewayId) == natGatewayID {
+			return nil
+		}
+
+		_, err := r.ec2.ReplaceRouteWithContext(ctx, &ec2.ReplaceRouteInput{
+			RouteTableId:         routeTable.RouteTableId,
+			DestinationCidrBlock: aws.String(destination),
+			GatewayId:            optionalString(gatewayID),
+			NatGatewayId:         optionalString(natGatewayID),
+		})
+		return err
+	}
+
+	_, err := r.ec2.CreateRouteWithContext(ctx, &ec2.CreateRouteInput{
+		RouteTableId:         routeTable.RouteTableId,
+		DestinationCidrBlock: aws.String(destination),
+		GatewayId:            optionalString(gatewayID),
+		NatGatewayId:         optionalString(natGatewayID),
+	})
+	return err
+}
+
+// ensureRouteTableAssociation ensures that the subnet with the given <subnetID> is associated with the given route table.
+func (r *Reconciler) ensureRouteTableAssociation(ctx context.Context, routeTable *ec2.RouteTable, subnetID string) error {
+	for _, association := range routeTable.Associations {
+		if aws.StringValue(association.SubnetId) == subnetID {
+			return nil
+		}
+	}
+
+	out, err := r.ec2.DescribeRouteTablesWithContext(ctx, &ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{filter("association.subnet-id", subnetID)},
+	})
+	if err != nil {
+		return err
+	}
+	for _, other := range out.RouteTables {
+		for _, association := range other.Associations {
+			if aws.StringValue(association.SubnetId) != subnetID {
+				continue
+			}
+			_, err := r.ec2.ReplaceRouteTableAssociationWithContext(ctx, &ec2.ReplaceRouteTableAssociationInput{
+				AssociationId: association.RouteTableAssociationId,
+				RouteTableId:  routeTable.RouteTableId,
+			})
+			return err
+		}
+	}
+
+	_, err = r.ec2.AssociateRouteTableWithContext(ctx, &ec2.AssociateRouteTableInput{
+		RouteTableId: routeTable.RouteTableId,
+		SubnetId:     aws.String(subnetID),
+	})
+	return err
+}
+
+// Security groups
+
+func (r *Reconciler) findSecurityGroup(ctx context.Context, req *Request, vpcID, purpose string) (*ec2.SecurityGroup, error) {
+	byName := []*ec2.Filter{
+		filter("group-name", fmt.Sprintf("%s-%s", req.ClusterName, purpose)),
+		filter("vpc-id", vpcID),
+	}
+	for _, filters := range candidates("group-id", req.State.ID(addressSecurityGroup, purpose), byName) {
+		out, err := r.ec2.DescribeSecurityGroupsWithContext(ctx, &ec2.DescribeSecurityGroupsInput{Filters: filters})
+		if err != nil {
+			return nil, err
+		}
+		if len(out.SecurityGroups) > 0 {
+			return out.SecurityGroups[0], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *Reconciler) ensureSecurityGroup(ctx context.Context, req *Request, vpcID, purpose, description string) (*ec2.SecurityGroup, error) {
+	securityGroup, err := r.findSecurityGroup(ctx, req, vpcID, purpose)
+	if err != nil || securityGroup != nil {
+		return securityGroup, err
+	}
+
+	name := fmt.Sprintf("%s-%s", req.ClusterName, purpose)
+	out, err := r.ec2.CreateSecurityGroupWithContext(ctx, &ec2.CreateSecurityGroupInput{
+		GroupName:   aws.String(name),
+		Description: aws.String(description),
+		VpcId:       aws.String(vpcID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.logger.Info("Created security group", "cluster", req.ClusterName, "securityGroup", aws.StringValue(out.GroupId))
+
+	return &ec2.SecurityGroup{GroupId: out.GroupId, GroupName: aws.String(name), VpcId: aws.String(vpcID)}, r.tag(ctx, aws.StringValue(out.GroupId), resourceTags(req.ClusterName, name))
+}
+
+// ensureSecurityGroupRules authorizes all given <ingress> and <egress> permissions that are not yet part of the given
+// security group. Additional permissions are left untouched.
+func (r *Reconciler) ensureSecurityGroupRules(ctx context.Context, securityGroup *ec2.SecurityGroup, ingress, egress []*ec2.IpPermission) error {
+	for _, permission := range ingress {
+		if hasPermission(securityGroup.IpPermissions, permission) {
+			continue
+		}
+		if _, err := r.ec2.AuthorizeSecurityGroupIngressWithContext(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       securityGroup.GroupId,
+			IpPermissions: []*ec2.IpPermission{permission},
+		}); err != nil && !isAWSError(err, errCodeDuplicatePermission) {
+			return err
+		}
+	}
+
+	for _, permission := range egress {
+		if hasPermission(securityGroup.IpPermissionsEgress, permission) {
+			continue
+		}
+		if _, err := r.ec2.AuthorizeSecurityGroupEgressWithContext(ctx, &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       securityGroup.GroupId,
+			IpPermissions: []*ec2.IpPermission{permission},
+		}); err != nil && !isAWSError(err, errCodeDuplicatePermission) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func cidrPermission(protocol string, fromPort, toPort int64, cidr string) *ec2.IpPermission {
+	permission := &ec2.IpPermission{
+		IpProtocol: aws.String(protocol),
+		IpRanges:   []*ec2.IpRange{{CidrIp: aws.String(cidr)}},
+	}
+	if protocol != "-1" {
+		permission.FromPort, permission.ToPort = aws.Int64(fromPort), aws.Int64(toPort)
+	}
+	return permission
+}
+
+func groupPermission(protocol string, fromPort, toPort int64, groupID string) *ec2.IpPermission {
+	permission := &ec2.IpPermission{
+		IpProtocol:       aws.String(protocol),
+		UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String(groupID)}},
+	}
+	if protocol != "-1" {
+		permission.FromPort, permission.ToPort = aws.Int64(fromPort), aws.Int64(toPort)
+	}
+	return permission
+}
+
+// hasPermission checks whether the single CIDR or security group source of <wanted> is covered by <permissions>.
+func hasPermission(permissions []*ec2.IpPermission, wanted *ec2.IpPermission) bool {
+	for _, permission := range permissions {
+		if aws.StringValue(permission.IpProtocol) != aws.StringValue(wanted.IpProtocol) ||
+			aws.Int64Value(permission.FromPort) != aws.Int64Value(wanted.FromPort) ||
+			aws.Int64Value(permission.ToPort) != aws.Int64Value(wanted.ToPort) {
+			continue
+		}
+		for _, wantedRange := range wanted.IpRanges {
+			for _, ipRange := range permission.IpRanges {
+				if aws.StringValue(ipRange.CidrIp) == aws.StringValue(wantedRange.CidrIp) {
+					return true
+				}
+			}
+		}
+		for _, wantedPair := range wanted.UserIdGroupPairs {
+			for _, pair := range permission.UserIdGroupPairs {
+				if aws.StringValue(pair.GroupId) == aws.StringValue(wantedPair.GroupId) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Subnets, elastic IPs and NAT gateways
+
+func (r *Reconciler) findSubnet(ctx context.Context, req *Request, vpcID, id, name string) (*ec2.Subnet, error) {
+	for _, filters := range candidates("subnet-id", id, tagFilters(req.ClusterName, name, filter("vpc-id", vpcID))) {
+		out, err := r.ec2.DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{Filters: filters})
+		if err != nil {
+			return nil, err
+		}
+		if len(out.Subnets) > 0 {
+			return out.Subnets[0], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *Reconciler) ensureSubnet(ctx context.Context, req *Request, vpcID, id, name, zone, cidr string, extraTags ...*ec2.Tag) (string, error) {
+	subnet, err := r.findSubnet(ctx, req, vpcID, id, name)
+	if err != nil {
+		return "", err
+	}
+	if subnet != nil {
+		return aws.StringValue(subnet.SubnetId), checkSubnet(subnet, zone, cidr)
+	}
+
+	out, err := r.ec2.CreateSubnetWithContext(ctx, &ec2.CreateSubnetInput{
+		VpcId:            aws.String(vpcID),
+		CidrBlock:        aws.String(cidr),
+		AvailabilityZone: aws.String(zone),
+	})
+	if err != nil {
+		return "", err
+	}
+	subnetID := aws.StringValue(out.Subnet.SubnetId)
+	r.logger.Info("Created subnet", "cluster", req.ClusterName, "subnet", subnetID)
+
+	return subnetID, r.tag(ctx, subnetID, resourceTags(req.ClusterName, name, extraTags...))
+}
+
+// getExistingSubnet verifies that the subnet with the given <id>, which is not managed by the reconciler, exists in
+// the VPC and matches the desired zone and CIDR.
+func (r *Reconciler) getExistingSubnet(ctx context.Context, vpcID, id, zone, cidr string) (string, error) {
+	out, err := r.ec2.DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{
+		Filters: []*ec2.Filter{filter("subnet-id", id), filter("vpc-id", vpcID)},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(out.Subnets) == 0 {
+		return "", fmt.Errorf("subnet %s does not exist in VPC %s", id, vpcID)
+	}
+	return id, checkSubnet(out.Subnets[0], zone, cidr)
+}
+
+func checkSubnet(subnet *ec2.Subnet, zone, cidr string) error {
+	if aws.StringValue(subnet.CidrBlock) != cidr || aws.StringValue(subnet.AvailabilityZone) != zone {
+		return fmt.Errorf("subnet %s (%s in %s) does not match the desired CIDR %s in %s", aws.StringValue(subnet.SubnetId), aws.StringValue(subnet.CidrBlock), aws.StringValue(subnet.AvailabilityZone), cidr, zone)
+	}
+	return nil
+}
+
+func (r *Reconciler) findElasticIP(ctx context.Context, req *Request, zoneIndex int) (*ec2.Address, error) {
+	name := fmt.Sprintf("%s-eip-natgw-z%d", req.ClusterName, zoneIndex)
+	for _, filters := range candidates("allocation-id", req.State.ID(addressElasticIP, zoneIndex), tagFilters(req.ClusterName, name)) {
+		out, err := r.ec2.DescribeAddressesWithContext(ctx, &ec2.DescribeAddressesInput{Filters: filters})
+		if err != nil {
+			return nil, err
+		}
+		if len(out.Addresses) > 0 {
+			return out.Addresses[0], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *Reconciler) ensureElasticIP(ctx context.Context, req *Request, zoneIndex int) (*ec2.Address, error) {
+	address, err := r.findElasticIP(ctx, req, zoneIndex)
+	if err != nil || address != nil {
+		return address, err
+	}
+
+	out, err := r.ec2.AllocateAddressWithContext(ctx, &ec2.AllocateAddressInput{Domain: aws.String(ec2.DomainTypeVpc)})
+	if err != nil {
+		return nil, err
+	}
+	allocationID := aws.StringValue(out.AllocationId)
+	r.logger.Info("Allocated elastic IP", "cluster", req.ClusterName, "allocation", allocationID)
+
+	address = &ec2.Address{AllocationId: out.AllocationId, PublicIp: out.PublicIp}
+	return address, r.tag(ctx, allocationID, resourceTags(req.ClusterName, fmt.Sprintf("%s-eip-natgw-z%d", req.ClusterName, zoneIndex)))
+}
+
+// getExistingElasticIP returns the elastic IP with the given <allocationID>, which is not managed by the reconciler.
+func (r *Reconciler) getExistingElasticIP(ctx context.Context, allocationID string) (*ec2.Address, error) {
+	out, err := r.ec2.DescribeAddressesWithContext(ctx, &ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{filter("allocation-id", allocationID)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Addresses) == 0 {
+		return nil, fmt.Errorf("elastic IP %s does not exist", allocationID)
+	}
+	return out.Addresses[0], nil
+}
+
+// releaseElasticIP releases the elastic IP managed by the reconciler for the zone with the given index, if any. The
+// elastic IP with the given <keepAllocationID> is never released.
+func (r *Reconciler) releaseElasticIP(ctx context.Context, req *Request, zoneIndex int, keepAllocationID string) error {
+	address, err := r.findElasticIP(ctx, req, zoneIndex)
+	if err != nil || address == nil || aws.StringValue(address.AllocationId) == keepAllocationID {
+		return err
+	}
+	if _, err := r.ec2.ReleaseAddressWithContext(ctx, &ec2.ReleaseAddressInput{AllocationId: address.AllocationId}); err != nil {
+		return fmt.Errorf("could not release elastic IP %s: %+v", aws.StringValue(address.AllocationId), err)
+	}
+	r.logger.Info("Released elastic IP", "cluster", req.ClusterName, "allocation", aws.StringValue(address.AllocationId))
+	return nil
+}
+
+func (r *Reconciler) findNATGateway(ctx context.Context, req *Request, zoneIndex int) (*ec2.NatGateway, error) {
+	var (
+		name   = fmt.Sprintf("%s-natgw-z%d", req.ClusterName, zoneIndex)
+		active = filter("state", ec2.NatGatewayStatePending, ec2.NatGatewayStateAvailable)
+	)
+
+	for _, filters := range candidates("nat-gateway-id", req.State.ID(addressNATGateway, zoneIndex), tagFilters(req.ClusterName, name)) {
+		out, err := r.ec2.DescribeNatGatewaysWithContext(ctx, &ec2.DescribeNatGatewaysInput{Filter: append(filters, active)})
+		if err != nil {
+			return nil, err
+		}
+		if len(out.NatGateways) > 0 {
+			return out.NatGateways[0], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *Reconciler) ensureNATGateway(ctx context.Context, req *Request, zoneIndex int, subnetID, allocationID string) (string, error) {
+	natGateway, err := r.findNATGateway(ctx, req, zoneIndex)
+	if err != nil {
+		return "", err
+	}
+
+	// The elastic IP of a NAT gateway cannot be changed, hence the NAT gateway has to be replaced.
+	if natGateway != nil && !usesElasticIP(natGateway, allocationID) {
+		if err := r.deleteNATGateway(ctx, req, natGateway); err != nil {
+			return "", err
+		}
+		natGateway = nil
+	}
+
+	if natGateway == nil {
+		out, err := r.ec2.CreateNatGatewayWithContext(ctx, &ec2.CreateNatGatewayInput{
+			AllocationId: aws.String(allocationID),
+			SubnetId:     aws.String(subnetID),
+		})
+		if err != nil {
+			return "", err
+		}
+		natGateway = out.NatGateway
+		r.logger.Info("Created NAT gateway", "cluster", req.ClusterName, "natGateway", aws.StringValue(natGateway.NatGatewayId))
+
+		if err := r.tag(ctx, aws.StringValue(natGateway.NatGatewayId), resourceTags(req.ClusterName, fmt.Sprintf("%s-natgw-z%d", req.ClusterName, zoneIndex))); err != nil {
+			return "", err
+		}
+	}
+
+	natGatewayID := aws.StringValue(natGateway.NatGatewayId)
+	return natGatewayID, r.waitForNATGatewayState(ctx, natGatewayID, ec2.NatGatewayStateAvailable)
+}
+
+func (r *Reconciler) deleteNATGateway(ctx context.Context, req *Request, natGateway *ec2.NatGateway) error {
+	natGatewayID := aws.StringValue(natGateway.NatGatewayId)
+	if _, err := r.ec2.DeleteNatGatewayWithContext(ctx, &ec2.DeleteNatGatewayInput{NatGatewayId: natGateway.NatGatewayId}); err != nil {
+		return fmt.Errorf("could not delete NAT gateway %s: %+v", natGatewayID, err)
+	}
+	r.logger.Info("Deleted NAT gateway", "cluster", req.ClusterName, "natGateway", natGatewayID)
+	return r.waitForNATGatewayState(ctx, natGatewayID, ec2.NatGatewayStateDeleted)
+}
+
+func usesElasticIP(natGateway *ec2.NatGateway, allocationID string) bool {
+	for _, address := range natGateway.NatGatewayAddresses {
+		if aws.StringValue(address.AllocationId) == allocationID {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reconciler) waitForNATGatewayState(ctx context.Context, natGatewayID, state string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.natGatewayTimeout)
+	defer cancel()
+
+	return wait.PollImmediateUntil(r.pollInterval, func() (bool, error) {
+		out, err := r.ec2.DescribeNatGatewaysWithContext(ctx, &ec2.DescribeNatGatewaysInput{
+			NatGatewayIds: aws.StringSlice([]string{natGatewayID}),
+		})
+		if err != nil {
+			return false, err
+		}
+		for _, natGateway := range out.NatGateways {
+			switch aws.StringValue(natGateway.State) {
+			case state:
+				return true, nil
+			case ec2.NatGatewayStateFailed:
+				return false, fmt.Errorf("NAT gateway %s failed: %s", natGatewayID, aws.StringValue(natGateway.FailureMessage))
+			}
+		}
+		// A deleted NAT gateway may vanish from the API entirely.
+		return len(out.NatGateways) == 0 && state == ec2.NatGatewayStateDeleted, nil
+	}, ctx.Done())
+}
+
+// VPC gateway endpoints
+
+// findGatewayEndpoints returns the active gateway endpoints in the given VPC which are attached to the route table
+// with the given ID, keyed by their service name. A route table can only be attached to one gateway endpoint per
+// service, hence the endpoints attached to the main route table of the cluster are the ones of the cluster.
+func (r *Reconciler) findGatewayEndpoints(ctx context.Context, vpcID, routeTableID string) (map[string]*ec2.VpcEndpoint, error) {
+	out, err := r.ec2.DescribeVpcEndpointsWithContext(ctx, &ec2.DescribeVpcEndpointsInput{
+		Filters: []*ec2.Filter{
+			filter("vpc-id", vpcID),
+			filter("vpc-endpoint-state", "pending", "available"),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := map[string]*ec2.VpcEndpoint{}
+	for _, endpoint := range out.VpcEndpoints {
+		if aws.StringValue(endpoint.VpcEndpointType) != ec2.VpcEndpointTypeGateway {
+			continue
+		}
+		if sets.NewString(aws.StringValueSlice(endpoint.RouteTableIds)...).Has(routeTableID) {
+			endpoints[aws.StringValue(endpoint.ServiceName)] = endpoint
+		}
+	}
+	return endpoints, nil
+}
+
+// ensureGatewayEndpoints ensures that exactly the gateway endpoints configured for the VPC exist and that they are
+// attached to the given route tables. The first route table must be the main route table of the cluster.
+func (r *Reconciler) ensureGatewayEndpoints(ctx context.Context, req *Request, vpcID string, routeTableIDs []string) error {
+	existing, err := r.findGatewayEndpoints(ctx, vpcID, routeTableIDs[0])
+	if err != nil {
+		return err
+	}
+
+	var (
+		desiredRouteTableIDs = sets.NewString(routeTableIDs...)
+		serviceNames         = sets.NewString()
+	)
+
+	for _, service := range req.Config.Networks.VPC.GatewayEndpoints {
+		serviceName := gatewayEndpointServiceName(req.Region, service)
+		serviceNames.Insert(serviceName)
+
+		endpoint, ok := existing[serviceName]
+		if !ok {
+			out, err := r.ec2.CreateVpcEndpointWithContext(ctx, &ec2.CreateVpcEndpointInput{
+				VpcId:           aws.String(vpcID),
+				ServiceName:     aws.String(serviceName),
+				VpcEndpointType: aws.String(ec2.VpcEndpointTypeGateway),
+				RouteTableIds:   aws.StringSlice(routeTableIDs),
+			})
+			if err != nil {
+				return err
+			}
+			r.logger.Info("Created VPC gateway endpoint", "cluster", req.ClusterName, "vpcEndpoint", aws.StringValue(out.VpcEndpoint.VpcEndpointId))
+			continue
+		}
+
+		currentRouteTableIDs := sets.NewString(aws.StringValueSlice(endpoint.RouteTableIds)...)
+		if currentRouteTableIDs.Equal(desiredRouteTableIDs) {
+			continue
+		}
+		if _, err := r.ec2.ModifyVpcEndpointWithContext(ctx, &ec2.ModifyVpcEndpointInput{
+			VpcEndpointId:       endpoint.VpcEndpointId,
+			AddRouteTableIds:    aws.StringSlice(desiredRouteTableIDs.Difference(currentRouteTableIDs).List()),
+			RemoveRouteTableIds: aws.StringSlice(currentRouteTableIDs.Difference(desiredRouteTableIDs).List()),
+		}); err != nil {
+			return err
+		}
+	}
+
+	var obsolete []string
+	for serviceName, endpoint := range existing {
+		if !serviceNames.Has(serviceName) {
+			obsolete = append(obsolete, aws.StringValue(endpoint.VpcEndpointId))
+		}
+	}
+	return r.deleteVPCEndpoints(ctx, req, obsolete)
+}
+
+// deleteGatewayEndpoints deletes all gateway endpoints attached to the route table with the given ID.
+func (r *Reconciler) deleteGatewayEndpoints(ctx context.Context, req *Request, vpcID, routeTableID string) error {
+	existing, err := r.findGatewayEndpoints(ctx, vpcID, routeTableID)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	for _, endpoint := range existing {
+		ids = append(ids, aws.StringValue(endpoint.VpcEndpointId))
+	}
+	return r.deleteVPCEndpoints(ctx, req, ids)
+}
+
+func (r *Reconciler) deleteVPCEndpoints(ctx context.Context, req *Request, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if _, err := r.ec2.DeleteVpcEndpointsWithContext(ctx, &ec2.DeleteVpcEndpointsInput{VpcEndpointIds: aws.StringSlice(ids)}); err != nil {
+		return fmt.Errorf("could not delete VPC endpoints %v: %+v", ids, err)
+	}
+	r.logger.Info("Deleted VPC gateway endpoints", "cluster", req.ClusterName, "vpcEndpoints", ids)
+	return nil
+}
+
+func gatewayEndpointServiceName(region, service string) string {
+	return fmt.Sprintf("com.amazonaws.%s.%s", region, service)
+}
+
+// Key pair
+
+func (r *Reconciler) ensureKeyPair(ctx context.Context, req *Request) (string, error) {
+	name := keyPairName(req.ClusterName)
+	out, err := r.ec2.DescribeKeyPairsWithContext(ctx, &ec2.DescribeKeyPairsInput{
+		Filters: []*ec2.Filter{filter("key-name", name)},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(out.KeyPairs) > 0 {
+		return name, nil
+	}
+
+	if _, err := r.ec2.ImportKeyPairWithContext(ctx, &ec2.ImportKeyPairInput{
+		KeyName:           aws.String(name),
+		PublicKeyMaterial: req.SSHPublicKey,
+	}); err != nil {
+		return "", err
+	}
+	r.logger.Info("Imported key pair", "cluster", req.ClusterName, "keyPair", name)
+	return name, nil
+}
+
+func keyPairName(clusterName string) string {
+	return fmt.Sprintf("%s-ssh-publickey", clusterName)
+}
+
+func optionalString(value string) *string {
+	if len(value) == 0 {
+		return nil
+	}
+	return aws.String(value)
+}
+
+func isAWSError(err error, codes ...string) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		for _, code := range codes {
+			if aerr.Code() == code {
+				return true
+			}
+		}
+	}
+	return false
+}
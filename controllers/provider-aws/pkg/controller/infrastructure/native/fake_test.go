@@ -0,0 +1,892 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+// fakeEC2 is an in-memory implementation of the native.EC2 interface. It supports the filters used by the
+// reconciler and mimics the dependency checks of the AWS API.
+type fakeEC2 struct {
+	nextID int
+	calls  map[string]int
+
+	vpcs             map[string]*ec2.Vpc
+	dhcpOptions      map[string]*ec2.DhcpOptions
+	internetGateways map[string]*ec2.InternetGateway
+	routeTables      map[string]*ec2.RouteTable
+	securityGroups   map[string]*ec2.SecurityGroup
+	subnets          map[string]*ec2.Subnet
+	addresses        map[string]*ec2.Address
+	natGateways      map[string]*ec2.NatGateway
+	keyPairs         map[string]*ec2.KeyPairInfo
+	vpcEndpoints     map[string]*ec2.VpcEndpoint
+}
+
+func newFakeEC2() *fakeEC2 {
+	return &fakeEC2{
+		calls:            map[string]int{},
+		vpcs:             map[string]*ec2.Vpc{},
+		dhcpOptions:      map[string]*ec2.DhcpOptions{},
+		internetGateways: map[string]*ec2.InternetGateway{},
+		routeTables:      map[string]*ec2.RouteTable{},
+		securityGroups:   map[string]*ec2.SecurityGroup{},
+		subnets:          map[string]*ec2.Subnet{},
+		addresses:        map[string]*ec2.Address{},
+		natGateways:      map[string]*ec2.NatGateway{},
+		keyPairs:         map[string]*ec2.KeyPairInfo{},
+		vpcEndpoints:     map[string]*ec2.VpcEndpoint{},
+	}
+}
+
+// mutatingCalls returns the number of calls that changed (or tried to change) the fake's state.
+func (f *fakeEC2) mutatingCalls() int {
+	n := 0
+	for name, count := range f.calls {
+		if !strings.HasPrefix(name, "Describe") {
+			n += count
+		}
+	}
+	return n
+}
+
+// activeNATGateways returns the NAT gateways which have not been deleted.
+func (f *fakeEC2) activeNATGateways() int {
+	n := 0
+	for _, natGateway := range f.natGateways {
+		if aws.StringValue(natGateway.State) != ec2.NatGatewayStateDeleted {
+			n++
+		}
+	}
+	return n
+}
+
+func (f *fakeEC2) newID(prefix string) string {
+	f.nextID++
+	return fmt.Sprintf("%s-%d", prefix, f.nextID)
+}
+
+func notFound(code, id string) error {
+	return awserr.New(code, fmt.Sprintf("%s does not exist", id), nil)
+}
+
+func dependencyViolation(id string) error {
+	return awserr.New("DependencyViolation", fmt.Sprintf("%s has dependencies", id), nil)
+}
+
+// matches checks whether all <filters> match the given <tags> and filter <attributes>.
+func matches(filters []*ec2.Filter, tags []*ec2.Tag, attributes map[string][]string) bool {
+	for _, filter := range filters {
+		var (
+			name   = aws.StringValue(filter.Name)
+			values []string
+		)
+
+		if strings.HasPrefix(name, "tag:") {
+			for _, tag := range tags {
+				if aws.StringValue(tag.Key) == strings.TrimPrefix(name, "tag:") {
+					values = append(values, aws.StringValue(tag.Value))
+				}
+			}
+		} else {
+			attributeValues, ok := attributes[name]
+			if !ok {
+				panic(fmt.Sprintf("unsupported filter %q", name))
+			}
+			values = attributeValues
+		}
+
+		if !containsAny(values, aws.StringValueSlice(filter.Values)) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAny(values, wanted []string) bool {
+	for _, value := range values {
+		for _, w := range wanted {
+			if value == w {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (f *fakeEC2) AllocateAddressWithContext(_ aws.Context, input *ec2.AllocateAddressInput, _ ...request.Option) (*ec2.AllocateAddressOutput, error) {
+	f.calls["AllocateAddress"]++
+	id := f.newID("eipalloc")
+	publicIP := fmt.Sprintf("203.0.113.%d", f.nextID)
+	f.addresses[id] = &ec2.Address{AllocationId: aws.String(id), Domain: input.Domain, PublicIp: aws.String(publicIP)}
+	return &ec2.AllocateAddressOutput{AllocationId: aws.String(id), PublicIp: aws.String(publicIP)}, nil
+}
+
+func (f *fakeEC2) AssociateDhcpOptionsWithContext(_ aws.Context, input *ec2.AssociateDhcpOptionsInput, _ ...request.Option) (*ec2.AssociateDhcpOptionsOutput, error) {
+	f.calls["AssociateDhcpOptions"]++
+	vpc, ok := f.vpcs[aws.StringValue(input.VpcId)]
+	if !ok {
+		return nil, notFound("InvalidVpcID.NotFound", aws.StringValue(input.VpcId))
+	}
+	vpc.DhcpOptionsId = input.DhcpOptionsId
+	return &ec2.AssociateDhcpOptionsOutput{}, nil
+}
+
+func (f *fakeEC2) AssociateRouteTableWithContext(_ aws.Context, input *ec2.AssociateRouteTableInput, _ ...request.Option) (*ec2.AssociateRouteTableOutput, error) {
+	f.calls["AssociateRouteTable"]++
+	routeTable, ok := f.routeTables[aws.StringValue(input.RouteTableId)]
+	if !ok {
+		return nil, notFound("InvalidRouteTableID.NotFound", aws.StringValue(input.RouteTableId))
+	}
+	id := f.newID("rtbassoc")
+	routeTable.Associations = append(routeTable.Associations, &ec2.RouteTableAssociation{
+		RouteTableAssociationId: aws.String(id),
+		RouteTableId:            input.RouteTableId,
+		SubnetId:                input.SubnetId,
+	})
+	return &ec2.AssociateRouteTableOutput{AssociationId: aws.String(id)}, nil
+}
+
+func (f *fakeEC2) AttachInternetGatewayWithContext(_ aws.Context, input *ec2.AttachInternetGatewayInput, _ ...request.Option) (*ec2.AttachInternetGatewayOutput, error) {
+	f.calls["AttachInternetGateway"]++
+	internetGateway, ok := f.internetGateways[aws.StringValue(input.InternetGatewayId)]
+	if !ok {
+		return nil, notFound("InvalidInternetGatewayID.NotFound", aws.StringValue(input.InternetGatewayId))
+	}
+	internetGateway.Attachments = append(internetGateway.Attachments, &ec2.InternetGatewayAttachment{VpcId: input.VpcId, State: aws.String("available")})
+	return &ec2.AttachInternetGatewayOutput{}, nil
+}
+
+func authorize(permissions []*ec2.IpPermission, wanted []*ec2.IpPermission) ([]*ec2.IpPermission, error) {
+	for _, permission := range wanted {
+		for _, existing := range permissions {
+			if aws.StringValue(existing.IpProtocol) == aws.StringValue(permission.IpProtocol) &&
+				aws.Int64Value(existing.FromPort) == aws.Int64Value(permission.FromPort) &&
+				aws.Int64Value(existing.ToPort) == aws.Int64Value(permission.ToPort) &&
+				sources(existing) == sources(permission) {
+				return nil, awserr.New("InvalidPermission.Duplicate", "the specified rule already exists", nil)
+			}
+		}
+		permissions = append(permissions, permission)
+	}
+	return permissions, nil
+}
+
+func sources(permission *ec2.IpPermission) string {
+	var result []string
+	for _, ipRange := range permission.IpRanges {
+		result = append(result, aws.StringValue(ipRange.CidrIp))
+	}
+	for _, pair := range permission.UserIdGroupPairs {
+		result = append(result, aws.StringValue(pair.GroupId))
+	}
+	return strings.Join(result, ",")
+}
+
+func (f *fakeEC2) AuthorizeSecurityGroupEgressWithContext(_ aws.Context, input *ec2.AuthorizeSecurityGroupEgressInput, _ ...request.Option) (*ec2.AuthorizeSecurityGroupEgressOutput, error) {
+	f.calls["AuthorizeSecurityGroupEgress"]++
+	securityGroup, ok := f.securityGroups[aws.StringValue(input.GroupId)]
+	if !ok {
+		return nil, notFound("InvalidGroup.NotFound", aws.StringValue(input.GroupId))
+	}
+	permissions, err := authorize(securityGroup.IpPermissionsEgress, input.IpPermissions)
+	if err != nil {
+		return nil, err
+	}
+	securityGroup.IpPermissionsEgress = permissions
+	return &ec2.AuthorizeSecurityGroupEgressOutput{}, nil
+}
+
+func (f *fakeEC2) AuthorizeSecurityGroupIngressWithContext(_ aws.Context, input *ec2.AuthorizeSecurityGroupIngressInput, _ ...request.Option) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+	f.calls["AuthorizeSecurityGroupIngress"]++
+	securityGroup, ok := f.securityGroups[aws.StringValue(input.GroupId)]
+	if !ok {
+		return nil, notFound("InvalidGroup.NotFound", aws.StringValue(input.GroupId))
+	}
+	permissions, err := authorize(securityGroup.IpPermissions, input.IpPermissions)
+	if err != nil {
+		return nil, err
+	}
+	securityGroup.IpPermissions = permissions
+	return &ec2.AuthorizeSecurityGroupIngressOutput{}, nil
+}
+
+func (f *fakeEC2) CreateDhcpOptionsWithContext(_ aws.Context, input *ec2.CreateDhcpOptionsInput, _ ...request.Option) (*ec2.CreateDhcpOptionsOutput, error) {
+	f.calls["CreateDhcpOptions"]++
+	dhcpOptions := &ec2.DhcpOptions{DhcpOptionsId: aws.String(f.newID("dopt"))}
+	for _, configuration := range input.DhcpConfigurations {
+		var values []*ec2.AttributeValue
+		for _, value := range configuration.Values {
+			values = append(values, &ec2.AttributeValue{Value: value})
+		}
+		dhcpOptions.DhcpConfigurations = append(dhcpOptions.DhcpConfigurations, &ec2.DhcpConfiguration{Key: configuration.Key, Values: values})
+	}
+	f.dhcpOptions[aws.StringValue(dhcpOptions.DhcpOptionsId)] = dhcpOptions
+	return &ec2.CreateDhcpOptionsOutput{DhcpOptions: dhcpOptions}, nil
+}
+
+func (f *fakeEC2) CreateInternetGatewayWithContext(aws.Context, *ec2.CreateInternetGatewayInput, ...request.Option) (*ec2.CreateInternetGatewayOutput, error) {
+	f.calls["CreateInternetGateway"]++
+	internetGateway := &ec2.InternetGateway{InternetGatewayId: aws.String(f.newID("igw"))}
+	f.internetGateways[aws.StringValue(internetGateway.InternetGatewayId)] = internetGateway
+	return &ec2.CreateInternetGatewayOutput{InternetGateway: internetGateway}, nil
+}
+
+func (f *fakeEC2) CreateNatGatewayWithContext(_ aws.Context, input *ec2.CreateNatGatewayInput, _ ...request.Option) (*ec2.CreateNatGatewayOutput, error) {
+	f.calls["CreateNatGateway"]++
+	subnet, ok := f.subnets[aws.StringValue(input.SubnetId)]
+	if !ok {
+		return nil, notFound("InvalidSubnetID.NotFound", aws.StringValue(input.SubnetId))
+	}
+	if _, ok := f.addresses[aws.StringValue(input.AllocationId)]; !ok {
+		return nil, notFound("InvalidAllocationID.NotFound", aws.StringValue(input.AllocationId))
+	}
+	natGateway := &ec2.NatGateway{
+		NatGatewayId:        aws.String(f.newID("nat")),
+		NatGatewayAddresses: []*ec2.NatGatewayAddress{{AllocationId: input.AllocationId}},
+		State:               aws.String(ec2.NatGatewayStateAvailable),
+		SubnetId:            input.SubnetId,
+		VpcId:               subnet.VpcId,
+	}
+	f.natGateways[aws.StringValue(natGateway.NatGatewayId)] = natGateway
+	return &ec2.CreateNatGatewayOutput{NatGateway: natGateway}, nil
+}
+
+func (f *fakeEC2) CreateRouteWithContext(_ aws.Context, input *ec2.CreateRouteInput, _ ...request.Option) (*ec2.CreateRouteOutput, error) {
+	f.calls["CreateRoute"]++
+	routeTable, ok := f.routeTables[aws.StringValue(input.RouteTableId)]
+	if !ok {
+		return nil, notFound("InvalidRouteTableID.NotFound", aws.StringValue(input.RouteTableId))
+	}
+	for _, route := range routeTable.Routes {
+		if aws.StringValue(route.DestinationCidrBlock) == aws.StringValue(input.DestinationCidrBlock) {
+			return nil, awserr.New("RouteAlreadyExists", "the route already exists", nil)
+		}
+	}
+	routeTable.Routes = append(routeTable.Routes, &ec2.Route{
+		DestinationCidrBlock: input.DestinationCidrBlock,
+		GatewayId:            input.GatewayId,
+		NatGatewayId:         input.NatGatewayId,
+	})
+	return &ec2.CreateRouteOutput{Return: aws.Bool(true)}, nil
+}
+
+func (f *fakeEC2) CreateRouteTableWithContext(_ aws.Context, input *ec2.CreateRouteTableInput, _ ...request.Option) (*ec2.CreateRouteTableOutput, error) {
+	f.calls["CreateRouteTable"]++
+	routeTable := &ec2.RouteTable{RouteTableId: aws.String(f.newID("rtb")), VpcId: input.VpcId}
+	f.routeTables[aws.StringValue(routeTable.RouteTableId)] = routeTable
+	return &ec2.CreateRouteTableOutput{RouteTable: routeTable}, nil
+}
+
+func (f *fakeEC2) CreateSecurityGroupWithContext(_ aws.Context, input *ec2.CreateSecurityGroupInput, _ ...request.Option) (*ec2.CreateSecurityGroupOutput, error) {
+	f.calls["CreateSecurityGroup"]++
+	securityGroup := &ec2.SecurityGroup{
+		GroupId:     aws.String(f.newID("sg")),
+		GroupName:   input.GroupName,
+		Description: input.Description,
+		VpcId:       input.VpcId,
+		// Like AWS, allow all outbound traffic by default.
+		IpPermissionsEgress: []*ec2.IpPermission{{IpProtocol: aws.String("-1"), IpRanges: []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}}},
+	}
+	f.securityGroups[aws.StringValue(securityGroup.GroupId)] = securityGroup
+	return &ec2.CreateSecurityGroupOutput{GroupId: securityGroup.GroupId}, nil
+}
+
+func (f *fakeEC2) CreateSubnetWithContext(_ aws.Context, input *ec2.CreateSubnetInput, _ ...request.Option) (*ec2.CreateSubnetOutput, error) {
+	f.calls["CreateSubnet"]++
+	subnet := &ec2.Subnet{
+		SubnetId:         aws.String(f.newID("subnet")),
+		AvailabilityZone: input.AvailabilityZone,
+		CidrBlock:        input.CidrBlock,
+		VpcId:            input.VpcId,
+	}
+	f.subnets[aws.StringValue(subnet.SubnetId)] = subnet
+	return &ec2.CreateSubnetOutput{Subnet: subnet}, nil
+}
+
+func (f *fakeEC2) CreateTagsWithContext(_ aws.Context, input *ec2.CreateTagsInput, _ ...request.Option) (*ec2.CreateTagsOutput, error) {
+	f.calls["CreateTags"]++
+	for _, id := range aws.StringValueSlice(input.Resources) {
+		var tags *[]*ec2.Tag
+		switch {
+		case f.vpcs[id] != nil:
+			tags = &f.vpcs[id].Tags
+		case f.dhcpOptions[id] != nil:
+			tags = &f.dhcpOptions[id].Tags
+		case f.internetGateways[id] != nil:
+			tags = &f.internetGateways[id].Tags
+		case f.routeTables[id] != nil:
+			tags = &f.routeTables[id].Tags
+		case f.securityGroups[id] != nil:
+			tags = &f.securityGroups[id].Tags
+		case f.subnets[id] != nil:
+			tags = &f.subnets[id].Tags
+		case f.addresses[id] != nil:
+			tags = &f.addresses[id].Tags
+		case f.natGateways[id] != nil:
+			tags = &f.natGateways[id].Tags
+		default:
+			return nil, notFound("InvalidID", id)
+		}
+		*tags = append(*tags, input.Tags...)
+	}
+	return &ec2.CreateTagsOutput{}, nil
+}
+
+func (f *fakeEC2) CreateVpcWithContext(_ aws.Context, input *ec2.CreateVpcInput, _ ...request.Option) (*ec2.CreateVpcOutput, error) {
+	f.calls["CreateVpc"]++
+	vpc := &ec2.Vpc{VpcId: aws.String(f.newID("vpc")), CidrBlock: input.CidrBlock, DhcpOptionsId: aws.String("default")}
+	f.vpcs[aws.StringValue(vpc.VpcId)] = vpc
+	return &ec2.CreateVpcOutput{Vpc: vpc}, nil
+}
+
+func (f *fakeEC2) CreateVpcEndpointWithContext(_ aws.Context, input *ec2.CreateVpcEndpointInput, _ ...request.Option) (*ec2.CreateVpcEndpointOutput, error) {
+	f.calls["CreateVpcEndpoint"]++
+	for _, routeTableID := range aws.StringValueSlice(input.RouteTableIds) {
+		if _, ok := f.routeTables[routeTableID]; !ok {
+			return nil, notFound("InvalidRouteTableID.NotFound", routeTableID)
+		}
+	}
+	vpcEndpoint := &ec2.VpcEndpoint{
+		VpcEndpointId:   aws.String(f.newID("vpce")),
+		VpcEndpointType: input.VpcEndpointType,
+		VpcId:           input.VpcId,
+		ServiceName:     input.ServiceName,
+		RouteTableIds:   input.RouteTableIds,
+		State:           aws.String("available"),
+	}
+	f.vpcEndpoints[aws.StringValue(vpcEndpoint.VpcEndpointId)] = vpcEndpoint
+	return &ec2.CreateVpcEndpointOutput{VpcEndpoint: vpcEndpoint}, nil
+}
+
+func (f *fakeEC2) DeleteDhcpOptionsWithContext(_ aws.Context, input *ec2.DeleteDhcpOptionsInput, _ ...request.Option) (*ec2.DeleteDhcpOptionsOutput, error) {
+	f.calls["DeleteDhcpOptions"]++
+	id := aws.StringValue(input.DhcpOptionsId)
+	for _, vpc := range f.vpcs {
+		if aws.StringValue(vpc.DhcpOptionsId) == id {
+			return nil, dependencyViolation(id)
+		}
+	}
+	delete(f.dhcpOptions, id)
+	return &ec2.DeleteDhcpOptionsOutput{}, nil
+}
+
+func (f *fakeEC2) DeleteInternetGatewayWithContext(_ aws.Context, input *ec2.DeleteInternetGatewayInput, _ ...request.Option) (*ec2.DeleteInternetGatewayOutput, error) {
+	f.calls["DeleteInternetGateway"]++
+	id := aws.StringValue(input.InternetGatewayId)
+	if internetGateway, ok := f.internetGateways[id]; ok && len(internetGateway.Attachments) > 0 {
+		return nil, dependencyViolation(id)
+	}
+	delete(f.internetGateways, id)
+	return &ec2.DeleteInternetGatewayOutput{}, nil
+}
+
+func (f *fakeEC2) DeleteKeyPairWithContext(_ aws.Context, input *ec2.DeleteKeyPairInput, _ ...request.Option) (*ec2.DeleteKeyPairOutput, error) {
+	f.calls["DeleteKeyPair"]++
+	delete(f.keyPairs, aws.StringValue(input.KeyName))
+	return &ec2.DeleteKeyPairOutput{}, nil
+}
+
+func (f *fakeEC2) DeleteNatGatewayWithContext(_ aws.Context, input *ec2.DeleteNatGatewayInput, _ ...request.Option) (*ec2.DeleteNatGatewayOutput, error) {
+	f.calls["DeleteNatGateway"]++
+	natGateway, ok := f.natGateways[aws.StringValue(input.NatGatewayId)]
+	if !ok {
+		return nil, notFound("NatGatewayNotFound", aws.StringValue(input.NatGatewayId))
+	}
+	natGateway.State = aws.String(ec2.NatGatewayStateDeleted)
+	return &ec2.DeleteNatGatewayOutput{NatGatewayId: input.NatGatewayId}, nil
+}
+
+func (f *fakeEC2) DeleteRouteTableWithContext(_ aws.Context, input *ec2.DeleteRouteTableInput, _ ...request.Option) (*ec2.DeleteRouteTableOutput, error) {
+	f.calls["DeleteRouteTable"]++
+	id := aws.StringValue(input.RouteTableId)
+	if routeTable, ok := f.routeTables[id]; ok && len(routeTable.Associations) > 0 {
+		return nil, dependencyViolation(id)
+	}
+	delete(f.routeTables, id)
+	return &ec2.DeleteRouteTableOutput{}, nil
+}
+
+func (f *fakeEC2) DeleteSecurityGroupWithContext(_ aws.Context, input *ec2.DeleteSecurityGroupInput, _ ...request.Option) (*ec2.DeleteSecurityGroupOutput, error) {
+	f.calls["DeleteSecurityGroup"]++
+	id := aws.StringValue(input.GroupId)
+	for otherID, other := range f.securityGroups {
+		if otherID == id {
+			continue
+		}
+		for _, permission := range other.IpPermissions {
+			for _, pair := range permission.UserIdGroupPairs {
+				if aws.StringValue(pair.GroupId) == id {
+					return nil, dependencyViolation(id)
+				}
+			}
+		}
+	}
+	delete(f.securityGroups, id)
+	return &ec2.DeleteSecurityGroupOutput{}, nil
+}
+
+func (f *fakeEC2) DeleteSubnetWithContext(_ aws.Context, input *ec2.DeleteSubnetInput, _ ...request.Option) (*ec2.DeleteSubnetOutput, error) {
+	f.calls["DeleteSubnet"]++
+	id := aws.StringValue(input.SubnetId)
+	for _, natGateway := range f.natGateways {
+		if aws.StringValue(natGateway.SubnetId) == id && aws.StringValue(natGateway.State) != ec2.NatGatewayStateDeleted {
+			return nil, dependencyViolation(id)
+		}
+	}
+	for _, routeTable := range f.routeTables {
+		var associations []*ec2.RouteTableAssociation
+		for _, association := range routeTable.Associations {
+			if aws.StringValue(association.SubnetId) != id {
+				associations = append(associations, association)
+			}
+		}
+		routeTable.Associations = associations
+	}
+	delete(f.subnets, id)
+	return &ec2.DeleteSubnetOutput{}, nil
+}
+
+func (f *fakeEC2) DeleteVpcWithContext(_ aws.Context, input *ec2.DeleteVpcInput, _ ...request.Option) (*ec2.DeleteVpcOutput, error) {
+	f.calls["DeleteVpc"]++
+	id := aws.StringValue(input.VpcId)
+	for _, subnet := range f.subnets {
+		if aws.StringValue(subnet.VpcId) == id {
+			return nil, dependencyViolation(id)
+		}
+	}
+	for _, routeTable := range f.routeTables {
+		if aws.StringValue(routeTable.VpcId) == id {
+			return nil, dependencyViolation(id)
+		}
+	}
+	for _, securityGroup := range f.securityGroups {
+		if aws.StringValue(securityGroup.VpcId) == id {
+			return nil, dependencyViolation(id)
+		}
+	}
+	for _, internetGateway := range f.internetGateways {
+		for _, attachment := range internetGateway.Attachments {
+			if aws.StringValue(attachment.VpcId) == id {
+				return nil, dependencyViolation(id)
+			}
+		}
+	}
+	for _, vpcEndpoint := range f.vpcEndpoints {
+		if aws.StringValue(vpcEndpoint.VpcId) == id {
+			return nil, dependencyViolation(id)
+		}
+	}
+	delete(f.vpcs, id)
+	return &ec2.DeleteVpcOutput{}, nil
+}
+
+func (f *fakeEC2) DeleteVpcEndpointsWithContext(_ aws.Context, input *ec2.DeleteVpcEndpointsInput, _ ...request.Option) (*ec2.DeleteVpcEndpointsOutput, error) {
+	f.calls["DeleteVpcEndpoints"]++
+	for _, id := range aws.StringValueSlice(input.VpcEndpointIds) {
+		delete(f.vpcEndpoints, id)
+	}
+	return &ec2.DeleteVpcEndpointsOutput{}, nil
+}
+
+func (f *fakeEC2) DescribeAddressesWithContext(_ aws.Context, input *ec2.DescribeAddressesInput, _ ...request.Option) (*ec2.DescribeAddressesOutput, error) {
+	f.calls["DescribeAddresses"]++
+	out := &ec2.DescribeAddressesOutput{}
+	for id, address := range f.addresses {
+		if matches(input.Filters, address.Tags, map[string][]string{"allocation-id": {id}}) {
+			copied := *address
+			out.Addresses = append(out.Addresses, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeEC2) DescribeDhcpOptionsWithContext(_ aws.Context, input *ec2.DescribeDhcpOptionsInput, _ ...request.Option) (*ec2.DescribeDhcpOptionsOutput, error) {
+	f.calls["DescribeDhcpOptions"]++
+	out := &ec2.DescribeDhcpOptionsOutput{}
+	for id, dhcpOptions := range f.dhcpOptions {
+		if matches(input.Filters, dhcpOptions.Tags, map[string][]string{"dhcp-options-id": {id}}) {
+			copied := *dhcpOptions
+			out.DhcpOptions = append(out.DhcpOptions, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeEC2) DescribeInternetGatewaysWithContext(_ aws.Context, input *ec2.DescribeInternetGatewaysInput, _ ...request.Option) (*ec2.DescribeInternetGatewaysOutput, error) {
+	f.calls["DescribeInternetGateways"]++
+	out := &ec2.DescribeInternetGatewaysOutput{}
+	for id, internetGateway := range f.internetGateways {
+		var vpcIDs []string
+		for _, attachment := range internetGateway.Attachments {
+			vpcIDs = append(vpcIDs, aws.StringValue(attachment.VpcId))
+		}
+		if matches(input.Filters, internetGateway.Tags, map[string][]string{"internet-gateway-id": {id}, "attachment.vpc-id": vpcIDs}) {
+			copied := *internetGateway
+			out.InternetGateways = append(out.InternetGateways, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeEC2) DescribeKeyPairsWithContext(_ aws.Context, input *ec2.DescribeKeyPairsInput, _ ...request.Option) (*ec2.DescribeKeyPairsOutput, error) {
+	f.calls["DescribeKeyPairs"]++
+	out := &ec2.DescribeKeyPairsOutput{}
+	for name, keyPair := range f.keyPairs {
+		if matches(input.Filters, nil, map[string][]string{"key-name": {name}}) {
+			copied := *keyPair
+			out.KeyPairs = append(out.KeyPairs, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeEC2) DescribeNatGatewaysWithContext(_ aws.Context, input *ec2.DescribeNatGatewaysInput, _ ...request.Option) (*ec2.DescribeNatGatewaysOutput, error) {
+	f.calls["DescribeNatGateways"]++
+	out := &ec2.DescribeNatGatewaysOutput{}
+	for id, natGateway := range f.natGateways {
+		if len(input.NatGatewayIds) > 0 && !containsAny([]string{id}, aws.StringValueSlice(input.NatGatewayIds)) {
+			continue
+		}
+		if matches(input.Filter, natGateway.Tags, map[string][]string{"nat-gateway-id": {id}, "state": {aws.StringValue(natGateway.State)}}) {
+			copied := *natGateway
+			out.NatGateways = append(out.NatGateways, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeEC2) DescribeRouteTablesWithContext(_ aws.Context, input *ec2.DescribeRouteTablesInput, _ ...request.Option) (*ec2.DescribeRouteTablesOutput, error) {
+	f.calls["DescribeRouteTables"]++
+	out := &ec2.DescribeRouteTablesOutput{}
+	for id, routeTable := range f.routeTables {
+		var subnetIDs []string
+		for _, association := range routeTable.Associations {
+			subnetIDs = append(subnetIDs, aws.StringValue(association.SubnetId))
+		}
+		if matches(input.Filters, routeTable.Tags, map[string][]string{"route-table-id": {id}, "vpc-id": {aws.StringValue(routeTable.VpcId)}, "association.subnet-id": subnetIDs}) {
+			copied := *routeTable
+			out.RouteTables = append(out.RouteTables, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeEC2) DescribeSecurityGroupsWithContext(_ aws.Context, input *ec2.DescribeSecurityGroupsInput, _ ...request.Option) (*ec2.DescribeSecurityGroupsOutput, error) {
+	f.calls["DescribeSecurityGroups"]++
+	out := &ec2.DescribeSecurityGroupsOutput{}
+	for id, securityGroup := range f.securityGroups {
+		if matches(input.Filters, securityGroup.Tags, map[string][]string{"group-id": {id}, "group-name": {aws.StringValue(securityGroup.GroupName)}, "vpc-id": {aws.StringValue(securityGroup.VpcId)}}) {
+			copied := *securityGroup
+			out.SecurityGroups = append(out.SecurityGroups, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeEC2) DescribeSubnetsWithContext(_ aws.Context, input *ec2.DescribeSubnetsInput, _ ...request.Option) (*ec2.DescribeSubnetsOutput, error) {
+	f.calls["DescribeSubnets"]++
+	out := &ec2.DescribeSubnetsOutput{}
+	for id, subnet := range f.subnets {
+		if matches(input.Filters, subnet.Tags, map[string][]string{"subnet-id": {id}, "vpc-id": {aws.StringValue(subnet.VpcId)}}) {
+			copied := *subnet
+			out.Subnets = append(out.Subnets, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeEC2) DescribeVpcEndpointsWithContext(_ aws.Context, input *ec2.DescribeVpcEndpointsInput, _ ...request.Option) (*ec2.DescribeVpcEndpointsOutput, error) {
+	f.calls["DescribeVpcEndpoints"]++
+	out := &ec2.DescribeVpcEndpointsOutput{}
+	for id, vpcEndpoint := range f.vpcEndpoints {
+		if matches(input.Filters, nil, map[string][]string{"vpc-endpoint-id": {id}, "vpc-id": {aws.StringValue(vpcEndpoint.VpcId)}, "service-name": {aws.StringValue(vpcEndpoint.ServiceName)}, "vpc-endpoint-state": {aws.StringValue(vpcEndpoint.State)}}) {
+			copied := *vpcEndpoint
+			out.VpcEndpoints = append(out.VpcEndpoints, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeEC2) DescribeVpcsWithContext(_ aws.Context, input *ec2.DescribeVpcsInput, _ ...request.Option) (*ec2.DescribeVpcsOutput, error) {
+	f.calls["DescribeVpcs"]++
+	out := &ec2.DescribeVpcsOutput{}
+	for id, vpc := range f.vpcs {
+		if matches(input.Filters, vpc.Tags, map[string][]string{"vpc-id": {id}}) {
+			copied := *vpc
+			out.Vpcs = append(out.Vpcs, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeEC2) DetachInternetGatewayWithContext(_ aws.Context, input *ec2.DetachInternetGatewayInput, _ ...request.Option) (*ec2.DetachInternetGatewayOutput, error) {
+	f.calls["DetachInternetGateway"]++
+	internetGateway, ok := f.internetGateways[aws.StringValue(input.InternetGatewayId)]
+	if !ok {
+		return nil, notFound("InvalidInternetGatewayID.NotFound", aws.StringValue(input.InternetGatewayId))
+	}
+	var attachments []*ec2.InternetGatewayAttachment
+	for _, attachment := range internetGateway.Attachments {
+		if aws.StringValue(attachment.VpcId) != aws.StringValue(input.VpcId) {
+			attachments = append(attachments, attachment)
+		}
+	}
+	internetGateway.Attachments = attachments
+	return &ec2.DetachInternetGatewayOutput{}, nil
+}
+
+func (f *fakeEC2) DisassociateRouteTableWithContext(_ aws.Context, input *ec2.DisassociateRouteTableInput, _ ...request.Option) (*ec2.DisassociateRouteTableOutput, error) {
+	f.calls["DisassociateRouteTable"]++
+	for _, routeTable := range f.routeTables {
+		for i, association := range routeTable.Associations {
+			if aws.StringValue(association.RouteTableAssociationId) == aws.StringValue(input.AssociationId) {
+				routeTable.Associations = append(routeTable.Associations[:i], routeTable.Associations[i+1:]...)
+				return &ec2.DisassociateRouteTableOutput{}, nil
+			}
+		}
+	}
+	return nil, notFound("InvalidAssociationID.NotFound", aws.StringValue(input.AssociationId))
+}
+
+func (f *fakeEC2) ImportKeyPairWithContext(_ aws.Context, input *ec2.ImportKeyPairInput, _ ...request.Option) (*ec2.ImportKeyPairOutput, error) {
+	f.calls["ImportKeyPair"]++
+	f.keyPairs[aws.StringValue(input.KeyName)] = &ec2.KeyPairInfo{KeyName: input.KeyName, KeyFingerprint: aws.String(string(input.PublicKeyMaterial))}
+	return &ec2.ImportKeyPairOutput{KeyName: input.KeyName}, nil
+}
+
+func (f *fakeEC2) ModifyVpcAttributeWithContext(aws.Context, *ec2.ModifyVpcAttributeInput, ...request.Option) (*ec2.ModifyVpcAttributeOutput, error) {
+	f.calls["ModifyVpcAttribute"]++
+	return &ec2.ModifyVpcAttributeOutput{}, nil
+}
+
+func (f *fakeEC2) ModifyVpcEndpointWithContext(_ aws.Context, input *ec2.ModifyVpcEndpointInput, _ ...request.Option) (*ec2.ModifyVpcEndpointOutput, error) {
+	f.calls["ModifyVpcEndpoint"]++
+	vpcEndpoint, ok := f.vpcEndpoints[aws.StringValue(input.VpcEndpointId)]
+	if !ok {
+		return nil, notFound("InvalidVpcEndpointId.NotFound", aws.StringValue(input.VpcEndpointId))
+	}
+	var routeTableIDs []*string
+	for _, id := range vpcEndpoint.RouteTableIds {
+		if !containsAny([]string{aws.StringValue(id)}, aws.StringValueSlice(input.RemoveRouteTableIds)) {
+			routeTableIDs = append(routeTableIDs, id)
+		}
+	}
+	vpcEndpoint.RouteTableIds = append(routeTableIDs, input.AddRouteTableIds...)
+	return &ec2.ModifyVpcEndpointOutput{Return: aws.Bool(true)}, nil
+}
+
+func (f *fakeEC2) ReleaseAddressWithContext(_ aws.Context, input *ec2.ReleaseAddressInput, _ ...request.Option) (*ec2.ReleaseAddressOutput, error) {
+	f.calls["ReleaseAddress"]++
+	id := aws.StringValue(input.AllocationId)
+	for _, natGateway := range f.natGateways {
+		if aws.StringValue(natGateway.State) == ec2.NatGatewayStateDeleted {
+			continue
+		}
+		for _, address := range natGateway.NatGatewayAddresses {
+			if aws.StringValue(address.AllocationId) == id {
+				return nil, dependencyViolation(id)
+			}
+		}
+	}
+	delete(f.addresses, id)
+	return &ec2.ReleaseAddressOutput{}, nil
+}
+
+func (f *fakeEC2) ReplaceRouteWithContext(_ aws.Context, input *ec2.ReplaceRouteInput, _ ...request.Option) (*ec2.ReplaceRouteOutput, error) {
+	f.calls["ReplaceRoute"]++
+	routeTable, ok := f.routeTables[aws.StringValue(input.RouteTableId)]
+	if !ok {
+		return nil, notFound("InvalidRouteTableID.NotFound", aws.StringValue(input.RouteTableId))
+	}
+	for _, route := range routeTable.Routes {
+		if aws.StringValue(route.DestinationCidrBlock) == aws.StringValue(input.DestinationCidrBlock) {
+			route.GatewayId, route.NatGatewayId = input.GatewayId, input.NatGatewayId
+			return &ec2.ReplaceRouteOutput{}, nil
+		}
+	}
+	return nil, notFound("InvalidRoute.NotFound", aws.StringValue(input.DestinationCidrBlock))
+}
+
+func (f *fakeEC2) ReplaceRouteTableAssociationWithContext(_ aws.Context, input *ec2.ReplaceRouteTableAssociationInput, _ ...request.Option) (*ec2.ReplaceRouteTableAssociationOutput, error) {
+	f.calls["ReplaceRouteTableAssociation"]++
+	target, ok := f.routeTables[aws.StringValue(input.RouteTableId)]
+	if !ok {
+		return nil, notFound("InvalidRouteTableID.NotFound", aws.StringValue(input.RouteTableId))
+	}
+	for _, routeTable := range f.routeTables {
+		for i, association := range routeTable.Associations {
+			if aws.StringValue(association.RouteTableAssociationId) != aws.StringValue(input.AssociationId) {
+				continue
+			}
+			routeTable.Associations = append(routeTable.Associations[:i], routeTable.Associations[i+1:]...)
+			id := f.newID("rtbassoc")
+			target.Associations = append(target.Associations, &ec2.RouteTableAssociation{
+				RouteTableAssociationId: aws.String(id),
+				RouteTableId:            input.RouteTableId,
+				SubnetId:                association.SubnetId,
+			})
+			return &ec2.ReplaceRouteTableAssociationOutput{NewAssociationId: aws.String(id)}, nil
+		}
+	}
+	return nil, notFound("InvalidAssociationID.NotFound", aws.StringValue(input.AssociationId))
+}
+
+// fakeIAM is an in-memory implementation of the native.IAM interface.
+type fakeIAM struct {
+	calls map[string]int
+
+	roles            map[string]*iam.Role
+	rolePolicies     map[string]string
+	instanceProfiles map[string]*iam.InstanceProfile
+}
+
+func newFakeIAM() *fakeIAM {
+	return &fakeIAM{
+		calls:            map[string]int{},
+		roles:            map[string]*iam.Role{},
+		rolePolicies:     map[string]string{},
+		instanceProfiles: map[string]*iam.InstanceProfile{},
+	}
+}
+
+func noSuchEntity(name string) error {
+	return awserr.New(iam.ErrCodeNoSuchEntityException, fmt.Sprintf("%s cannot be found", name), nil)
+}
+
+func (f *fakeIAM) AddRoleToInstanceProfileWithContext(_ aws.Context, input *iam.AddRoleToInstanceProfileInput, _ ...request.Option) (*iam.AddRoleToInstanceProfileOutput, error) {
+	f.calls["AddRoleToInstanceProfile"]++
+	instanceProfile, ok := f.instanceProfiles[aws.StringValue(input.InstanceProfileName)]
+	if !ok {
+		return nil, noSuchEntity(aws.StringValue(input.InstanceProfileName))
+	}
+	role, ok := f.roles[aws.StringValue(input.RoleName)]
+	if !ok {
+		return nil, noSuchEntity(aws.StringValue(input.RoleName))
+	}
+	instanceProfile.Roles = append(instanceProfile.Roles, role)
+	return &iam.AddRoleToInstanceProfileOutput{}, nil
+}
+
+func (f *fakeIAM) CreateInstanceProfileWithContext(_ aws.Context, input *iam.CreateInstanceProfileInput, _ ...request.Option) (*iam.CreateInstanceProfileOutput, error) {
+	f.calls["CreateInstanceProfile"]++
+	instanceProfile := &iam.InstanceProfile{InstanceProfileName: input.InstanceProfileName, Path: input.Path}
+	f.instanceProfiles[aws.StringValue(input.InstanceProfileName)] = instanceProfile
+	return &iam.CreateInstanceProfileOutput{InstanceProfile: instanceProfile}, nil
+}
+
+func (f *fakeIAM) CreateRoleWithContext(_ aws.Context, input *iam.CreateRoleInput, _ ...request.Option) (*iam.CreateRoleOutput, error) {
+	f.calls["CreateRole"]++
+	role := &iam.Role{
+		RoleName:                 input.RoleName,
+		Path:                     input.Path,
+		AssumeRolePolicyDocument: input.AssumeRolePolicyDocument,
+		Arn:                      aws.String("arn:aws:iam::123456789012:role/" + aws.StringValue(input.RoleName)),
+	}
+	f.roles[aws.StringValue(input.RoleName)] = role
+	return &iam.CreateRoleOutput{Role: role}, nil
+}
+
+func (f *fakeIAM) DeleteInstanceProfileWithContext(_ aws.Context, input *iam.DeleteInstanceProfileInput, _ ...request.Option) (*iam.DeleteInstanceProfileOutput, error) {
+	f.calls["DeleteInstanceProfile"]++
+	instanceProfile, ok := f.instanceProfiles[aws.StringValue(input.InstanceProfileName)]
+	if !ok {
+		return nil, noSuchEntity(aws.StringValue(input.InstanceProfileName))
+	}
+	if len(instanceProfile.Roles) > 0 {
+		return nil, awserr.New(iam.ErrCodeDeleteConflictException, "instance profile has roles", nil)
+	}
+	delete(f.instanceProfiles, aws.StringValue(input.InstanceProfileName))
+	return &iam.DeleteInstanceProfileOutput{}, nil
+}
+
+func (f *fakeIAM) DeleteRolePolicyWithContext(_ aws.Context, input *iam.DeleteRolePolicyInput, _ ...request.Option) (*iam.DeleteRolePolicyOutput, error) {
+	f.calls["DeleteRolePolicy"]++
+	key := aws.StringValue(input.RoleName) + "/" + aws.StringValue(input.PolicyName)
+	if _, ok := f.rolePolicies[key]; !ok {
+		return nil, noSuchEntity(key)
+	}
+	delete(f.rolePolicies, key)
+	return &iam.DeleteRolePolicyOutput{}, nil
+}
+
+func (f *fakeIAM) DeleteRoleWithContext(_ aws.Context, input *iam.DeleteRoleInput, _ ...request.Option) (*iam.DeleteRoleOutput, error) {
+	f.calls["DeleteRole"]++
+	name := aws.StringValue(input.RoleName)
+	if _, ok := f.roles[name]; !ok {
+		return nil, noSuchEntity(name)
+	}
+	for key := range f.rolePolicies {
+		if strings.HasPrefix(key, name+"/") {
+			return nil, awserr.New(iam.ErrCodeDeleteConflictException, "role has policies", nil)
+		}
+	}
+	delete(f.roles, name)
+	return &iam.DeleteRoleOutput{}, nil
+}
+
+func (f *fakeIAM) GetInstanceProfileWithContext(_ aws.Context, input *iam.GetInstanceProfileInput, _ ...request.Option) (*iam.GetInstanceProfileOutput, error) {
+	f.calls["GetInstanceProfile"]++
+	instanceProfile, ok := f.instanceProfiles[aws.StringValue(input.InstanceProfileName)]
+	if !ok {
+		return nil, noSuchEntity(aws.StringValue(input.InstanceProfileName))
+	}
+	return &iam.GetInstanceProfileOutput{InstanceProfile: instanceProfile}, nil
+}
+
+func (f *fakeIAM) GetRoleWithContext(_ aws.Context, input *iam.GetRoleInput, _ ...request.Option) (*iam.GetRoleOutput, error) {
+	f.calls["GetRole"]++
+	role, ok := f.roles[aws.StringValue(input.RoleName)]
+	if !ok {
+		return nil, noSuchEntity(aws.StringValue(input.RoleName))
+	}
+	return &iam.GetRoleOutput{Role: role}, nil
+}
+
+func (f *fakeIAM) PutRolePolicyWithContext(_ aws.Context, input *iam.PutRolePolicyInput, _ ...request.Option) (*iam.PutRolePolicyOutput, error) {
+	f.calls["PutRolePolicy"]++
+	if _, ok := f.roles[aws.StringValue(input.RoleName)]; !ok {
+		return nil, noSuchEntity(aws.StringValue(input.RoleName))
+	}
+	f.rolePolicies[aws.StringValue(input.RoleName)+"/"+aws.StringValue(input.PolicyName)] = aws.StringValue(input.PolicyDocument)
+	return &iam.PutRolePolicyOutput{}, nil
+}
+
+func (f *fakeIAM) RemoveRoleFromInstanceProfileWithContext(_ aws.Context, input *iam.RemoveRoleFromInstanceProfileInput, _ ...request.Option) (*iam.RemoveRoleFromInstanceProfileOutput, error) {
+	f.calls["RemoveRoleFromInstanceProfile"]++
+	instanceProfile, ok := f.instanceProfiles[aws.StringValue(input.InstanceProfileName)]
+	if !ok {
+		return nil, noSuchEntity(aws.StringValue(input.InstanceProfileName))
+	}
+	var roles []*iam.Role
+	for _, role := range instanceProfile.Roles {
+		if aws.StringValue(role.RoleName) != aws.StringValue(input.RoleName) {
+			roles = append(roles, role)
+		}
+	}
+	if len(roles) == len(instanceProfile.Roles) {
+		return nil, noSuchEntity(aws.StringValue(input.RoleName))
+	}
+	instanceProfile.Roles = roles
+	return &iam.RemoveRoleFromInstanceProfileOutput{}, nil
+}
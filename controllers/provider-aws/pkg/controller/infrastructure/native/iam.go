@@ -0,0 +1,175 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+const assumeRolePolicyDocument = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+const bastionsPolicyDocument = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ec2:DescribeRegions"
+      ],
+      "Resource": [
+        "*"
+      ]
+    }
+  ]
+}`
+
+const nodesPolicyDocument = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ec2:DescribeInstances"
+      ],
+      "Resource": [
+        "*"
+      ]
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ecr:GetAuthorizationToken",
+        "ecr:BatchCheckLayerAvailability",
+        "ecr:GetDownloadUrlForLayer",
+        "ecr:GetRepositoryPolicy",
+        "ecr:DescribeRepositories",
+        "ecr:ListImages",
+        "ecr:BatchGetImage"
+      ],
+      "Resource": [
+        "*"
+      ]
+    }
+  ]
+}`
+
+// ensureRoleAndInstanceProfile ensures that the role, its inline policy, and the instance profile with the given
+// <name> exist. It returns the ARN of the role.
+func (r *Reconciler) ensureRoleAndInstanceProfile(ctx context.Context, name, policyDocument string) (string, error) {
+	var role *iam.Role
+
+	getRoleOutput, err := r.iam.GetRoleWithContext(ctx, &iam.GetRoleInput{RoleName: aws.String(name)})
+	switch {
+	case err == nil:
+		role = getRoleOutput.Role
+	case isAWSError(err, iam.ErrCodeNoSuchEntityException):
+		createRoleOutput, err := r.iam.CreateRoleWithContext(ctx, &iam.CreateRoleInput{
+			RoleName:                 aws.String(name),
+			Path:                     aws.String("/"),
+			AssumeRolePolicyDocument: aws.String(assumeRolePolicyDocument),
+		})
+		if err != nil {
+			return "", err
+		}
+		role = createRoleOutput.Role
+		r.logger.Info("Created IAM role", "role", name)
+	default:
+		return "", err
+	}
+
+	if _, err := r.iam.PutRolePolicyWithContext(ctx, &iam.PutRolePolicyInput{
+		RoleName:       aws.String(name),
+		PolicyName:     aws.String(name),
+		PolicyDocument: aws.String(policyDocument),
+	}); err != nil {
+		return "", err
+	}
+
+	var instanceProfile *iam.InstanceProfile
+
+	getInstanceProfileOutput, err := r.iam.GetInstanceProfileWithContext(ctx, &iam.GetInstanceProfileInput{InstanceProfileName: aws.String(name)})
+	switch {
+	case err == nil:
+		instanceProfile = getInstanceProfileOutput.InstanceProfile
+	case isAWSError(err, iam.ErrCodeNoSuchEntityException):
+		createInstanceProfileOutput, err := r.iam.CreateInstanceProfileWithContext(ctx, &iam.CreateInstanceProfileInput{
+			InstanceProfileName: aws.String(name),
+			Path:                aws.String("/"),
+		})
+		if err != nil {
+			return "", err
+		}
+		instanceProfile = createInstanceProfileOutput.InstanceProfile
+		r.logger.Info("Created IAM instance profile", "instanceProfile", name)
+	default:
+		return "", err
+	}
+
+	for _, profileRole := range instanceProfile.Roles {
+		if aws.StringValue(profileRole.RoleName) == name {
+			return aws.StringValue(role.Arn), nil
+		}
+	}
+
+	if _, err := r.iam.AddRoleToInstanceProfileWithContext(ctx, &iam.AddRoleToInstanceProfileInput{
+		InstanceProfileName: aws.String(name),
+		RoleName:            aws.String(name),
+	}); err != nil {
+		return "", err
+	}
+	return aws.StringValue(role.Arn), nil
+}
+
+// deleteRoleAndInstanceProfile deletes the instance profile, the inline policy and the role with the given <name>.
+// Already deleted resources are ignored.
+func (r *Reconciler) deleteRoleAndInstanceProfile(ctx context.Context, name string) error {
+	if _, err := r.iam.RemoveRoleFromInstanceProfileWithContext(ctx, &iam.RemoveRoleFromInstanceProfileInput{
+		InstanceProfileName: aws.String(name),
+		RoleName:            aws.String(name),
+	}); err != nil && !isAWSError(err, iam.ErrCodeNoSuchEntityException) {
+		return err
+	}
+	if _, err := r.iam.DeleteInstanceProfileWithContext(ctx, &iam.DeleteInstanceProfileInput{
+		InstanceProfileName: aws.String(name),
+	}); err != nil && !isAWSError(err, iam.ErrCodeNoSuchEntityException) {
+		return err
+	}
+	if _, err := r.iam.DeleteRolePolicyWithContext(ctx, &iam.DeleteRolePolicyInput{
+		RoleName:   aws.String(name),
+		PolicyName: aws.String(name),
+	}); err != nil && !isAWSError(err, iam.ErrCodeNoSuchEntityException) {
+		return err
+	}
+	if _, err := r.iam.DeleteRoleWithContext(ctx, &iam.DeleteRoleInput{
+		RoleName: aws.String(name),
+	}); err != nil && !isAWSError(err, iam.ErrCodeNoSuchEntityException) {
+		return err
+	}
+	return nil
+}
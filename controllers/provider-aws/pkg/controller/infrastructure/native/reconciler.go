@@ -0,0 +1,505 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	awsv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/v1alpha1"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/go-logr/logr"
+)
+
+const (
+	purposeBastions = "bastions"
+	purposeNodes    = "nodes"
+
+	nodePortRangeFrom int64 = 30000
+	nodePortRangeTo   int64 = 32767
+)
+
+// Reconciler creates, updates, and deletes the AWS infrastructure of a cluster by calling the AWS API directly.
+// It manages the same resources with the same names and tags as the Terraform configuration of the `aws-infra` chart.
+type Reconciler struct {
+	logger logr.Logger
+	ec2    EC2
+	iam    IAM
+
+	pollInterval      time.Duration
+	natGatewayTimeout time.Duration
+}
+
+// NewReconciler creates a new Reconciler using the given EC2 and IAM clients.
+func NewReconciler(logger logr.Logger, ec2Client EC2, iamClient IAM) *Reconciler {
+	return &Reconciler{
+		logger:            logger,
+		ec2:               ec2Client,
+		iam:               iamClient,
+		pollInterval:      5 * time.Second,
+		natGatewayTimeout: 10 * time.Minute,
+	}
+}
+
+// Reconcile idempotently creates or adopts all resources of the infrastructure described by the given request and
+// returns the resulting provider status.
+func (r *Reconciler) Reconcile(ctx context.Context, req *Request) (*awsv1alpha1.InfrastructureStatus, error) {
+	var (
+		clusterName = req.ClusterName
+		networks    = req.Config.Networks
+
+		vpcID             string
+		internetGatewayID string
+		err               error
+	)
+
+	switch {
+	case networks.VPC.ID != nil:
+		vpcID = *networks.VPC.ID
+		if internetGatewayID, err = r.getAttachedInternetGateway(ctx, vpcID); err != nil {
+			return nil, err
+		}
+	case networks.VPC.CIDR != nil:
+		dhcpOptionsID, err := r.ensureDHCPOptions(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("could not reconcile DHCP options: %+v", err)
+		}
+		if vpcID, err = r.ensureVPC(ctx, req, string(*networks.VPC.CIDR), dhcpOptionsID); err != nil {
+			return nil, fmt.Errorf("could not reconcile VPC: %+v", err)
+		}
+		if internetGatewayID, err = r.ensureInternetGateway(ctx, req, vpcID); err != nil {
+			return nil, fmt.Errorf("could not reconcile internet gateway: %+v", err)
+		}
+	default:
+		return nil, fmt.Errorf("either the ID or the CIDR of the VPC must be given")
+	}
+
+	mainRouteTable, err := r.ensureRouteTable(ctx, req, vpcID, req.State.ID(addressMainRouteTable), clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("could not reconcile main route table: %+v", err)
+	}
+	if err := r.ensureRoute(ctx, mainRouteTable, allIPv4CIDR, internetGatewayID, ""); err != nil {
+		return nil, fmt.Errorf("could not reconcile route to internet gateway: %+v", err)
+	}
+
+	nodesSecurityGroup, err := r.reconcileSecurityGroups(ctx, req, vpcID)
+	if err != nil {
+		return nil, fmt.Errorf("could not reconcile security groups: %+v", err)
+	}
+
+	var (
+		subnets       []awsv1alpha1.Subnet
+		natGateways   []awsv1alpha1.NATGateway
+		routeTableIDs = []string{aws.StringValue(mainRouteTable.RouteTableId)}
+	)
+	for zoneIndex, zone := range networks.Zones {
+		status, err := r.reconcileZone(ctx, req, vpcID, mainRouteTable, zoneIndex, zone)
+		if err != nil {
+			return nil, fmt.Errorf("could not reconcile zone %s: %+v", zone.Name, err)
+		}
+		subnets = append(subnets,
+			awsv1alpha1.Subnet{ID: status.nodesSubnetID, Purpose: awsv1alpha1.PurposeNodes, Zone: zone.Name},
+			awsv1alpha1.Subnet{ID: status.publicSubnetID, Purpose: awsv1alpha1.PurposePublic, Zone: zone.Name},
+		)
+		natGateways = append(natGateways, status.natGateway)
+		routeTableIDs = append(routeTableIDs, status.routeTableID)
+	}
+
+	if err := r.ensureGatewayEndpoints(ctx, req, vpcID, routeTableIDs); err != nil {
+		return nil, fmt.Errorf("could not reconcile VPC gateway endpoints: %+v", err)
+	}
+
+	if _, err := r.ensureRoleAndInstanceProfile(ctx, fmt.Sprintf("%s-%s", clusterName, purposeBastions), bastionsPolicyDocument); err != nil {
+		return nil, fmt.Errorf("could not reconcile IAM resources for bastions: %+v", err)
+	}
+	nodesRoleARN, err := r.ensureRoleAndInstanceProfile(ctx, fmt.Sprintf("%s-%s", clusterName, purposeNodes), nodesPolicyDocument)
+	if err != nil {
+		return nil, fmt.Errorf("could not reconcile IAM resources for nodes: %+v", err)
+	}
+
+	keyName, err := r.ensureKeyPair(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("could not reconcile key pair: %+v", err)
+	}
+
+	return &awsv1alpha1.InfrastructureStatus{
+		VPC: awsv1alpha1.VPCStatus{
+			ID:          vpcID,
+			Subnets:     subnets,
+			NATGateways: natGateways,
+			SecurityGroups: []awsv1alpha1.SecurityGroup{
+				{
+					Purpose: awsv1alpha1.PurposeNodes,
+					ID:      aws.StringValue(nodesSecurityGroup.GroupId),
+				},
+			},
+		},
+		EC2: awsv1alpha1.EC2{
+			KeyName: keyName,
+		},
+		IAM: awsv1alpha1.IAM{
+			InstanceProfiles: []awsv1alpha1.InstanceProfile{
+				{
+					Purpose: awsv1alpha1.PurposeNodes,
+					Name:    fmt.Sprintf("%s-%s", clusterName, purposeNodes),
+				},
+			},
+			Roles: []awsv1alpha1.Role{
+				{
+					Purpose: awsv1alpha1.PurposeNodes,
+					ARN:     nodesRoleARN,
+				},
+			},
+		},
+	}, nil
+}
+
+// reconcileSecurityGroups reconciles the bastions and nodes security groups and returns the latter.
+func (r *Reconciler) reconcileSecurityGroups(ctx context.Context, req *Request, vpcID string) (*ec2.SecurityGroup, error) {
+	egressAll := []*ec2.IpPermission{cidrPermission("-1", 0, 0, allIPv4CIDR)}
+
+	bastions, err := r.ensureSecurityGroup(ctx, req, vpcID, purposeBastions, "Security group for bastions")
+	if err != nil {
+		return nil, err
+	}
+	if err := r.ensureSecurityGroupRules(ctx, bastions, []*ec2.IpPermission{
+		cidrPermission("tcp", 22, 22, allIPv4CIDR),
+	}, egressAll); err != nil {
+		return nil, err
+	}
+
+	nodes, err := r.ensureSecurityGroup(ctx, req, vpcID, purposeNodes, "Security group for nodes")
+	if err != nil {
+		return nil, err
+	}
+
+	nodesIngress := []*ec2.IpPermission{
+		groupPermission("-1", 0, 0, aws.StringValue(nodes.GroupId)),
+		cidrPermission("tcp", nodePortRangeFrom, nodePortRangeTo, allIPv4CIDR),
+		cidrPermission("udp", nodePortRangeFrom, nodePortRangeTo, allIPv4CIDR),
+		groupPermission("tcp", 22, 22, aws.StringValue(bastions.GroupId)),
+	}
+	for _, zone := range req.Config.Networks.Zones {
+		for _, cidr := range []string{string(zone.Internal), string(zone.Public)} {
+			nodesIngress = append(nodesIngress,
+				cidrPermission("tcp", nodePortRangeFrom, nodePortRangeTo, cidr),
+				cidrPermission("udp", nodePortRangeFrom, nodePortRangeTo, cidr),
+			)
+		}
+	}
+	if err := r.ensureSecurityGroupRules(ctx, nodes, nodesIngress, egressAll); err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+// zoneStatus contains the results of reconciling a zone.
+type zoneStatus struct {
+	nodesSubnetID  string
+	publicSubnetID string
+	natGateway     awsv1alpha1.NATGateway
+	routeTableID   string
+}
+
+// reconcileZone reconciles the subnets, the NAT gateway and the routing of the zone with the given index. Subnets and
+// elastic IPs given in the zone configuration are used as they are.
+func (r *Reconciler) reconcileZone(ctx context.Context, req *Request, vpcID string, mainRouteTable *ec2.RouteTable, zoneIndex int, zone awsapi.Zone) (*zoneStatus, error) {
+	var (
+		clusterName = req.ClusterName
+		existing    = zone.Subnets
+	)
+	if existing == nil {
+		existing = &awsapi.ZoneSubnets{}
+	}
+
+	nodesSubnetID, err := r.reconcileSubnet(ctx, req, vpcID, existing.Workers, req.State.ID(addressNodesSubnet, zoneIndex), fmt.Sprintf("%s-nodes-z%d", clusterName, zoneIndex), zone.Name, string(zone.Workers))
+	if err != nil {
+		return nil, err
+	}
+	privateSubnetID, err := r.reconcileSubnet(ctx, req, vpcID, existing.Internal, req.State.ID(addressPrivateSubnet, zoneIndex), fmt.Sprintf("%s-private-utility-z%d", clusterName, zoneIndex), zone.Name, string(zone.Internal),
+		&ec2.Tag{Key: aws.String(TagKeyRolePrivateELB), Value: aws.String("use")})
+	if err != nil {
+		return nil, err
+	}
+	publicSubnetID, err := r.reconcileSubnet(ctx, req, vpcID, existing.Public, req.State.ID(addressPublicSubnet, zoneIndex), fmt.Sprintf("%s-public-utility-z%d", clusterName, zoneIndex), zone.Name, string(zone.Public),
+		&ec2.Tag{Key: aws.String(TagKeyRolePublicELB), Value: aws.String("use")})
+	if err != nil {
+		return nil, err
+	}
+
+	var address *ec2.Address
+	if zone.ElasticIPAllocationID != nil {
+		address, err = r.getExistingElasticIP(ctx, *zone.ElasticIPAllocationID)
+	} else {
+		address, err = r.ensureElasticIP(ctx, req, zoneIndex)
+	}
+	if err != nil {
+		return nil, err
+	}
+	allocationID := aws.StringValue(address.AllocationId)
+
+	natGatewayID, err := r.ensureNATGateway(ctx, req, zoneIndex, publicSubnetID, allocationID)
+	if err != nil {
+		return nil, err
+	}
+	// An elastic IP allocated for the zone before it was configured explicitly is not needed anymore.
+	if zone.ElasticIPAllocationID != nil {
+		if err := r.releaseElasticIP(ctx, req, zoneIndex, allocationID); err != nil {
+			return nil, err
+		}
+	}
+
+	zoneRouteTable, err := r.ensureRouteTable(ctx, req, vpcID, req.State.ID(addressZoneRouteTable, zoneIndex), fmt.Sprintf("%s-private-%s", clusterName, zone.Name))
+	if err != nil {
+		return nil, err
+	}
+	if err := r.ensureRoute(ctx, zoneRouteTable, allIPv4CIDR, "", natGatewayID); err != nil {
+		return nil, err
+	}
+
+	for _, association := range []struct {
+		subnetID   string
+		routeTable *ec2.RouteTable
+	}{
+		{privateSubnetID, zoneRouteTable},
+		{publicSubnetID, mainRouteTable},
+		{nodesSubnetID, zoneRouteTable},
+	} {
+		if err := r.ensureRouteTableAssociation(ctx, association.routeTable, association.subnetID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &zoneStatus{
+		nodesSubnetID:  nodesSubnetID,
+		publicSubnetID: publicSubnetID,
+		natGateway: awsv1alpha1.NATGateway{
+			Zone:                  zone.Name,
+			ElasticIPAllocationID: allocationID,
+			PublicIP:              aws.StringValue(address.PublicIp),
+		},
+		routeTableID: aws.StringValue(zoneRouteTable.RouteTableId),
+	}, nil
+}
+
+// reconcileSubnet verifies the subnet with the given <existingID> if it is set, otherwise it ensures the subnet
+// managed by the reconciler. It returns the ID of the subnet.
+func (r *Reconciler) reconcileSubnet(ctx context.Context, req *Request, vpcID string, existingID *string, id, name, zone, cidr string, extraTags ...*ec2.Tag) (string, error) {
+	if existingID != nil {
+		return r.getExistingSubnet(ctx, vpcID, *existingID, zone, cidr)
+	}
+	return r.ensureSubnet(ctx, req, vpcID, id, name, zone, cidr, extraTags...)
+}
+
+// Delete deletes all resources of the infrastructure described by the given request. Resources which do not exist
+// (anymore) are skipped, hence it is safe to call Delete repeatedly.
+func (r *Reconciler) Delete(ctx context.Context, req *Request) error {
+	var (
+		clusterName = req.ClusterName
+		networks    = req.Config.Networks
+		managedVPC  = networks.VPC.ID == nil
+	)
+
+	if _, err := r.ec2.DeleteKeyPairWithContext(ctx, &ec2.DeleteKeyPairInput{KeyName: aws.String(keyPairName(clusterName))}); err != nil {
+		return fmt.Errorf("could not delete key pair: %+v", err)
+	}
+	for _, purpose := range []string{purposeNodes, purposeBastions} {
+		if err := r.deleteRoleAndInstanceProfile(ctx, fmt.Sprintf("%s-%s", clusterName, purpose)); err != nil {
+			return fmt.Errorf("could not delete IAM resources for %s: %+v", purpose, err)
+		}
+	}
+
+	vpcID, err := r.VPCID(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if len(vpcID) > 0 {
+		for zoneIndex := range networks.Zones {
+			natGateway, err := r.findNATGateway(ctx, req, zoneIndex)
+			if err != nil {
+				return err
+			}
+			if natGateway == nil {
+				continue
+			}
+			if err := r.deleteNATGateway(ctx, req, natGateway); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Elastic IPs given in the zone configuration are not managed by the reconciler and must not be released.
+	for zoneIndex, zone := range networks.Zones {
+		if err := r.releaseElasticIP(ctx, req, zoneIndex, aws.StringValue(zone.ElasticIPAllocationID)); err != nil {
+			return err
+		}
+	}
+
+	if len(vpcID) > 0 {
+		if err := r.deleteVPCResources(ctx, req, vpcID); err != nil {
+			return err
+		}
+	}
+
+	if !managedVPC {
+		return nil
+	}
+
+	if len(vpcID) > 0 {
+		internetGateway, err := r.findInternetGateway(ctx, req)
+		if err != nil {
+			return err
+		}
+		if internetGateway != nil {
+			for _, attachment := range internetGateway.Attachments {
+				if _, err := r.ec2.DetachInternetGatewayWithContext(ctx, &ec2.DetachInternetGatewayInput{
+					InternetGatewayId: internetGateway.InternetGatewayId,
+					VpcId:             attachment.VpcId,
+				}); err != nil {
+					return fmt.Errorf("could not detach internet gateway %s: %+v", aws.StringValue(internetGateway.InternetGatewayId), err)
+				}
+			}
+			if _, err := r.ec2.DeleteInternetGatewayWithContext(ctx, &ec2.DeleteInternetGatewayInput{InternetGatewayId: internetGateway.InternetGatewayId}); err != nil {
+				return fmt.Errorf("could not delete internet gateway %s: %+v", aws.StringValue(internetGateway.InternetGatewayId), err)
+			}
+		}
+
+		if _, err := r.ec2.DeleteVpcWithContext(ctx, &ec2.DeleteVpcInput{VpcId: aws.String(vpcID)}); err != nil {
+			return fmt.Errorf("could not delete VPC %s: %+v", vpcID, err)
+		}
+	}
+
+	dhcpOptions, err := r.findDHCPOptions(ctx, req)
+	if err != nil {
+		return err
+	}
+	if dhcpOptions != nil {
+		if _, err := r.ec2.DeleteDhcpOptionsWithContext(ctx, &ec2.DeleteDhcpOptionsInput{DhcpOptionsId: dhcpOptions.DhcpOptionsId}); err != nil {
+			return fmt.Errorf("could not delete DHCP options %s: %+v", aws.StringValue(dhcpOptions.DhcpOptionsId), err)
+		}
+	}
+
+	return nil
+}
+
+// VPCID returns the ID of the VPC of the infrastructure described by the given request. If the VPC is managed by
+// the reconciler but does not exist, an empty string is returned.
+func (r *Reconciler) VPCID(ctx context.Context, req *Request) (string, error) {
+	if req.Config.Networks.VPC.ID != nil {
+		return *req.Config.Networks.VPC.ID, nil
+	}
+
+	vpc, err := r.findVPC(ctx, req)
+	if err != nil || vpc == nil {
+		return "", err
+	}
+	return aws.StringValue(vpc.VpcId), nil
+}
+
+// deleteVPCResources deletes the gateway endpoints, subnets, route tables and security groups of the cluster inside
+// the given VPC. Subnets given in the zone configuration are only detached from the route tables of the cluster.
+func (r *Reconciler) deleteVPCResources(ctx context.Context, req *Request, vpcID string) error {
+	clusterName := req.ClusterName
+
+	mainRouteTable, err := r.findRouteTable(ctx, req, vpcID, req.State.ID(addressMainRouteTable), clusterName)
+	if err != nil {
+		return err
+	}
+	if mainRouteTable != nil {
+		if err := r.deleteGatewayEndpoints(ctx, req, vpcID, aws.StringValue(mainRouteTable.RouteTableId)); err != nil {
+			return err
+		}
+	}
+
+	for zoneIndex, zone := range req.Config.Networks.Zones {
+		existing := zone.Subnets
+		if existing == nil {
+			existing = &awsapi.ZoneSubnets{}
+		}
+
+		for _, subnet := range []struct {
+			address, name string
+			existingID    *string
+		}{
+			{addressNodesSubnet, fmt.Sprintf("%s-nodes-z%d", clusterName, zoneIndex), existing.Workers},
+			{addressPrivateSubnet, fmt.Sprintf("%s-private-utility-z%d", clusterName, zoneIndex), existing.Internal},
+			{addressPublicSubnet, fmt.Sprintf("%s-public-utility-z%d", clusterName, zoneIndex), existing.Public},
+		} {
+			if subnet.existingID != nil {
+				continue
+			}
+			found, err := r.findSubnet(ctx, req, vpcID, req.State.ID(subnet.address, zoneIndex), subnet.name)
+			if err != nil {
+				return err
+			}
+			if found == nil {
+				continue
+			}
+			if _, err := r.ec2.DeleteSubnetWithContext(ctx, &ec2.DeleteSubnetInput{SubnetId: found.SubnetId}); err != nil {
+				return fmt.Errorf("could not delete subnet %s: %+v", aws.StringValue(found.SubnetId), err)
+			}
+		}
+
+		if err := r.deleteRouteTable(ctx, req, vpcID, req.State.ID(addressZoneRouteTable, zoneIndex), fmt.Sprintf("%s-private-%s", clusterName, zone.Name)); err != nil {
+			return err
+		}
+	}
+
+	if err := r.deleteRouteTable(ctx, req, vpcID, req.State.ID(addressMainRouteTable), clusterName); err != nil {
+		return err
+	}
+
+	// The nodes security group references the bastions security group, hence it has to be deleted first.
+	for _, purpose := range []string{purposeNodes, purposeBastions} {
+		securityGroup, err := r.findSecurityGroup(ctx, req, vpcID, purpose)
+		if err != nil {
+			return err
+		}
+		if securityGroup == nil {
+			continue
+		}
+		if _, err := r.ec2.DeleteSecurityGroupWithContext(ctx, &ec2.DeleteSecurityGroupInput{GroupId: securityGroup.GroupId}); err != nil {
+			return fmt.Errorf("could not delete security group %s: %+v", aws.StringValue(securityGroup.GroupId), err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) deleteRouteTable(ctx context.Context, req *Request, vpcID, id, name string) error {
+	routeTable, err := r.findRouteTable(ctx, req, vpcID, id, name)
+	if err != nil || routeTable == nil {
+		return err
+	}
+	// Subnets which are not managed by the reconciler are still associated with the route table.
+	for _, association := range routeTable.Associations {
+		if aws.BoolValue(association.Main) {
+			continue
+		}
+		if _, err := r.ec2.DisassociateRouteTableWithContext(ctx, &ec2.DisassociateRouteTableInput{AssociationId: association.RouteTableAssociationId}); err != nil {
+			return fmt.Errorf("could not disassociate subnet %s from route table %s: %+v", aws.StringValue(association.SubnetId), aws.StringValue(routeTable.RouteTableId), err)
+		}
+	}
+	if _, err := r.ec2.DeleteRouteTableWithContext(ctx, &ec2.DeleteRouteTableInput{RouteTableId: routeTable.RouteTableId}); err != nil {
+		return fmt.Errorf("could not delete route table %s: %+v", aws.StringValue(routeTable.RouteTableId), err)
+	}
+	return nil
+}
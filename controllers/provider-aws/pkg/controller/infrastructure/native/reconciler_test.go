@@ -0,0 +1,414 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native_test
+
+import (
+	"context"
+
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	awsv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/v1alpha1"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/controller/infrastructure/native"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+var _ = Describe("Reconciler", func() {
+	const clusterName = "shoot--foo--bar"
+
+	var (
+		ctx = context.TODO()
+
+		ec2Client  *fakeEC2
+		iamClient  *fakeIAM
+		reconciler *Reconciler
+		req        *Request
+	)
+
+	cidr := func(value string) *gardencore.CIDR {
+		c := gardencore.CIDR(value)
+		return &c
+	}
+
+	hasTag := func(tags []*ec2.Tag, key, value string) bool {
+		for _, tag := range tags {
+			if aws.StringValue(tag.Key) == key && aws.StringValue(tag.Value) == value {
+				return true
+			}
+		}
+		return false
+	}
+
+	BeforeEach(func() {
+		ec2Client = newFakeEC2()
+		iamClient = newFakeIAM()
+		reconciler = NewReconciler(log.Log.WithName("test"), ec2Client, iamClient)
+		req = &Request{
+			ClusterName:  clusterName,
+			Region:       "eu-west-1",
+			SSHPublicKey: []byte("ssh-rsa AAAA"),
+			Config: &awsapi.InfrastructureConfig{
+				Networks: awsapi.Networks{
+					VPC: awsapi.VPC{CIDR: cidr("10.250.0.0/16")},
+					Zones: []awsapi.Zone{
+						{Name: "eu-west-1a", Workers: "10.250.0.0/19", Internal: "10.250.112.0/22", Public: "10.250.96.0/22"},
+						{Name: "eu-west-1b", Workers: "10.250.32.0/19", Internal: "10.250.116.0/22", Public: "10.250.100.0/22"},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#Reconcile", func() {
+		It("should create all resources and return the provider status", func() {
+			status, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ec2Client.vpcs).To(HaveLen(1))
+			Expect(ec2Client.dhcpOptions).To(HaveLen(1))
+			Expect(ec2Client.internetGateways).To(HaveLen(1))
+			Expect(ec2Client.subnets).To(HaveLen(6))
+			Expect(ec2Client.addresses).To(HaveLen(2))
+			Expect(ec2Client.natGateways).To(HaveLen(2))
+			Expect(ec2Client.routeTables).To(HaveLen(3))
+			Expect(ec2Client.securityGroups).To(HaveLen(2))
+			Expect(ec2Client.keyPairs).To(HaveKey(clusterName + "-ssh-publickey"))
+			Expect(iamClient.roles).To(HaveLen(2))
+			Expect(iamClient.instanceProfiles).To(HaveLen(2))
+			Expect(iamClient.rolePolicies).To(HaveLen(2))
+
+			var vpcID string
+			for id, vpc := range ec2Client.vpcs {
+				vpcID = id
+				Expect(aws.StringValue(vpc.CidrBlock)).To(Equal("10.250.0.0/16"))
+				Expect(hasTag(vpc.Tags, "Name", clusterName)).To(BeTrue())
+				Expect(hasTag(vpc.Tags, "kubernetes.io/cluster/"+clusterName, "1")).To(BeTrue())
+				Expect(ec2Client.dhcpOptions).To(HaveKey(aws.StringValue(vpc.DhcpOptionsId)))
+			}
+
+			for _, subnet := range ec2Client.subnets {
+				associated := 0
+				for _, routeTable := range ec2Client.routeTables {
+					for _, association := range routeTable.Associations {
+						if aws.StringValue(association.SubnetId) == aws.StringValue(subnet.SubnetId) {
+							associated++
+						}
+					}
+				}
+				Expect(associated).To(Equal(1))
+
+				switch {
+				case hasTag(subnet.Tags, "Name", clusterName+"-public-utility-z0"), hasTag(subnet.Tags, "Name", clusterName+"-public-utility-z1"):
+					Expect(hasTag(subnet.Tags, TagKeyRolePublicELB, "use")).To(BeTrue())
+				case hasTag(subnet.Tags, "Name", clusterName+"-private-utility-z0"), hasTag(subnet.Tags, "Name", clusterName+"-private-utility-z1"):
+					Expect(hasTag(subnet.Tags, TagKeyRolePrivateELB, "use")).To(BeTrue())
+				}
+			}
+
+			for _, routeTable := range ec2Client.routeTables {
+				Expect(routeTable.Routes).To(HaveLen(1))
+				Expect(aws.StringValue(routeTable.Routes[0].DestinationCidrBlock)).To(Equal("0.0.0.0/0"))
+				if hasTag(routeTable.Tags, "Name", clusterName) {
+					Expect(ec2Client.internetGateways).To(HaveKey(aws.StringValue(routeTable.Routes[0].GatewayId)))
+				} else {
+					Expect(ec2Client.natGateways).To(HaveKey(aws.StringValue(routeTable.Routes[0].NatGatewayId)))
+				}
+			}
+
+			Expect(status.VPC.ID).To(Equal(vpcID))
+			Expect(status.VPC.Subnets).To(HaveLen(4))
+			for _, subnet := range status.VPC.Subnets {
+				Expect(ec2Client.subnets).To(HaveKey(subnet.ID))
+				Expect(aws.StringValue(ec2Client.subnets[subnet.ID].AvailabilityZone)).To(Equal(subnet.Zone))
+			}
+			Expect(status.VPC.SecurityGroups).To(HaveLen(1))
+			Expect(status.VPC.SecurityGroups[0].Purpose).To(Equal(awsv1alpha1.PurposeNodes))
+			Expect(aws.StringValue(ec2Client.securityGroups[status.VPC.SecurityGroups[0].ID].GroupName)).To(Equal(clusterName + "-nodes"))
+			Expect(ec2Client.securityGroups[status.VPC.SecurityGroups[0].ID].IpPermissions).To(HaveLen(12))
+			Expect(status.VPC.NATGateways).To(HaveLen(2))
+			for _, natGateway := range status.VPC.NATGateways {
+				Expect(ec2Client.addresses).To(HaveKey(natGateway.ElasticIPAllocationID))
+				Expect(natGateway.PublicIP).To(Equal(aws.StringValue(ec2Client.addresses[natGateway.ElasticIPAllocationID].PublicIp)))
+			}
+			Expect(status.VPC.NATGateways[0].Zone).To(Equal("eu-west-1a"))
+			Expect(status.EC2.KeyName).To(Equal(clusterName + "-ssh-publickey"))
+			Expect(status.IAM.InstanceProfiles).To(ConsistOf(awsv1alpha1.InstanceProfile{Purpose: awsv1alpha1.PurposeNodes, Name: clusterName + "-nodes"}))
+			Expect(status.IAM.Roles).To(ConsistOf(awsv1alpha1.Role{Purpose: awsv1alpha1.PurposeNodes, ARN: "arn:aws:iam::123456789012:role/" + clusterName + "-nodes"}))
+		})
+
+		It("should be idempotent", func() {
+			status, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			ec2Calls, iamCreateRoleCalls := ec2Client.mutatingCalls(), iamClient.calls["CreateRole"]
+
+			statusAgain, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statusAgain).To(Equal(status))
+			Expect(ec2Client.mutatingCalls()).To(Equal(ec2Calls))
+			Expect(iamClient.calls["CreateRole"]).To(Equal(iamCreateRoleCalls))
+		})
+
+		It("should use an existing VPC and its internet gateway", func() {
+			ec2Client.vpcs["vpc-existing"] = &ec2.Vpc{VpcId: aws.String("vpc-existing"), CidrBlock: aws.String("10.250.0.0/16")}
+			ec2Client.internetGateways["igw-existing"] = &ec2.InternetGateway{
+				InternetGatewayId: aws.String("igw-existing"),
+				Attachments:       []*ec2.InternetGatewayAttachment{{VpcId: aws.String("vpc-existing")}},
+			}
+			req.Config.Networks.VPC = awsapi.VPC{ID: aws.String("vpc-existing")}
+
+			status, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(status.VPC.ID).To(Equal("vpc-existing"))
+			Expect(ec2Client.vpcs).To(HaveLen(1))
+			Expect(ec2Client.internetGateways).To(HaveLen(1))
+			Expect(ec2Client.dhcpOptions).To(BeEmpty())
+			Expect(ec2Client.calls["ModifyVpcAttribute"]).To(BeZero())
+		})
+
+		It("should use existing subnets and elastic IPs", func() {
+			ec2Client.vpcs["vpc-existing"] = &ec2.Vpc{VpcId: aws.String("vpc-existing"), CidrBlock: aws.String("10.250.0.0/16")}
+			ec2Client.internetGateways["igw-existing"] = &ec2.InternetGateway{
+				InternetGatewayId: aws.String("igw-existing"),
+				Attachments:       []*ec2.InternetGatewayAttachment{{VpcId: aws.String("vpc-existing")}},
+			}
+			ec2Client.subnets["subnet-existing"] = &ec2.Subnet{
+				SubnetId:         aws.String("subnet-existing"),
+				VpcId:            aws.String("vpc-existing"),
+				CidrBlock:        aws.String("10.250.0.0/19"),
+				AvailabilityZone: aws.String("eu-west-1a"),
+			}
+			ec2Client.addresses["eipalloc-existing"] = &ec2.Address{AllocationId: aws.String("eipalloc-existing"), PublicIp: aws.String("198.51.100.1")}
+			req.Config.Networks.VPC = awsapi.VPC{ID: aws.String("vpc-existing")}
+			req.Config.Networks.Zones[0].Subnets = &awsapi.ZoneSubnets{Workers: aws.String("subnet-existing")}
+			req.Config.Networks.Zones[0].ElasticIPAllocationID = aws.String("eipalloc-existing")
+
+			status, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(status.VPC.Subnets).To(ContainElement(awsv1alpha1.Subnet{ID: "subnet-existing", Purpose: awsv1alpha1.PurposeNodes, Zone: "eu-west-1a"}))
+			Expect(status.VPC.NATGateways).To(ContainElement(awsv1alpha1.NATGateway{Zone: "eu-west-1a", ElasticIPAllocationID: "eipalloc-existing", PublicIP: "198.51.100.1"}))
+			Expect(ec2Client.subnets).To(HaveLen(6))
+			Expect(ec2Client.addresses).To(HaveLen(2))
+			Expect(ec2Client.calls["CreateSubnet"]).To(Equal(5))
+			Expect(ec2Client.calls["AllocateAddress"]).To(Equal(1))
+		})
+
+		It("should fail if an existing subnet does not exist", func() {
+			ec2Client.vpcs["vpc-existing"] = &ec2.Vpc{VpcId: aws.String("vpc-existing"), CidrBlock: aws.String("10.250.0.0/16")}
+			ec2Client.internetGateways["igw-existing"] = &ec2.InternetGateway{
+				InternetGatewayId: aws.String("igw-existing"),
+				Attachments:       []*ec2.InternetGatewayAttachment{{VpcId: aws.String("vpc-existing")}},
+			}
+			req.Config.Networks.VPC = awsapi.VPC{ID: aws.String("vpc-existing")}
+			req.Config.Networks.Zones[0].Subnets = &awsapi.ZoneSubnets{Public: aws.String("subnet-missing")}
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should replace the NAT gateway and release the elastic IP if an elastic IP is configured later", func() {
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			ec2Client.addresses["eipalloc-existing"] = &ec2.Address{AllocationId: aws.String("eipalloc-existing"), PublicIp: aws.String("198.51.100.1")}
+			req.Config.Networks.Zones[1].ElasticIPAllocationID = aws.String("eipalloc-existing")
+
+			status, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(status.VPC.NATGateways[1]).To(Equal(awsv1alpha1.NATGateway{Zone: "eu-west-1b", ElasticIPAllocationID: "eipalloc-existing", PublicIP: "198.51.100.1"}))
+			Expect(ec2Client.activeNATGateways()).To(Equal(2))
+			Expect(ec2Client.addresses).To(HaveLen(2))
+			Expect(ec2Client.addresses).To(HaveKey("eipalloc-existing"))
+		})
+
+		It("should attach the gateway endpoints to all route tables and remove obsolete ones", func() {
+			req.Config.Networks.VPC.GatewayEndpoints = []string{"s3", "dynamodb"}
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ec2Client.vpcEndpoints).To(HaveLen(2))
+			var serviceNames []string
+			for _, vpcEndpoint := range ec2Client.vpcEndpoints {
+				serviceNames = append(serviceNames, aws.StringValue(vpcEndpoint.ServiceName))
+				Expect(aws.StringValue(vpcEndpoint.VpcEndpointType)).To(Equal(ec2.VpcEndpointTypeGateway))
+				Expect(vpcEndpoint.RouteTableIds).To(HaveLen(3))
+				for _, routeTableID := range vpcEndpoint.RouteTableIds {
+					Expect(ec2Client.routeTables).To(HaveKey(aws.StringValue(routeTableID)))
+				}
+			}
+			Expect(serviceNames).To(ConsistOf("com.amazonaws.eu-west-1.s3", "com.amazonaws.eu-west-1.dynamodb"))
+
+			ec2Calls := ec2Client.mutatingCalls()
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ec2Client.mutatingCalls()).To(Equal(ec2Calls))
+
+			req.Config.Networks.VPC.GatewayEndpoints = []string{"s3"}
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ec2Client.vpcEndpoints).To(HaveLen(1))
+			for _, vpcEndpoint := range ec2Client.vpcEndpoints {
+				Expect(aws.StringValue(vpcEndpoint.ServiceName)).To(Equal("com.amazonaws.eu-west-1.s3"))
+			}
+		})
+
+		It("should fail for an existing VPC without internet gateway", func() {
+			ec2Client.vpcs["vpc-existing"] = &ec2.Vpc{VpcId: aws.String("vpc-existing"), CidrBlock: aws.String("10.250.0.0/16")}
+			req.Config.Networks.VPC = awsapi.VPC{ID: aws.String("vpc-existing")}
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should adopt the resources recorded in the Terraform state", func() {
+			ec2Client.vpcs["vpc-terraform"] = &ec2.Vpc{VpcId: aws.String("vpc-terraform"), CidrBlock: aws.String("10.250.0.0/16")}
+			ec2Client.subnets["subnet-terraform"] = &ec2.Subnet{
+				SubnetId:         aws.String("subnet-terraform"),
+				VpcId:            aws.String("vpc-terraform"),
+				CidrBlock:        aws.String("10.250.0.0/19"),
+				AvailabilityZone: aws.String("eu-west-1a"),
+			}
+			req.State = TerraformState{
+				"aws_vpc.vpc":         "vpc-terraform",
+				"aws_subnet.nodes_z0": "subnet-terraform",
+			}
+
+			status, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(status.VPC.ID).To(Equal("vpc-terraform"))
+			Expect(status.VPC.Subnets).To(ContainElement(awsv1alpha1.Subnet{ID: "subnet-terraform", Purpose: awsv1alpha1.PurposeNodes, Zone: "eu-west-1a"}))
+			Expect(ec2Client.vpcs).To(HaveLen(1))
+			Expect(ec2Client.subnets).To(HaveLen(6))
+			Expect(ec2Client.calls["CreateVpc"]).To(BeZero())
+		})
+
+		It("should fail if an adopted subnet does not match the configuration", func() {
+			ec2Client.vpcs["vpc-terraform"] = &ec2.Vpc{VpcId: aws.String("vpc-terraform"), CidrBlock: aws.String("10.250.0.0/16")}
+			ec2Client.subnets["subnet-terraform"] = &ec2.Subnet{
+				SubnetId:         aws.String("subnet-terraform"),
+				VpcId:            aws.String("vpc-terraform"),
+				CidrBlock:        aws.String("10.250.64.0/19"),
+				AvailabilityZone: aws.String("eu-west-1a"),
+			}
+			req.State = TerraformState{
+				"aws_vpc.vpc":         "vpc-terraform",
+				"aws_subnet.nodes_z0": "subnet-terraform",
+			}
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete all resources", func() {
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.Delete(ctx, req)).To(Succeed())
+
+			Expect(ec2Client.vpcs).To(BeEmpty())
+			Expect(ec2Client.dhcpOptions).To(BeEmpty())
+			Expect(ec2Client.internetGateways).To(BeEmpty())
+			Expect(ec2Client.subnets).To(BeEmpty())
+			Expect(ec2Client.addresses).To(BeEmpty())
+			Expect(ec2Client.activeNATGateways()).To(BeZero())
+			Expect(ec2Client.routeTables).To(BeEmpty())
+			Expect(ec2Client.securityGroups).To(BeEmpty())
+			Expect(ec2Client.keyPairs).To(BeEmpty())
+			Expect(iamClient.roles).To(BeEmpty())
+			Expect(iamClient.instanceProfiles).To(BeEmpty())
+			Expect(iamClient.rolePolicies).To(BeEmpty())
+		})
+
+		It("should delete the gateway endpoints", func() {
+			req.Config.Networks.VPC.GatewayEndpoints = []string{"s3"}
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.Delete(ctx, req)).To(Succeed())
+
+			Expect(ec2Client.vpcEndpoints).To(BeEmpty())
+			Expect(ec2Client.vpcs).To(BeEmpty())
+		})
+
+		It("should succeed if the resources are already gone", func() {
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.Delete(ctx, req)).To(Succeed())
+			Expect(reconciler.Delete(ctx, req)).To(Succeed())
+		})
+
+		It("should keep an existing VPC and its internet gateway", func() {
+			ec2Client.vpcs["vpc-existing"] = &ec2.Vpc{VpcId: aws.String("vpc-existing"), CidrBlock: aws.String("10.250.0.0/16")}
+			ec2Client.internetGateways["igw-existing"] = &ec2.InternetGateway{
+				InternetGatewayId: aws.String("igw-existing"),
+				Attachments:       []*ec2.InternetGatewayAttachment{{VpcId: aws.String("vpc-existing")}},
+			}
+			req.Config.Networks.VPC = awsapi.VPC{ID: aws.String("vpc-existing")}
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.Delete(ctx, req)).To(Succeed())
+
+			Expect(ec2Client.vpcs).To(HaveKey("vpc-existing"))
+			Expect(ec2Client.internetGateways).To(HaveKey("igw-existing"))
+			Expect(ec2Client.subnets).To(BeEmpty())
+			Expect(ec2Client.routeTables).To(BeEmpty())
+			Expect(ec2Client.securityGroups).To(BeEmpty())
+		})
+
+		It("should keep existing subnets and elastic IPs", func() {
+			ec2Client.vpcs["vpc-existing"] = &ec2.Vpc{VpcId: aws.String("vpc-existing"), CidrBlock: aws.String("10.250.0.0/16")}
+			ec2Client.internetGateways["igw-existing"] = &ec2.InternetGateway{
+				InternetGatewayId: aws.String("igw-existing"),
+				Attachments:       []*ec2.InternetGatewayAttachment{{VpcId: aws.String("vpc-existing")}},
+			}
+			ec2Client.subnets["subnet-existing"] = &ec2.Subnet{
+				SubnetId:         aws.String("subnet-existing"),
+				VpcId:            aws.String("vpc-existing"),
+				CidrBlock:        aws.String("10.250.96.0/22"),
+				AvailabilityZone: aws.String("eu-west-1a"),
+			}
+			ec2Client.addresses["eipalloc-existing"] = &ec2.Address{AllocationId: aws.String("eipalloc-existing"), PublicIp: aws.String("198.51.100.1")}
+			req.Config.Networks.VPC = awsapi.VPC{ID: aws.String("vpc-existing")}
+			req.Config.Networks.Zones[0].Subnets = &awsapi.ZoneSubnets{Public: aws.String("subnet-existing")}
+			req.Config.Networks.Zones[0].ElasticIPAllocationID = aws.String("eipalloc-existing")
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.Delete(ctx, req)).To(Succeed())
+
+			Expect(ec2Client.subnets).To(ConsistOf(ec2Client.subnets["subnet-existing"]))
+			Expect(ec2Client.addresses).To(ConsistOf(ec2Client.addresses["eipalloc-existing"]))
+			Expect(ec2Client.activeNATGateways()).To(BeZero())
+			Expect(ec2Client.routeTables).To(BeEmpty())
+		})
+	})
+})
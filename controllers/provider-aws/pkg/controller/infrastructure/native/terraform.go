@@ -0,0 +1,83 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	addressVPC             = "aws_vpc.vpc"
+	addressDHCPOptions     = "aws_vpc_dhcp_options.vpc_dhcp_options"
+	addressInternetGateway = "aws_internet_gateway.igw"
+	addressMainRouteTable  = "aws_route_table.routetable_main"
+	addressSecurityGroup   = "aws_security_group.%s"
+	addressNodesSubnet     = "aws_subnet.nodes_z%d"
+	addressPrivateSubnet   = "aws_subnet.private_utility_z%d"
+	addressPublicSubnet    = "aws_subnet.public_utility_z%d"
+	addressElasticIP       = "aws_eip.eip_natgw_z%d"
+	addressNATGateway      = "aws_nat_gateway.natgw_z%d"
+	addressZoneRouteTable  = "aws_route_table.routetable_private_utility_z%d"
+)
+
+// TerraformState maps the addresses of the resources in a Terraform state (e.g. `aws_vpc.vpc`) to their IDs.
+type TerraformState map[string]string
+
+// ID returns the ID of the resource with the given address, or an empty string if the state does not contain it.
+func (s TerraformState) ID(address string, args ...interface{}) string {
+	if s == nil {
+		return ""
+	}
+	return s[fmt.Sprintf(address, args...)]
+}
+
+type terraformStateV3 struct {
+	Modules []struct {
+		Path      []string `json:"path"`
+		Resources map[string]struct {
+			Primary struct {
+				ID string `json:"id"`
+			} `json:"primary"`
+		} `json:"resources"`
+	} `json:"modules"`
+}
+
+// ParseTerraformState extracts the IDs of the resources of the root module of the given Terraform (v3) state.
+// An empty state results in an empty TerraformState.
+func ParseTerraformState(data []byte) (TerraformState, error) {
+	state := TerraformState{}
+	if len(data) == 0 {
+		return state, nil
+	}
+
+	var raw terraformStateV3
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("could not parse Terraform state: %+v", err)
+	}
+
+	for _, module := range raw.Modules {
+		if len(module.Path) > 1 {
+			continue
+		}
+		for address, resource := range module.Resources {
+			if len(resource.Primary.ID) > 0 {
+				state[address] = resource.Primary.ID
+			}
+		}
+	}
+
+	return state, nil
+}
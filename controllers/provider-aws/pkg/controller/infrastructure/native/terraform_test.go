@@ -0,0 +1,69 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package native_test
+
+import (
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/controller/infrastructure/native"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TerraformState", func() {
+	Describe("#ParseTerraformState", func() {
+		It("should return an empty state for empty data", func() {
+			state, err := ParseTerraformState(nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(BeEmpty())
+			Expect(state.ID("aws_vpc.vpc")).To(BeEmpty())
+		})
+
+		It("should return an error for invalid data", func() {
+			_, err := ParseTerraformState([]byte("{"))
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should extract the IDs of the root module resources", func() {
+			state, err := ParseTerraformState([]byte(`{
+  "version": 3,
+  "modules": [
+    {
+      "path": ["root"],
+      "outputs": {"vpc_id": {"type": "string", "value": "vpc-1"}},
+      "resources": {
+        "aws_vpc.vpc": {"type": "aws_vpc", "primary": {"id": "vpc-1", "attributes": {"cidr_block": "10.250.0.0/16"}}},
+        "aws_subnet.nodes_z0": {"type": "aws_subnet", "primary": {"id": "subnet-1"}}
+      }
+    },
+    {
+      "path": ["root", "child"],
+      "resources": {
+        "aws_vpc.other": {"type": "aws_vpc", "primary": {"id": "vpc-2"}}
+      }
+    }
+  ]
+}`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(Equal(TerraformState{
+				"aws_vpc.vpc":         "vpc-1",
+				"aws_subnet.nodes_z0": "subnet-1",
+			}))
+			Expect(state.ID("aws_subnet.nodes_z%d", 0)).To(Equal("subnet-1"))
+		})
+	})
+})
@@ -51,7 +51,7 @@ type delegateFactory struct {
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
-func NewActuator(machineImageToAMIMapping []config.MachineImage) worker.Actuator {
+func NewActuator(machineImageToAMIMapping []config.MachineImage, deletionOptions worker.DeletionOptions) worker.Actuator {
 	delegateFactory := &delegateFactory{
 		logger:                   log.Log.WithName("worker-actuator"),
 		machineImageToAMIMapping: machineImageToAMIMapping,
@@ -63,6 +63,7 @@ func NewActuator(machineImageToAMIMapping []config.MachineImage) worker.Actuator
 		mcmChart,
 		mcmShootChart,
 		imagevector.ImageVector(),
+		deletionOptions,
 	)
 }
 
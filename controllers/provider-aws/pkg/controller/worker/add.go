@@ -36,6 +36,8 @@ type AddOptions struct {
 	Controller controller.Options
 	// MachineImagesToAMIMapping is the default mapping from machine images to AMIs.
 	MachineImagesToAMIMapping []config.MachineImage
+	// Deletion are the options for deleting the machines of a worker.
+	Deletion worker.DeletionOptions
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -50,7 +52,7 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 	}
 
 	return worker.Add(mgr, worker.AddArgs{
-		Actuator:          NewActuator(opts.MachineImagesToAMIMapping),
+		Actuator:          NewActuator(opts.MachineImagesToAMIMapping, opts.Deletion),
 		ControllerOptions: opts.Controller,
 		Predicates:        worker.DefaultPredicates(mgr.GetClient(), aws.Type),
 	})
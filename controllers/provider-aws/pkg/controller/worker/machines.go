@@ -26,6 +26,7 @@ import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -108,10 +109,20 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	machineTypes := w.cloudProfileMachineTypes()
+	excludedZones := worker.ExcludedZonesFromAnnotations(w.worker.Annotations)
+
 	for _, pool := range w.worker.Spec.Pools {
-		zoneLen := len(pool.Zones)
 
-		ami, err := confighelper.FindAMIForRegion(w.machineImageToAMIMapping, pool.MachineImage.Name, pool.MachineImage.Version, w.worker.Spec.Region)
+		workerConfig := &awsapi.WorkerConfig{}
+		if pool.ProviderConfig != nil && pool.ProviderConfig.Raw != nil {
+			if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+				return fmt.Errorf("could not decode provider config of worker pool %q: %+v", pool.Name, err)
+			}
+		}
+		zoneDistribution := worker.NewZoneDistribution(pool.Zones, zoneWeights(workerConfig.ZoneWeights), excludedZones)
+
+		ami, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version)
 		if err != nil {
 			return err
 		}
@@ -121,6 +132,24 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			return err
 		}
 
+		rootEBS := map[string]interface{}{
+			"volumeSize": volumeSize,
+			"volumeType": pool.Volume.Type,
+		}
+		addEBSVolumeOptions(rootEBS, workerConfig.Volume)
+
+		blockDevices := []map[string]interface{}{
+			{
+				"ebs": rootEBS,
+			},
+		}
+
+		dataBlockDevices, err := computeDataBlockDevices(workerConfig.DataVolumes)
+		if err != nil {
+			return err
+		}
+		blockDevices = append(blockDevices, dataBlockDevices...)
+
 		for zoneIndex, zone := range pool.Zones {
 			nodesSubnet, err := awsapihelper.FindSubnetForPurposeAndZone(infrastructureStatus.VPC.Subnets, awsapi.PurposeNodes, zone)
 			if err != nil {
@@ -146,14 +175,11 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				"secret": map[string]interface{}{
 					"cloudConfig": string(pool.UserData),
 				},
-				"blockDevices": []map[string]interface{}{
-					{
-						"ebs": map[string]interface{}{
-							"volumeSize": volumeSize,
-							"volumeType": pool.Volume.Type,
-						},
-					},
-				},
+				"blockDevices": blockDevices,
+			}
+
+			if options := computeInstanceMetadataOptions(workerConfig.InstanceMetadataOptions); options != nil {
+				machineClassSpec["instanceMetadataOptions"] = options
 			}
 
 			var (
@@ -162,17 +188,27 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 			)
 
+			minimum, maximum := zoneDistribution.DistributeMinMax(zoneIndex, pool.Minimum, pool.Maximum)
+
 			machineDeployments = append(machineDeployments, worker.MachineDeployment{
 				Name:           deploymentName,
 				ClassName:      className,
 				SecretName:     className,
-				Minimum:        worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
-				Maximum:        worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
+				Minimum:        minimum,
+				Maximum:        maximum,
+				MaxSurge:       zoneDistribution.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, pool.Maximum),
+				MaxUnavailable: zoneDistribution.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, pool.Minimum),
 				Labels:         pool.Labels,
 				Annotations:    pool.Annotations,
 				Taints:         pool.Taints,
+				NodeTemplate:   worker.NodeTemplateForMachineType(machineTypes, pool.MachineType, w.worker.Spec.Region, zone),
+				MachineImage: worker.MachineImage{
+					Pool:    pool.Name,
+					Name:    pool.MachineImage.Name,
+					Version: pool.MachineImage.Version,
+					ID:      ami,
+				},
+				Lifecycle: machineLifecycle(workerConfig.MachineLifecycle),
 			})
 
 			machineClassSpec["name"] = className
@@ -188,3 +224,143 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+// dataVolumeDeviceNames are the device names which are assigned (in order) to data volumes that don't specify one.
+var dataVolumeDeviceNames = []string{"/dev/sdf", "/dev/sdg", "/dev/sdh", "/dev/sdi", "/dev/sdj", "/dev/sdk", "/dev/sdl", "/dev/sdm", "/dev/sdn", "/dev/sdo", "/dev/sdp"}
+
+func computeDataBlockDevices(dataVolumes []awsapi.DataVolume) ([]map[string]interface{}, error) {
+	var (
+		blockDevices []map[string]interface{}
+		usedNames    = make(map[string]bool, len(dataVolumes))
+		nextName     = 0
+	)
+
+	for _, dataVolume := range dataVolumes {
+		if dataVolume.DeviceName != nil {
+			usedNames[*dataVolume.DeviceName] = true
+		}
+	}
+
+	for _, dataVolume := range dataVolumes {
+		volumeSize, err := worker.DiskSize(dataVolume.Size)
+		if err != nil {
+			return nil, err
+		}
+
+		var deviceName string
+		if dataVolume.DeviceName != nil {
+			deviceName = *dataVolume.DeviceName
+		} else {
+			for nextName < len(dataVolumeDeviceNames) && usedNames[dataVolumeDeviceNames[nextName]] {
+				nextName++
+			}
+			if nextName >= len(dataVolumeDeviceNames) {
+				return nil, fmt.Errorf("no free device name left for data volume %q", dataVolume.Name)
+			}
+			deviceName = dataVolumeDeviceNames[nextName]
+			usedNames[deviceName] = true
+		}
+
+		ebs := map[string]interface{}{
+			"volumeSize":          volumeSize,
+			"volumeType":          dataVolume.Type,
+			"deleteOnTermination": true,
+		}
+		addEBSVolumeOptions(ebs, &dataVolume.Volume)
+
+		blockDevices = append(blockDevices, map[string]interface{}{
+			"deviceName": deviceName,
+			"ebs":        ebs,
+		})
+	}
+
+	return blockDevices, nil
+}
+
+// addEBSVolumeOptions adds the optional settings of the given volume to the ebs block device spec. Only the settings
+// that are actually configured are added so that the machine class hash does not change for existing worker pools.
+func addEBSVolumeOptions(ebs map[string]interface{}, volume *awsapi.Volume) {
+	if volume == nil {
+		return
+	}
+	if volume.IOPS != nil {
+		ebs["iops"] = int(*volume.IOPS)
+	}
+	if volume.Encrypted != nil {
+		ebs["encrypted"] = *volume.Encrypted
+	}
+	if volume.KMSKeyID != nil {
+		ebs["kmsKeyID"] = *volume.KMSKeyID
+	}
+}
+
+// machineLifecycle converts the machine lifecycle settings of a worker pool's provider config.
+func machineLifecycle(lifecycle *awsapi.MachineLifecycle) worker.MachineLifecycle {
+	if lifecycle == nil {
+		return worker.MachineLifecycle{}
+	}
+
+	out := worker.MachineLifecycle{
+		MinReadySeconds: lifecycle.MinReadySeconds,
+	}
+	if lifecycle.DrainTimeout != nil {
+		out.DrainTimeout = &lifecycle.DrainTimeout.Duration
+	}
+	if lifecycle.HealthTimeout != nil {
+		out.HealthTimeout = &lifecycle.HealthTimeout.Duration
+	}
+	if lifecycle.CreationTimeout != nil {
+		out.CreationTimeout = &lifecycle.CreationTimeout.Duration
+	}
+	return out
+}
+
+func computeInstanceMetadataOptions(options *awsapi.InstanceMetadataOptions) map[string]interface{} {
+	if options == nil || (options.HTTPTokens == nil && options.HTTPPutResponseHopLimit == nil) {
+		return nil
+	}
+
+	out := map[string]interface{}{}
+	if options.HTTPTokens != nil {
+		out["httpTokens"] = string(*options.HTTPTokens)
+	}
+	if options.HTTPPutResponseHopLimit != nil {
+		out["httpPutResponseHopLimit"] = int(*options.HTTPPutResponseHopLimit)
+	}
+	return out
+}
+
+// cloudProfileMachineTypes returns the machine types of the CloudProfile which are used to compute the node
+// templates of the machine deployments.
+func (w *workerDelegate) cloudProfileMachineTypes() []gardenv1beta1.MachineType {
+	if w.cluster.CloudProfile == nil || w.cluster.CloudProfile.Spec.AWS == nil {
+		return nil
+	}
+	return w.cluster.CloudProfile.Spec.AWS.Constraints.MachineTypes
+}
+
+// findMachineImage returns the AMI of the given machine image in the region of the worker. The versioned machine
+// images of the CloudProfile take precedence over the ones of the controller configuration.
+func (w *workerDelegate) findMachineImage(name, version string) (string, error) {
+	ami, err := confighelper.FindAMIForRegionFromCloudProfile(w.cluster.CloudProfile, name, version, w.worker.Spec.Region)
+	if err == nil {
+		return ami, nil
+	}
+	if ami, configErr := confighelper.FindAMIForRegion(w.machineImageToAMIMapping, name, version, w.worker.Spec.Region); configErr == nil {
+		return ami, nil
+	}
+	return "", err
+}
+
+// zoneWeights converts the given zone weights of a worker pool into the weights for the distribution of its machines.
+func zoneWeights(weights []awsapi.ZoneWeight) map[string]int {
+	if len(weights) == 0 {
+		return nil
+	}
+
+	out := make(map[string]int, len(weights))
+	for _, weight := range weights {
+		out[weight.Zone] = int(weight.Weight)
+	}
+	return out
+}
@@ -19,9 +19,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/config/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
 	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/controller/worker"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
@@ -36,6 +38,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -179,6 +182,22 @@ var _ = Describe("Machines", func() {
 				}
 
 				cluster = &extensionscontroller.Cluster{
+					CloudProfile: &gardenv1beta1.CloudProfile{
+						Spec: gardenv1beta1.CloudProfileSpec{
+							AWS: &gardenv1beta1.AWSProfile{
+								Constraints: gardenv1beta1.AWSConstraints{
+									MachineTypes: []gardenv1beta1.MachineType{
+										{
+											Name:   machineType,
+											CPU:    resource.MustParse("4"),
+											GPU:    resource.MustParse("0"),
+											Memory: resource.MustParse("16Gi"),
+										},
+									},
+								},
+							},
+						},
+					},
 					Shoot: &gardenv1beta1.Shoot{
 						Spec: gardenv1beta1.ShootSpec{
 							Kubernetes: gardenv1beta1.Kubernetes{
@@ -383,6 +402,23 @@ var _ = Describe("Machines", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				// Test workerDelegate.GenerateMachineDeployments()
+				nodeTemplate := func(zone string) *worker.NodeTemplate {
+					return &worker.NodeTemplate{
+						Capacity: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("4"),
+							corev1.ResourceMemory: resource.MustParse("16Gi"),
+						},
+						InstanceType: machineType,
+						Region:       region,
+						Zone:         zone,
+					}
+				}
+
+				var (
+					machineImagePool1 = worker.MachineImage{Pool: namePool1, Name: machineImageName, Version: machineImageVersion, ID: machineImageAMI}
+					machineImagePool2 = worker.MachineImage{Pool: namePool2, Name: machineImageName, Version: machineImageVersion, ID: machineImageAMI}
+				)
+
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1Zone1,
@@ -392,6 +428,8 @@ var _ = Describe("Machines", func() {
 						Maximum:        worker.DistributeOverZones(0, maxPool1, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool1, 2, maxPool1),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool1, 2, minPool1),
+						NodeTemplate:   nodeTemplate(zone1),
+						MachineImage:   machineImagePool1,
 					},
 					{
 						Name:           machineClassNamePool1Zone2,
@@ -401,6 +439,8 @@ var _ = Describe("Machines", func() {
 						Maximum:        worker.DistributeOverZones(1, maxPool1, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool1, 2, maxPool1),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool1, 2, minPool1),
+						NodeTemplate:   nodeTemplate(zone2),
+						MachineImage:   machineImagePool1,
 					},
 					{
 						Name:           machineClassNamePool2Zone1,
@@ -410,6 +450,8 @@ var _ = Describe("Machines", func() {
 						Maximum:        worker.DistributeOverZones(0, maxPool2, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool2, 2, maxPool2),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool2, 2, minPool2),
+						NodeTemplate:   nodeTemplate(zone1),
+						MachineImage:   machineImagePool2,
 					},
 					{
 						Name:           machineClassNamePool2Zone2,
@@ -419,6 +461,8 @@ var _ = Describe("Machines", func() {
 						Maximum:        worker.DistributeOverZones(1, maxPool2, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool2, 2, maxPool2),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool2, 2, minPool2),
+						NodeTemplate:   nodeTemplate(zone2),
+						MachineImage:   machineImagePool2,
 					},
 				}
 
@@ -427,6 +471,184 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should prefer the versioned machine images of the cloud profile", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+
+				cluster.CloudProfile.Annotations = map[string]string{
+					confighelper.AnnotationMachineImages: fmt.Sprintf(`[{"name":%q,"version":%q,"regions":[{"name":%q,"ami":"ami-from-cloudprofile"}]}]`, machineImageName, machineImageVersion, region),
+				}
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).NotTo(BeEmpty())
+				for _, machineDeployment := range result {
+					Expect(machineDeployment.MachineImage.ID).To(Equal("ami-from-cloudprofile"))
+				}
+			})
+
+			It("should fall back to the machine images of the controller configuration", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+
+				cluster.CloudProfile.Annotations = map[string]string{
+					confighelper.AnnotationMachineImages: fmt.Sprintf(`[{"name":%q,"version":"other-version","regions":[{"name":%q,"ami":"ami-from-cloudprofile"}]}]`, machineImageName, region),
+				}
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).NotTo(BeEmpty())
+				for _, machineDeployment := range result {
+					Expect(machineDeployment.MachineImage.ID).To(Equal(machineImageAMI))
+				}
+			})
+
+			It("should consider the worker pool provider config for the machine classes", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+
+				var (
+					iops       = int64(1000)
+					kmsKeyID   = "kms-key-id"
+					deviceName = "/dev/sdf"
+					httpTokens = apisaws.HTTPTokensRequired
+					hopLimit   = int64(2)
+
+					minReadySeconds = int32(900)
+					drainTimeout    = 2 * time.Hour
+				)
+
+				w.Spec.Pools = w.Spec.Pools[:1]
+				w.Spec.Pools[0].Zones = []string{zone1}
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apisaws.WorkerConfig{
+						Volume: &apisaws.Volume{
+							IOPS:     &iops,
+							KMSKeyID: &kmsKeyID,
+						},
+						DataVolumes: []apisaws.DataVolume{
+							{
+								Name: "data-1",
+								Size: "50Gi",
+								Type: "gp2",
+							},
+							{
+								Name:       "data-2",
+								Size:       "100Gi",
+								Type:       "io1",
+								DeviceName: &deviceName,
+								Volume: apisaws.Volume{
+									IOPS: &iops,
+								},
+							},
+						},
+						InstanceMetadataOptions: &apisaws.InstanceMetadataOptions{
+							HTTPTokens:              &httpTokens,
+							HTTPPutResponseHopLimit: &hopLimit,
+						},
+						MachineLifecycle: &apisaws.MachineLifecycle{
+							MinReadySeconds: &minReadySeconds,
+							DrainTimeout:    &metav1.Duration{Duration: drainTimeout},
+						},
+					}),
+				}
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				chartApplier.
+					EXPECT().
+					ApplyChart(context.TODO(), filepath.Join(aws.InternalChartsPath, "machineclass"), namespace, "machineclass", gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _, _, _ string, values map[string]interface{}, _ map[string]interface{}) error {
+						machineClasses := values["machineClasses"].([]map[string]interface{})
+						Expect(machineClasses).To(HaveLen(1))
+
+						Expect(machineClasses[0]["blockDevices"]).To(Equal([]map[string]interface{}{
+							{
+								"ebs": map[string]interface{}{
+									"volumeSize": volumeSize,
+									"volumeType": volumeType,
+									"iops":       int(iops),
+									"kmsKeyID":   kmsKeyID,
+								},
+							},
+							{
+								"deviceName": "/dev/sdg",
+								"ebs": map[string]interface{}{
+									"volumeSize":          50,
+									"volumeType":          "gp2",
+									"deleteOnTermination": true,
+								},
+							},
+							{
+								"deviceName": deviceName,
+								"ebs": map[string]interface{}{
+									"volumeSize":          100,
+									"volumeType":          "io1",
+									"deleteOnTermination": true,
+									"iops":                int(iops),
+								},
+							},
+						}))
+						Expect(machineClasses[0]["instanceMetadataOptions"]).To(Equal(map[string]interface{}{
+							"httpTokens":              string(httpTokens),
+							"httpPutResponseHopLimit": int(hopLimit),
+						}))
+						return nil
+					})
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(HaveLen(1))
+				Expect(result[0].Lifecycle).To(Equal(worker.MachineLifecycle{
+					MinReadySeconds: &minReadySeconds,
+					DrainTimeout:    &drainTimeout,
+				}))
+			})
+
+			It("should distribute the machines according to the zone weights and excluded zones", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+
+				w.Spec.Pools = w.Spec.Pools[:1]
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apisaws.WorkerConfig{
+						ZoneWeights: []apisaws.ZoneWeight{
+							{Zone: zone1, Weight: 3},
+						},
+					}),
+				}
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(HaveLen(2))
+				Expect([]int{result[0].Minimum, result[0].Maximum, result[1].Minimum, result[1].Maximum}).To(Equal([]int{4, 8, 1, 2}))
+
+				w.Annotations = map[string]string{worker.AnnotationExcludedZones: zone1}
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				result, err = workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(HaveLen(2))
+				Expect([]int{result[0].Minimum, result[0].Maximum, result[1].Minimum, result[1].Maximum}).To(Equal([]int{0, 0, minPool1, maxPool1}))
+			})
+
+			It("should fail because the worker pool provider config cannot be decoded", func() {
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion": "foo/v1", "kind": "Bar"}`)}
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
@@ -0,0 +1,142 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/install"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+)
+
+func TestValidation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AWS Validation Webhook Suite")
+}
+
+var _ = Describe("Validator", func() {
+	var (
+		ctx = context.TODO()
+
+		decoder runtime.Decoder
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		install.Install(scheme)
+		decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+	})
+
+	Describe("#Validate", func() {
+		It("should accept a valid infrastructure", func() {
+			infra := &extensionsv1alpha1.Infrastructure{
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureConfig",
+"networks": {
+  "vpc": {"cidr": "10.250.0.0/16"},
+  "zones": [{"name": "eu-west-1a", "internal": "10.250.112.0/22", "public": "10.250.96.0/22", "workers": "10.250.0.0/19"}]
+}}`)},
+				},
+			}
+
+			Expect(NewValidator(decoder).Validate(ctx, infra, nil)).To(Succeed())
+		})
+
+		It("should reject an infrastructure with overlapping zone CIDRs", func() {
+			infra := &extensionsv1alpha1.Infrastructure{
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureConfig",
+"networks": {
+  "vpc": {"cidr": "10.250.0.0/16"},
+  "zones": [{"name": "eu-west-1a", "internal": "10.250.0.0/22", "public": "10.250.96.0/22", "workers": "10.250.0.0/19"}]
+}}`)},
+				},
+			}
+
+			Expect(NewValidator(decoder).Validate(ctx, infra, nil)).To(HaveOccurred())
+		})
+
+		It("should reject an infrastructure without provider config", func() {
+			Expect(NewValidator(decoder).Validate(ctx, &extensionsv1alpha1.Infrastructure{}, nil)).To(HaveOccurred())
+		})
+
+		It("should reject a control plane whose provider config cannot be decoded", func() {
+			cp := &extensionsv1alpha1.ControlPlane{
+				Spec: extensionsv1alpha1.ControlPlaneSpec{
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion": "foo/v1", "kind": "Bar"}`)},
+				},
+			}
+
+			Expect(NewValidator(decoder).Validate(ctx, cp, nil)).To(HaveOccurred())
+		})
+
+		It("should accept a worker with a valid pool provider config", func() {
+			worker := &extensionsv1alpha1.Worker{
+				Spec: extensionsv1alpha1.WorkerSpec{
+					Pools: []extensionsv1alpha1.WorkerPool{
+						{
+							Name:   "pool-1",
+							Volume: &extensionsv1alpha1.Volume{Size: "20Gi", Type: "io1"},
+							ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"volume": {"iops": 1000, "kmsKeyID": "arn:aws:kms:eu-west-1:123456789012:key/abc"}
+}`)},
+						},
+						{
+							Name: "pool-2",
+						},
+					},
+				},
+			}
+
+			Expect(NewValidator(decoder).Validate(ctx, worker, nil)).To(Succeed())
+		})
+
+		It("should reject a worker pool requesting IOPS for a non-io1 root volume", func() {
+			worker := &extensionsv1alpha1.Worker{
+				Spec: extensionsv1alpha1.WorkerSpec{
+					Pools: []extensionsv1alpha1.WorkerPool{
+						{
+							Name:   "pool-1",
+							Volume: &extensionsv1alpha1.Volume{Size: "20Gi", Type: "gp2"},
+							ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"volume": {"iops": 1000}
+}`)},
+						},
+					},
+				},
+			}
+
+			Expect(NewValidator(decoder).Validate(ctx, worker, nil)).To(HaveOccurred())
+		})
+
+		It("should ignore other objects", func() {
+			Expect(NewValidator(decoder).Validate(ctx, &extensionsv1alpha1.Network{}, nil)).To(Succeed())
+		})
+	})
+})
@@ -27,7 +27,7 @@ resource "azurerm_virtual_network" "vnet" {
 
 resource "azurerm_subnet" "workers" {
   name                      = "{{ required "clusterName is required" .Values.clusterName }}-nodes"
-  resource_group_name       = "{{ required "resourceGroup.name is required" .Values.resourceGroup.name }}"
+  resource_group_name       = "{{ required "resourceGroup.vnet.resourceGroup is required" .Values.resourceGroup.vnet.resourceGroup }}"
   virtual_network_name      = "{{ required "resourceGroup.vnet.name is required" .Values.resourceGroup.vnet.name }}"
   address_prefix            = "{{ required "networks.worker is required" .Values.networks.worker }}"
   route_table_id            = "${azurerm_route_table.workers.id}"
@@ -46,6 +46,42 @@ resource "azurerm_network_security_group" "workers" {
   resource_group_name = "{{ required "resourceGroup.name is required" .Values.resourceGroup.name }}"
 }
 
+{{ if .Values.natGateway.enabled -}}
+#=====================================================================
+#= NAT Gateway
+#=====================================================================
+{{- if .Values.natGateway.publicIPCount }}
+
+resource "azurerm_public_ip" "natip" {
+  count               = {{ .Values.natGateway.publicIPCount }}
+  name                = "{{ required "clusterName is required" .Values.clusterName }}-nat-ip-${count.index}"
+  location            = "{{ required "azure.region is required" .Values.azure.region }}"
+  resource_group_name = "{{ required "resourceGroup.name is required" .Values.resourceGroup.name }}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+{{- end }}
+
+resource "azurerm_nat_gateway" "nat" {
+  name                    = "{{ required "clusterName is required" .Values.clusterName }}-nat-gateway"
+  location                = "{{ required "azure.region is required" .Values.azure.region }}"
+  resource_group_name     = "{{ required "resourceGroup.name is required" .Values.resourceGroup.name }}"
+  sku_name                = "Standard"
+  {{- if .Values.natGateway.publicIPCount }}
+  public_ip_address_ids   = ["${azurerm_public_ip.natip.*.id}"]
+  {{- end }}
+  {{- if .Values.natGateway.publicIPPrefixIDs }}
+  public_ip_prefix_ids    = [{{ range $i, $id := .Values.natGateway.publicIPPrefixIDs }}{{ if $i }}, {{ end }}"{{ $id }}"{{ end }}]
+  {{- end }}
+}
+
+resource "azurerm_subnet_nat_gateway_association" "workers" {
+  subnet_id      = "${azurerm_subnet.workers.id}"
+  nat_gateway_id = "${azurerm_nat_gateway.nat.id}"
+}
+{{- end }}
+
+{{ if not .Values.zoned -}}
 #=====================================================================
 #= Availability Set
 #=====================================================================
@@ -58,6 +94,7 @@ resource "azurerm_availability_set" "workers" {
   platform_fault_domain_count  = "{{ required "azure.countFaultDomains is required" .Values.azure.countFaultDomains }}"
   managed                      = true
 }
+{{- end }}
 
 //=====================================================================
 //= Output variables
@@ -75,6 +112,7 @@ output "{{ .Values.outputKeys.subnetName }}" {
   value = "${azurerm_subnet.workers.name}"
 }
 
+{{ if not .Values.zoned -}}
 output "{{ .Values.outputKeys.availabilitySetID }}" {
   value = "${azurerm_availability_set.workers.id}"
 }
@@ -82,6 +120,7 @@ output "{{ .Values.outputKeys.availabilitySetID }}" {
 output "{{ .Values.outputKeys.availabilitySetName }}" {
   value = "${azurerm_availability_set.workers.name}"
 }
+{{- end }}
 
 output "{{ .Values.outputKeys.routeTableName }}" {
   value = "${azurerm_route_table.workers.name}"
@@ -90,3 +129,13 @@ output "{{ .Values.outputKeys.routeTableName }}" {
 output "{{ .Values.outputKeys.securityGroupName }}" {
   value = "${azurerm_network_security_group.workers.name}"
 }
+{{- if .Values.natGateway.enabled }}
+
+output "{{ .Values.outputKeys.natGatewayID }}" {
+  value = "${azurerm_nat_gateway.nat.id}"
+}
+
+output "{{ .Values.outputKeys.natGatewayPublicIPs }}" {
+  value = "{{ if .Values.natGateway.publicIPCount }}${join(",", azurerm_public_ip.natip.*.ip_address)}{{ end }}"
+}
+{{- end }}
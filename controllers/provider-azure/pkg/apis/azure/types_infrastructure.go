@@ -29,6 +29,8 @@ type InfrastructureConfig struct {
 	ResourceGroup *ResourceGroup
 	// Networks is the network configuration (VNets, subnets, etc.)
 	Networks NetworkConfig
+	// Zoned indicates whether the cluster uses availability zones instead of an availability set.
+	Zoned bool
 }
 
 // ResourceGroup is azure resource group
@@ -43,6 +45,17 @@ type NetworkConfig struct {
 	VNet VNet
 	// Workers is the worker subnet range to create (used for the VMs).
 	Workers gardencorev1alpha1.CIDR
+	// NatGateway is the configuration of a NAT gateway for the outbound traffic of the worker subnet.
+	NatGateway *NatGatewayConfig
+}
+
+// NatGatewayConfig contains the configuration of the NAT gateway.
+type NatGatewayConfig struct {
+	// PublicIPCount is the number of public IPs which are created for the NAT gateway. It defaults to one if no
+	// public IP prefixes are given.
+	PublicIPCount *int32
+	// PublicIPPrefixIDs are the IDs of existing public IP prefixes which are attached to the NAT gateway.
+	PublicIPPrefixIDs []string
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -60,6 +73,8 @@ type InfrastructureStatus struct {
 	RouteTables []RouteTable
 	// SecurityGroups is a list of created security groups
 	SecurityGroups []SecurityGroup
+	// Zoned indicates whether the cluster uses availability zones instead of an availability set.
+	Zoned bool
 }
 
 // NetworkStatus is the current status of the infrastructure networks.
@@ -68,6 +83,16 @@ type NetworkStatus struct {
 	VNet VNetStatus
 	// Subnets are the subnets that have been created.
 	Subnets []Subnet
+	// NatGateway is the status of the NAT gateway, if one has been created.
+	NatGateway *NatGatewayStatus
+}
+
+// NatGatewayStatus contains information about the NAT gateway.
+type NatGatewayStatus struct {
+	// ID is the id of the NAT gateway.
+	ID string
+	// PublicIPAddresses are the addresses of the public IPs created for the NAT gateway.
+	PublicIPAddresses []string
 }
 
 // Purpose is a purpose of a subnet.
@@ -118,6 +143,8 @@ type SecurityGroup struct {
 type VNet struct {
 	// Name is the VNet name.
 	Name *string
+	// ResourceGroup is the resource group of an existing VNet. It defaults to the resource group of the cluster.
+	ResourceGroup *string
 	// CIDR is the VNet CIDR
 	CIDR *gardencorev1alpha1.CIDR
 }
@@ -126,4 +153,6 @@ type VNet struct {
 type VNetStatus struct {
 	// Name is the VNet name.
 	Name string
+	// ResourceGroup is the resource group of the VNet if it differs from the resource group of the cluster.
+	ResourceGroup *string
 }
@@ -30,6 +30,9 @@ type InfrastructureConfig struct {
 	ResourceGroup *ResourceGroup `json:"resourceGroup,omitempty"`
 	// Networks is the network configuration (VNet, subnets, etc.)
 	Networks NetworkConfig `json:"networks"`
+	// Zoned indicates whether the cluster uses availability zones instead of an availability set.
+	// +optional
+	Zoned bool `json:"zoned,omitempty"`
 }
 
 // ResourceGroup is azure resource group
@@ -44,6 +47,20 @@ type NetworkConfig struct {
 	VNet VNet `json:"vnet"`
 	// Workers is the worker subnet range to create (used for the VMs).
 	Workers gardencorev1alpha1.CIDR `json:"workers"`
+	// NatGateway is the configuration of a NAT gateway for the outbound traffic of the worker subnet.
+	// +optional
+	NatGateway *NatGatewayConfig `json:"natGateway,omitempty"`
+}
+
+// NatGatewayConfig contains the configuration of the NAT gateway.
+type NatGatewayConfig struct {
+	// PublicIPCount is the number of public IPs which are created for the NAT gateway. It defaults to one if no
+	// public IP prefixes are given.
+	// +optional
+	PublicIPCount *int32 `json:"publicIPCount,omitempty"`
+	// PublicIPPrefixIDs are the IDs of existing public IP prefixes which are attached to the NAT gateway.
+	// +optional
+	PublicIPPrefixIDs []string `json:"publicIPPrefixIDs,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -61,6 +78,9 @@ type InfrastructureStatus struct {
 	RouteTables []RouteTable `json:"routeTables"`
 	// SecurityGroups is a list of created security groups
 	SecurityGroups []SecurityGroup `json:"securityGroups"`
+	// Zoned indicates whether the cluster uses availability zones instead of an availability set.
+	// +optional
+	Zoned bool `json:"zoned,omitempty"`
 }
 
 // NetworkStatus is the current status of the infrastructure networks.
@@ -70,6 +90,19 @@ type NetworkStatus struct {
 
 	// Subnets are the subnets that have been created.
 	Subnets []Subnet `json:"subnets"`
+
+	// NatGateway is the status of the NAT gateway, if one has been created.
+	// +optional
+	NatGateway *NatGatewayStatus `json:"natGateway,omitempty"`
+}
+
+// NatGatewayStatus contains information about the NAT gateway.
+type NatGatewayStatus struct {
+	// ID is the id of the NAT gateway.
+	ID string `json:"id"`
+	// PublicIPAddresses are the addresses of the public IPs created for the NAT gateway.
+	// +optional
+	PublicIPAddresses []string `json:"publicIPAddresses,omitempty"`
 }
 
 // Purpose is a purpose of a subnet.
@@ -121,6 +154,9 @@ type VNet struct {
 	// Name is the VNet name.
 	// +optional
 	Name *string `json:"name,omitempty"`
+	// ResourceGroup is the resource group of an existing VNet. It defaults to the resource group of the cluster.
+	// +optional
+	ResourceGroup *string `json:"resourceGroup,omitempty"`
 	// CIDR is the VNet CIDR
 	// +optional
 	CIDR *gardencorev1alpha1.CIDR `json:"cidr,omitempty"`
@@ -130,4 +166,7 @@ type VNet struct {
 type VNetStatus struct {
 	// Name is the VNet name.
 	Name string `json:"name"`
+	// ResourceGroup is the resource group of the VNet if it differs from the resource group of the cluster.
+	// +optional
+	ResourceGroup *string `json:"resourceGroup,omitempty"`
 }
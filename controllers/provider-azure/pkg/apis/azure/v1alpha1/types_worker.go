@@ -0,0 +1,39 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// ZoneWeights contains weights for the zones of the worker pool. The machines of the worker pool are
+	// distributed over its zones proportionally to their weights. Zones without weight have a weight of 1.
+	// +optional
+	ZoneWeights []ZoneWeight `json:"zoneWeights,omitempty"`
+}
+
+// ZoneWeight is the weight of a zone of a worker pool.
+type ZoneWeight struct {
+	// Zone is the name of the zone.
+	Zone string `json:"zone"`
+	// Weight is the weight of the zone.
+	Weight int32 `json:"weight"`
+}
@@ -86,6 +86,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NatGatewayConfig)(nil), (*azure.NatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(a.(*NatGatewayConfig), b.(*azure.NatGatewayConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.NatGatewayConfig)(nil), (*NatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(a.(*azure.NatGatewayConfig), b.(*NatGatewayConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NatGatewayStatus)(nil), (*azure.NatGatewayStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NatGatewayStatus_To_azure_NatGatewayStatus(a.(*NatGatewayStatus), b.(*azure.NatGatewayStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.NatGatewayStatus)(nil), (*NatGatewayStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_NatGatewayStatus_To_v1alpha1_NatGatewayStatus(a.(*azure.NatGatewayStatus), b.(*NatGatewayStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkConfig)(nil), (*azure.NetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(a.(*NetworkConfig), b.(*azure.NetworkConfig), scope)
 	}); err != nil {
@@ -166,6 +186,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*azure.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(a.(*WorkerConfig), b.(*azure.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*azure.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ZoneWeight)(nil), (*azure.ZoneWeight)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZoneWeight_To_azure_ZoneWeight(a.(*ZoneWeight), b.(*azure.ZoneWeight), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ZoneWeight)(nil), (*ZoneWeight)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ZoneWeight_To_v1alpha1_ZoneWeight(a.(*azure.ZoneWeight), b.(*ZoneWeight), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -238,6 +278,7 @@ func autoConvert_v1alpha1_InfrastructureConfig_To_azure_InfrastructureConfig(in
 	if err := Convert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
+	out.Zoned = in.Zoned
 	return nil
 }
 
@@ -251,6 +292,7 @@ func autoConvert_azure_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in
 	if err := Convert_azure_NetworkConfig_To_v1alpha1_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
+	out.Zoned = in.Zoned
 	return nil
 }
 
@@ -269,6 +311,7 @@ func autoConvert_v1alpha1_InfrastructureStatus_To_azure_InfrastructureStatus(in
 	out.AvailabilitySets = *(*[]azure.AvailabilitySet)(unsafe.Pointer(&in.AvailabilitySets))
 	out.RouteTables = *(*[]azure.RouteTable)(unsafe.Pointer(&in.RouteTables))
 	out.SecurityGroups = *(*[]azure.SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.Zoned = in.Zoned
 	return nil
 }
 
@@ -287,6 +330,7 @@ func autoConvert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in
 	out.AvailabilitySets = *(*[]AvailabilitySet)(unsafe.Pointer(&in.AvailabilitySets))
 	out.RouteTables = *(*[]RouteTable)(unsafe.Pointer(&in.RouteTables))
 	out.SecurityGroups = *(*[]SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.Zoned = in.Zoned
 	return nil
 }
 
@@ -295,11 +339,56 @@ func Convert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *azu
 	return autoConvert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(in *NatGatewayConfig, out *azure.NatGatewayConfig, s conversion.Scope) error {
+	out.PublicIPCount = (*int32)(unsafe.Pointer(in.PublicIPCount))
+	out.PublicIPPrefixIDs = *(*[]string)(unsafe.Pointer(&in.PublicIPPrefixIDs))
+	return nil
+}
+
+// Convert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(in *NatGatewayConfig, out *azure.NatGatewayConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(in, out, s)
+}
+
+func autoConvert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in *azure.NatGatewayConfig, out *NatGatewayConfig, s conversion.Scope) error {
+	out.PublicIPCount = (*int32)(unsafe.Pointer(in.PublicIPCount))
+	out.PublicIPPrefixIDs = *(*[]string)(unsafe.Pointer(&in.PublicIPPrefixIDs))
+	return nil
+}
+
+// Convert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig is an autogenerated conversion function.
+func Convert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in *azure.NatGatewayConfig, out *NatGatewayConfig, s conversion.Scope) error {
+	return autoConvert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_NatGatewayStatus_To_azure_NatGatewayStatus(in *NatGatewayStatus, out *azure.NatGatewayStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.PublicIPAddresses = *(*[]string)(unsafe.Pointer(&in.PublicIPAddresses))
+	return nil
+}
+
+// Convert_v1alpha1_NatGatewayStatus_To_azure_NatGatewayStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NatGatewayStatus_To_azure_NatGatewayStatus(in *NatGatewayStatus, out *azure.NatGatewayStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NatGatewayStatus_To_azure_NatGatewayStatus(in, out, s)
+}
+
+func autoConvert_azure_NatGatewayStatus_To_v1alpha1_NatGatewayStatus(in *azure.NatGatewayStatus, out *NatGatewayStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.PublicIPAddresses = *(*[]string)(unsafe.Pointer(&in.PublicIPAddresses))
+	return nil
+}
+
+// Convert_azure_NatGatewayStatus_To_v1alpha1_NatGatewayStatus is an autogenerated conversion function.
+func Convert_azure_NatGatewayStatus_To_v1alpha1_NatGatewayStatus(in *azure.NatGatewayStatus, out *NatGatewayStatus, s conversion.Scope) error {
+	return autoConvert_azure_NatGatewayStatus_To_v1alpha1_NatGatewayStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(in *NetworkConfig, out *azure.NetworkConfig, s conversion.Scope) error {
 	if err := Convert_v1alpha1_VNet_To_azure_VNet(&in.VNet, &out.VNet, s); err != nil {
 		return err
 	}
 	out.Workers = corev1alpha1.CIDR(in.Workers)
+	out.NatGateway = (*azure.NatGatewayConfig)(unsafe.Pointer(in.NatGateway))
 	return nil
 }
 
@@ -313,6 +402,7 @@ func autoConvert_azure_NetworkConfig_To_v1alpha1_NetworkConfig(in *azure.Network
 		return err
 	}
 	out.Workers = corev1alpha1.CIDR(in.Workers)
+	out.NatGateway = (*NatGatewayConfig)(unsafe.Pointer(in.NatGateway))
 	return nil
 }
 
@@ -326,6 +416,7 @@ func autoConvert_v1alpha1_NetworkStatus_To_azure_NetworkStatus(in *NetworkStatus
 		return err
 	}
 	out.Subnets = *(*[]azure.Subnet)(unsafe.Pointer(&in.Subnets))
+	out.NatGateway = (*azure.NatGatewayStatus)(unsafe.Pointer(in.NatGateway))
 	return nil
 }
 
@@ -339,6 +430,7 @@ func autoConvert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(in *azure.Network
 		return err
 	}
 	out.Subnets = *(*[]Subnet)(unsafe.Pointer(&in.Subnets))
+	out.NatGateway = (*NatGatewayStatus)(unsafe.Pointer(in.NatGateway))
 	return nil
 }
 
@@ -435,6 +527,7 @@ func Convert_azure_Subnet_To_v1alpha1_Subnet(in *azure.Subnet, out *Subnet, s co
 
 func autoConvert_v1alpha1_VNet_To_azure_VNet(in *VNet, out *azure.VNet, s conversion.Scope) error {
 	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
 	out.CIDR = (*corev1alpha1.CIDR)(unsafe.Pointer(in.CIDR))
 	return nil
 }
@@ -446,6 +539,7 @@ func Convert_v1alpha1_VNet_To_azure_VNet(in *VNet, out *azure.VNet, s conversion
 
 func autoConvert_azure_VNet_To_v1alpha1_VNet(in *azure.VNet, out *VNet, s conversion.Scope) error {
 	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
 	out.CIDR = (*corev1alpha1.CIDR)(unsafe.Pointer(in.CIDR))
 	return nil
 }
@@ -457,6 +551,7 @@ func Convert_azure_VNet_To_v1alpha1_VNet(in *azure.VNet, out *VNet, s conversion
 
 func autoConvert_v1alpha1_VNetStatus_To_azure_VNetStatus(in *VNetStatus, out *azure.VNetStatus, s conversion.Scope) error {
 	out.Name = in.Name
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
 	return nil
 }
 
@@ -467,6 +562,7 @@ func Convert_v1alpha1_VNetStatus_To_azure_VNetStatus(in *VNetStatus, out *azure.
 
 func autoConvert_azure_VNetStatus_To_v1alpha1_VNetStatus(in *azure.VNetStatus, out *VNetStatus, s conversion.Scope) error {
 	out.Name = in.Name
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
 	return nil
 }
 
@@ -474,3 +570,45 @@ func autoConvert_azure_VNetStatus_To_v1alpha1_VNetStatus(in *azure.VNetStatus, o
 func Convert_azure_VNetStatus_To_v1alpha1_VNetStatus(in *azure.VNetStatus, out *VNetStatus, s conversion.Scope) error {
 	return autoConvert_azure_VNetStatus_To_v1alpha1_VNetStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(in *WorkerConfig, out *azure.WorkerConfig, s conversion.Scope) error {
+	out.ZoneWeights = *(*[]azure.ZoneWeight)(unsafe.Pointer(&in.ZoneWeights))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_azure_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(in *WorkerConfig, out *azure.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(in, out, s)
+}
+
+func autoConvert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in *azure.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.ZoneWeights = *(*[]ZoneWeight)(unsafe.Pointer(&in.ZoneWeights))
+	return nil
+}
+
+// Convert_azure_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in *azure.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ZoneWeight_To_azure_ZoneWeight(in *ZoneWeight, out *azure.ZoneWeight, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1alpha1_ZoneWeight_To_azure_ZoneWeight is an autogenerated conversion function.
+func Convert_v1alpha1_ZoneWeight_To_azure_ZoneWeight(in *ZoneWeight, out *azure.ZoneWeight, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ZoneWeight_To_azure_ZoneWeight(in, out, s)
+}
+
+func autoConvert_azure_ZoneWeight_To_v1alpha1_ZoneWeight(in *azure.ZoneWeight, out *ZoneWeight, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_azure_ZoneWeight_To_v1alpha1_ZoneWeight is an autogenerated conversion function.
+func Convert_azure_ZoneWeight_To_v1alpha1_ZoneWeight(in *azure.ZoneWeight, out *ZoneWeight, s conversion.Scope) error {
+	return autoConvert_azure_ZoneWeight_To_v1alpha1_ZoneWeight(in, out, s)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	cidrvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// maxNatGatewayPublicIPs is the maximum number of public IPs which can be attached to an Azure NAT gateway.
+const maxNatGatewayPublicIPs = 16
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object.
+func ValidateInfrastructureConfig(infra *apisazure.InfrastructureConfig) field.ErrorList {
+	var (
+		allErrs      = field.ErrorList{}
+		networksPath = field.NewPath("networks")
+		vnetPath     = networksPath.Child("vnet")
+		vnetCIDR     *cidrvalidation.CIDR
+	)
+
+	if infra.ResourceGroup != nil && len(infra.ResourceGroup.Name) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("resourceGroup", "name"), "must not be empty"))
+	}
+
+	switch {
+	case infra.Networks.VNet.Name != nil && infra.Networks.VNet.CIDR != nil:
+		allErrs = append(allErrs, field.Forbidden(vnetPath.Child("cidr"), "must not be set if an existing VNet name is given"))
+	case infra.Networks.VNet.Name != nil:
+		if len(*infra.Networks.VNet.Name) == 0 {
+			allErrs = append(allErrs, field.Required(vnetPath.Child("name"), "must not be empty"))
+		}
+	case infra.Networks.VNet.CIDR != nil:
+		vnetCIDR = cidrvalidation.NewCIDR(string(*infra.Networks.VNet.CIDR), vnetPath.Child("cidr"))
+		allErrs = append(allErrs, vnetCIDR.ValidateParse()...)
+	default:
+		allErrs = append(allErrs, field.Required(vnetPath, "either an existing VNet name or a CIDR for a new VNet must be given"))
+	}
+
+	if infra.Networks.VNet.ResourceGroup != nil {
+		switch {
+		case infra.Networks.VNet.Name == nil:
+			allErrs = append(allErrs, field.Forbidden(vnetPath.Child("resourceGroup"), "must only be set together with an existing VNet name"))
+		case len(*infra.Networks.VNet.ResourceGroup) == 0:
+			allErrs = append(allErrs, field.Required(vnetPath.Child("resourceGroup"), "must not be empty"))
+		}
+	}
+
+	if natGateway := infra.Networks.NatGateway; natGateway != nil {
+		natGatewayPath := networksPath.Child("natGateway")
+
+		if natGateway.PublicIPCount != nil {
+			count := *natGateway.PublicIPCount
+			switch {
+			case count < 0 || count > maxNatGatewayPublicIPs:
+				allErrs = append(allErrs, field.Invalid(natGatewayPath.Child("publicIPCount"), count, fmt.Sprintf("must be between 0 and %d", maxNatGatewayPublicIPs)))
+			case count == 0 && len(natGateway.PublicIPPrefixIDs) == 0:
+				allErrs = append(allErrs, field.Invalid(natGatewayPath.Child("publicIPCount"), count, "must be greater than 0 if no public IP prefixes are given"))
+			}
+		}
+
+		for i, id := range natGateway.PublicIPPrefixIDs {
+			if len(id) == 0 {
+				allErrs = append(allErrs, field.Required(natGatewayPath.Child("publicIPPrefixIDs").Index(i), "must not be empty"))
+			}
+		}
+	}
+
+	workersCIDR := cidrvalidation.NewCIDR(string(infra.Networks.Workers), networksPath.Child("workers"))
+	allErrs = append(allErrs, workersCIDR.ValidateParse()...)
+
+	if vnetCIDR != nil {
+		allErrs = append(allErrs, vnetCIDR.ValidateSubset(workersCIDR)...)
+	}
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigUpdate validates an update of a InfrastructureConfig object.
+func ValidateInfrastructureConfigUpdate(oldConfig, config *apisazure.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// Switching between an availability set and availability zones would require to recreate all machines.
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(config.Zoned, oldConfig.Zoned, field.NewPath("zoned"))...)
+
+	// The worker subnet cannot be moved to another VNet.
+	vnetPath := field.NewPath("networks", "vnet")
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(config.Networks.VNet.Name, oldConfig.Networks.VNet.Name, vnetPath.Child("name"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(config.Networks.VNet.ResourceGroup, oldConfig.Networks.VNet.ResourceGroup, vnetPath.Child("resourceGroup"))...)
+
+	return allErrs
+}
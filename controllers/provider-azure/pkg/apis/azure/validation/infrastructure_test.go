@@ -0,0 +1,232 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/validation"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		infrastructureConfig *apisazure.InfrastructureConfig
+
+		vnetCIDR = gardencorev1alpha1.CIDR("10.0.0.0/8")
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisazure.InfrastructureConfig{
+			Networks: apisazure.NetworkConfig{
+				VNet: apisazure.VNet{
+					CIDR: &vnetCIDR,
+				},
+				Workers: "10.250.0.0/19",
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should accept a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid specifying both a VNet name and a VNet CIDR", func() {
+			name := "existing-vnet"
+			infrastructureConfig.Networks.VNet.Name = &name
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.vnet.cidr"),
+			}))))
+		})
+
+		It("should require either a VNet name or a VNet CIDR", func() {
+			infrastructureConfig.Networks.VNet.CIDR = nil
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.vnet"),
+			}))))
+		})
+
+		It("should accept an existing VNet in a different resource group", func() {
+			name, resourceGroup := "existing-vnet", "network-rg"
+			infrastructureConfig.Networks.VNet = apisazure.VNet{
+				Name:          &name,
+				ResourceGroup: &resourceGroup,
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid a VNet resource group without a VNet name", func() {
+			resourceGroup := "network-rg"
+			infrastructureConfig.Networks.VNet.ResourceGroup = &resourceGroup
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.vnet.resourceGroup"),
+			}))))
+		})
+
+		It("should forbid an empty VNet resource group", func() {
+			name, resourceGroup := "existing-vnet", ""
+			infrastructureConfig.Networks.VNet = apisazure.VNet{
+				Name:          &name,
+				ResourceGroup: &resourceGroup,
+			}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.vnet.resourceGroup"),
+			}))))
+		})
+
+		It("should accept a NAT gateway with public IPs and public IP prefixes", func() {
+			count := int32(2)
+			infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{
+				PublicIPCount:     &count,
+				PublicIPPrefixIDs: []string{"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/publicIPPrefixes/prefix"},
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid too many NAT gateway public IPs", func() {
+			count := int32(17)
+			infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{PublicIPCount: &count}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.natGateway.publicIPCount"),
+			}))))
+		})
+
+		It("should forbid a NAT gateway without public IPs and public IP prefixes", func() {
+			count := int32(0)
+			infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{PublicIPCount: &count}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.natGateway.publicIPCount"),
+			}))))
+		})
+
+		It("should forbid empty NAT gateway public IP prefix ids", func() {
+			infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{PublicIPPrefixIDs: []string{""}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.natGateway.publicIPPrefixIDs[0]"),
+			}))))
+		})
+
+		It("should forbid an empty resource group name", func() {
+			infrastructureConfig.ResourceGroup = &apisazure.ResourceGroup{}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("resourceGroup.name"),
+			}))))
+		})
+
+		It("should forbid an invalid workers CIDR", func() {
+			infrastructureConfig.Networks.Workers = "not-a-cidr"
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.workers"),
+			}))))
+		})
+
+		It("should forbid a workers CIDR outside of the VNet CIDR", func() {
+			infrastructureConfig.Networks.Workers = "192.168.0.0/19"
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.workers"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should accept an unchanged configuration", func() {
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, infrastructureConfig.DeepCopy())).To(BeEmpty())
+		})
+
+		It("should forbid changing the zoned flag", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Zoned = true
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("zoned"),
+			}))))
+		})
+
+		It("should forbid changing the VNet name and resource group", func() {
+			var (
+				name          = "existing-vnet"
+				resourceGroup = "existing-vnet-rg"
+			)
+			infrastructureConfig.Networks.VNet = apisazure.VNet{Name: &name}
+
+			newName, newResourceGroup := "other-vnet", resourceGroup
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.VNet = apisazure.VNet{Name: &newName, ResourceGroup: &newResourceGroup}
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vnet.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vnet.resourceGroup"),
+				})),
+			))
+		})
+	})
+})
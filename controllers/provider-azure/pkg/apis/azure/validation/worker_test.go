@@ -0,0 +1,73 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("WorkerConfig validation", func() {
+	var (
+		workerConfig *apisazure.WorkerConfig
+		fldPath      = field.NewPath("config")
+
+		zones = []string{"1", "2"}
+	)
+
+	BeforeEach(func() {
+		workerConfig = &apisazure.WorkerConfig{
+			ZoneWeights: []apisazure.ZoneWeight{
+				{Zone: "1", Weight: 2},
+			},
+		}
+	})
+
+	Describe("#ValidateWorkerConfig", func() {
+		It("should accept a valid configuration", func() {
+			Expect(ValidateWorkerConfig(workerConfig, zones, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid zone weights", func() {
+			workerConfig.ZoneWeights = []apisazure.ZoneWeight{
+				{Zone: "1", Weight: 1},
+				{Zone: "1", Weight: 0},
+				{Zone: "3", Weight: 1},
+			}
+
+			errorList := ValidateWorkerConfig(workerConfig, zones, fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("config.zoneWeights[1].zone"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.zoneWeights[1].weight"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.zoneWeights[2].zone"),
+				})),
+			))
+		})
+	})
+})
@@ -18,6 +18,8 @@ import (
 	"fmt"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/config"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 )
 
 // FindImage takes a list of machine images, and the desired image name and version. It tries
@@ -32,3 +34,23 @@ func FindImage(machineImages []config.MachineImage, imageName, version string) (
 
 	return nil, fmt.Errorf("could not find an image for name %q in version %q", imageName, version)
 }
+
+// FindImageFromCloudProfile takes a CloudProfile, an image name and a version and tries to find the machine image in
+// the Azure section of the CloudProfile. If it cannot be found then an error is returned.
+func FindImageFromCloudProfile(cloudProfile *gardenv1beta1.CloudProfile, imageName, version string) (*config.MachineImage, error) {
+	if cloudProfile != nil && cloudProfile.Spec.Azure != nil {
+		for _, machineImage := range cloudProfile.Spec.Azure.Constraints.MachineImages {
+			if string(machineImage.Name) == imageName && machineImage.Version == version {
+				return &config.MachineImage{
+					Name:      imageName,
+					Version:   version,
+					Publisher: machineImage.Publisher,
+					Offer:     machineImage.Offer,
+					SKU:       machineImage.SKU,
+				}, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("could not find an image for name %q in version %q in the cloud profile", imageName, version)
+}
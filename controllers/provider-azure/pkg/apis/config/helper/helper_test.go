@@ -18,6 +18,7 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/config"
 	. "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/config/helper"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -48,6 +49,25 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found (version does not exist)", makeMachineImages("ubuntu", "2"), "ubuntu", "1", nil),
 		Entry("entry", makeMachineImages("ubuntu", "1"), "ubuntu", "1", &config.MachineImage{Name: "ubuntu", Version: "1", SKU: sku, Publisher: publisher, Offer: offer}),
 	)
+
+	DescribeTable("#FindImageFromCloudProfile",
+		func(cloudProfile *gardenv1beta1.CloudProfile, imageName, version string, expectedImage *config.MachineImage) {
+			image, err := FindImageFromCloudProfile(cloudProfile, imageName, version)
+
+			Expect(image).To(Equal(expectedImage))
+			if expectedImage != nil {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+
+		Entry("cloud profile is nil", nil, "ubuntu", "1", nil),
+		Entry("azure section is nil", &gardenv1beta1.CloudProfile{}, "ubuntu", "1", nil),
+		Entry("entry not found (image does not exist)", makeCloudProfile("debian", "1"), "ubuntu", "1", nil),
+		Entry("entry not found (version does not exist)", makeCloudProfile("ubuntu", "2"), "ubuntu", "1", nil),
+		Entry("entry", makeCloudProfile("ubuntu", "1"), "ubuntu", "1", &config.MachineImage{Name: "ubuntu", Version: "1", Publisher: publisher, Offer: offer, SKU: sku}),
+	)
 })
 
 func makeMachineImages(name, version string) []config.MachineImage {
@@ -61,3 +81,23 @@ func makeMachineImages(name, version string) []config.MachineImage {
 		},
 	}
 }
+
+func makeCloudProfile(name, version string) *gardenv1beta1.CloudProfile {
+	return &gardenv1beta1.CloudProfile{
+		Spec: gardenv1beta1.CloudProfileSpec{
+			Azure: &gardenv1beta1.AzureProfile{
+				Constraints: gardenv1beta1.AzureConstraints{
+					MachineImages: []gardenv1beta1.AzureMachineImage{
+						{
+							Name:      gardenv1beta1.MachineImageName(name),
+							Publisher: publisher,
+							Offer:     offer,
+							SKU:       sku,
+							Version:   version,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
+	azureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/client"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+type actuator struct {
+	backupbucket.Actuator
+	client        client.Client
+	logger        logr.Logger
+	clientFactory azureclient.Factory
+}
+
+func newActuator() backupbucket.Actuator {
+	return &actuator{
+		logger:        log.Log.WithName("azure-backupbucket-actuator"),
+		clientFactory: azureclient.NewFactory(),
+	}
+}
+
+func (a *actuator) InjectClient(client client.Client) error {
+	a.client = client
+	return nil
+}
+
+// Reconcile creates a resource group named after the BackupBucket which contains a storage account with
+// a container of the same name.
+func (a *actuator) Reconcile(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	auth, err := internal.GetClientAuthData(ctx, a.client, bb.Spec.SecretRef)
+	if err != nil {
+		return err
+	}
+
+	groupClient, err := a.clientFactory.Group(ctx, *auth)
+	if err != nil {
+		return err
+	}
+	if err := groupClient.CreateOrUpdate(ctx, bb.Name, bb.Spec.Region); err != nil {
+		return err
+	}
+
+	storageAccountClient, err := a.clientFactory.StorageAccount(ctx, *auth)
+	if err != nil {
+		return err
+	}
+
+	storageAccountName := internal.GenerateStorageAccountName(bb.Name)
+	if err := storageAccountClient.CreateStorageAccount(ctx, bb.Name, storageAccountName, bb.Spec.Region); err != nil {
+		return err
+	}
+
+	storageAccountKey, err := storageAccountClient.ListStorageAccountKey(ctx, bb.Name, storageAccountName)
+	if err != nil {
+		return err
+	}
+
+	storageClient, err := a.clientFactory.Storage(ctx, storageAccountName, storageAccountKey)
+	if err != nil {
+		return err
+	}
+
+	return storageClient.CreateContainerIfNotExists(ctx, bb.Name)
+}
+
+// Delete deletes the resource group of the BackupBucket, including the storage account and all backups.
+func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	auth, err := internal.GetClientAuthData(ctx, a.client, bb.Spec.SecretRef)
+	if err != nil {
+		return err
+	}
+
+	groupClient, err := a.clientFactory.Group(ctx, *auth)
+	if err != nil {
+		return err
+	}
+
+	return groupClient.DeleteIfExists(ctx, bb.Name)
+}
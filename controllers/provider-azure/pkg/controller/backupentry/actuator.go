@@ -0,0 +1,109 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
+	azureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/client"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry/genericactuator"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type actuator struct {
+	client        client.Client
+	logger        logr.Logger
+	clientFactory azureclient.Factory
+}
+
+func newActuator() genericactuator.BackupEntryDelegate {
+	return &actuator{
+		logger:        logger,
+		clientFactory: azureclient.NewFactory(),
+	}
+}
+
+func (a *actuator) InjectClient(client client.Client) error {
+	a.client = client
+	return nil
+}
+
+// GetETCDSecretData replaces the client credentials of the backup secret by the name and key of the storage
+// account holding the backup bucket, as etcd-backup-restore only needs access to the blob storage.
+func (a *actuator) GetETCDSecretData(ctx context.Context, be *extensionsv1alpha1.BackupEntry, backupSecretData map[string][]byte) (map[string][]byte, error) {
+	auth, err := internal.ReadClientAuthDataFromSecret(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: be.Spec.SecretRef.Namespace, Name: be.Spec.SecretRef.Name},
+		Data:       backupSecretData,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	storageAccountName, storageAccountKey, err := a.getStorageAccountCredentials(ctx, auth, be.Spec.BucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string][]byte{
+		azure.StorageAccount: []byte(storageAccountName),
+		azure.StorageKey:     []byte(storageAccountKey),
+		azure.BucketName:     []byte(be.Spec.BucketName),
+	}, nil
+}
+
+func (a *actuator) Delete(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
+	auth, err := internal.GetClientAuthData(ctx, a.client, be.Spec.SecretRef)
+	if err != nil {
+		return err
+	}
+
+	storageAccountName, storageAccountKey, err := a.getStorageAccountCredentials(ctx, auth, be.Spec.BucketName)
+	if err != nil {
+		if azureclient.IsNotFoundError(err) {
+			a.logger.Info("Storage account of backup bucket not found, nothing to delete", "backupentry", be.Name, "bucket", be.Spec.BucketName)
+			return nil
+		}
+		return err
+	}
+
+	storageClient, err := a.clientFactory.Storage(ctx, storageAccountName, storageAccountKey)
+	if err != nil {
+		return err
+	}
+
+	return storageClient.DeleteObjectsWithPrefix(ctx, be.Spec.BucketName, fmt.Sprintf("%s/", be.Name))
+}
+
+func (a *actuator) getStorageAccountCredentials(ctx context.Context, auth *internal.ClientAuth, bucketName string) (string, string, error) {
+	storageAccountClient, err := a.clientFactory.StorageAccount(ctx, *auth)
+	if err != nil {
+		return "", "", err
+	}
+
+	storageAccountName := internal.GenerateStorageAccountName(bucketName)
+	storageAccountKey, err := storageAccountClient.ListStorageAccountKey(ctx, bucketName, storageAccountName)
+	if err != nil {
+		return "", "", err
+	}
+	return storageAccountName, storageAccountKey, nil
+}
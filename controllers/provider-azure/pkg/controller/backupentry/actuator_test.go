@@ -0,0 +1,115 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
+	mockazureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/mock/client"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+var _ = Describe("Actuator", func() {
+	var (
+		ctrl *gomock.Controller
+		ctx  context.Context
+
+		c                    *mockclient.MockClient
+		factory              *mockazureclient.MockFactory
+		storageAccountClient *mockazureclient.MockStorageAccount
+		a                    *actuator
+
+		secretData         map[string][]byte
+		auth               internal.ClientAuth
+		storageAccountName string
+		be                 *extensionsv1alpha1.BackupEntry
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+
+		c = mockclient.NewMockClient(ctrl)
+		factory = mockazureclient.NewMockFactory(ctrl)
+		storageAccountClient = mockazureclient.NewMockStorageAccount(ctrl)
+		a = &actuator{client: c, logger: log.Log, clientFactory: factory}
+
+		secretData = map[string][]byte{
+			azure.SubscriptionIDKey: []byte("subscription"),
+			azure.TenantIDKey:       []byte("tenant"),
+			azure.ClientIDKey:       []byte("client"),
+			azure.ClientSecretKey:   []byte("secret"),
+		}
+		auth = internal.ClientAuth{SubscriptionID: "subscription", TenantID: "tenant", ClientID: "client", ClientSecret: "secret"}
+		storageAccountName = internal.GenerateStorageAccountName("bucket")
+		be = &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar--abcde"},
+			Spec: extensionsv1alpha1.BackupEntrySpec{
+				BucketName: "bucket",
+				Region:     "westeurope",
+				SecretRef:  corev1.SecretReference{Namespace: "garden", Name: "backupprovider"},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#GetETCDSecretData", func() {
+		It("should return the storage account credentials and the bucket name", func() {
+			factory.EXPECT().StorageAccount(ctx, auth).Return(storageAccountClient, nil)
+			storageAccountClient.EXPECT().ListStorageAccountKey(ctx, "bucket", storageAccountName).Return("key", nil)
+
+			data, err := a.GetETCDSecretData(ctx, be, secretData)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(map[string][]byte{
+				azure.StorageAccount: []byte(storageAccountName),
+				azure.StorageKey:     []byte("key"),
+				azure.BucketName:     []byte("bucket"),
+			}))
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete all blobs under the shoot prefix", func() {
+			storageClient := mockazureclient.NewMockStorage(ctrl)
+
+			c.EXPECT().Get(ctx, kutil.Key("garden", "backupprovider"), gomock.AssignableToTypeOf(&corev1.Secret{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, actual *corev1.Secret) error {
+					actual.Data = secretData
+					return nil
+				})
+			factory.EXPECT().StorageAccount(ctx, auth).Return(storageAccountClient, nil)
+			storageAccountClient.EXPECT().ListStorageAccountKey(ctx, "bucket", storageAccountName).Return("key", nil)
+			factory.EXPECT().Storage(ctx, storageAccountName, "key").Return(storageClient, nil)
+			storageClient.EXPECT().DeleteObjectsWithPrefix(ctx, "bucket", "shoot--foo--bar--abcde/")
+
+			Expect(a.Delete(ctx, be)).To(Succeed())
+		})
+	})
+})
@@ -213,7 +213,7 @@ func getConfigChartValues(
 	}
 
 	// Collect config chart values
-	return map[string]interface{}{
+	values := map[string]interface{}{
 		"kubernetesVersion":   cluster.Shoot.Spec.Kubernetes.Version,
 		"tenantId":            ca.TenantID,
 		"subscriptionId":      ca.SubscriptionID,
@@ -226,7 +226,22 @@ func getConfigChartValues(
 		"routeTableName":      routeTableName,
 		"securityGroupName":   securityGroupName,
 		"region":              cp.Spec.Region,
-	}, nil
+	}
+
+	if infraStatus.Networks.VNet.ResourceGroup != nil {
+		values["vnetResourceGroup"] = *infraStatus.Networks.VNet.ResourceGroup
+	}
+
+	// Zoned clusters and NAT gateways require standard SKU load balancers.
+	if infraStatus.Zoned || infraStatus.Networks.NatGateway != nil {
+		values["loadBalancerSku"] = "standard"
+	}
+	// Zoned clusters have no availability set.
+	if infraStatus.Zoned {
+		delete(values, "availabilitySetName")
+	}
+
+	return values, nil
 }
 
 // getCCMChartValues collects and returns the CCM chart values.
@@ -237,11 +252,16 @@ func getCCMChartValues(
 	checksums map[string]string,
 	scaledDown bool,
 ) (map[string]interface{}, error) {
+	podNetwork, err := extensionscontroller.GetPodNetwork(cluster.Shoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not determine pod network of controlplane '%s'", util.ObjectName(cp))
+	}
+
 	values := map[string]interface{}{
 		"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster.Shoot, scaledDown, 1),
 		"clusterName":       cp.Namespace,
 		"kubernetesVersion": cluster.Shoot.Spec.Kubernetes.Version,
-		"podNetwork":        extensionscontroller.GetPodNetwork(cluster.Shoot),
+		"podNetwork":        podNetwork,
 		"podAnnotations": map[string]interface{}{
 			"checksum/secret-cloud-controller-manager":        checksums[cloudControllerManagerDeploymentName],
 			"checksum/secret-cloud-controller-manager-server": checksums[cloudControllerManagerServerName],
@@ -260,14 +280,19 @@ func getCCMChartValues(
 }
 
 // getInfraNames determines the subnet, availability set, route table and security group names from the given infrastructure status.
+// The availability set name is empty for zoned clusters.
 func getInfraNames(infraStatus *apisazure.InfrastructureStatus) (string, string, string, string, error) {
 	nodesSubnet, err := azureapihelper.FindSubnetByPurpose(infraStatus.Networks.Subnets, apisazure.PurposeNodes)
 	if err != nil {
 		return "", "", "", "", errors.Wrapf(err, "could not determine subnet for purpose 'nodes'")
 	}
-	nodesAvailabilitySet, err := azureapihelper.FindAvailabilitySetByPurpose(infraStatus.AvailabilitySets, apisazure.PurposeNodes)
-	if err != nil {
-		return "", "", "", "", errors.Wrapf(err, "could not determine availability set for purpose 'nodes'")
+	var availabilitySetName string
+	if !infraStatus.Zoned {
+		nodesAvailabilitySet, err := azureapihelper.FindAvailabilitySetByPurpose(infraStatus.AvailabilitySets, apisazure.PurposeNodes)
+		if err != nil {
+			return "", "", "", "", errors.Wrapf(err, "could not determine availability set for purpose 'nodes'")
+		}
+		availabilitySetName = nodesAvailabilitySet.Name
 	}
 	nodesRouteTable, err := azureapihelper.FindRouteTableByPurpose(infraStatus.RouteTables, apisazure.PurposeNodes)
 	if err != nil {
@@ -278,5 +303,5 @@ func getInfraNames(infraStatus *apisazure.InfrastructureStatus) (string, string,
 		return "", "", "", "", errors.Wrapf(err, "could not determine security group for purpose 'nodes'")
 	}
 
-	return nodesSubnet.Name, nodesAvailabilitySet.Name, nodesRouteTable.Name, nodesSecurityGroup.Name, nil
+	return nodesSubnet.Name, availabilitySetName, nodesRouteTable.Name, nodesSecurityGroup.Name, nil
 }
@@ -54,6 +54,8 @@ var _ = Describe("ValuesProvider", func() {
 		scheme = runtime.NewScheme()
 		_      = apisazure.AddToScheme(scheme)
 
+		vnetResourceGroup = "rg-network"
+
 		cp = &extensionsv1alpha1.ControlPlane{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "control-plane",
@@ -233,6 +235,128 @@ var _ = Describe("ValuesProvider", func() {
 			},
 		}
 
+		cpNatGateway = &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "control-plane",
+				Namespace: namespace,
+			},
+			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				Region: "eu-west-1a",
+				SecretRef: corev1.SecretReference{
+					Name:      common.CloudProviderSecretName,
+					Namespace: namespace,
+				},
+				ProviderConfig: &runtime.RawExtension{
+					Raw: encode(&apisazure.ControlPlaneConfig{
+						CloudControllerManager: &apisazure.CloudControllerManagerConfig{
+							KubernetesConfig: gardenv1beta1.KubernetesConfig{
+								FeatureGates: map[string]bool{
+									"CustomResourceValidation": true,
+								},
+							},
+						},
+					}),
+				},
+				InfrastructureProviderStatus: &runtime.RawExtension{
+					Raw: encode(&apisazure.InfrastructureStatus{
+						ResourceGroup: apisazure.ResourceGroup{
+							Name: "rg-abcd1234",
+						},
+						Networks: apisazure.NetworkStatus{
+							VNet: apisazure.VNetStatus{
+								Name:          "vnet-abcd1234",
+								ResourceGroup: &vnetResourceGroup,
+							},
+							Subnets: []apisazure.Subnet{
+								{
+									Name:    "subnet-abcd1234-nodes",
+									Purpose: "nodes",
+								},
+							},
+							NatGateway: &apisazure.NatGatewayStatus{
+								ID: "/my/nat/gateway",
+							},
+						},
+						SecurityGroups: []apisazure.SecurityGroup{
+							{
+								Purpose: "nodes",
+								Name:    "security-group-name-workers",
+							},
+						},
+						RouteTables: []apisazure.RouteTable{
+							{
+								Purpose: "nodes",
+								Name:    "route-table-name",
+							},
+						},
+						AvailabilitySets: []apisazure.AvailabilitySet{
+							{
+								Name:    "availability-set-name",
+								Purpose: "nodes",
+								ID:      "/my/azure/id",
+							},
+						},
+					}),
+				},
+			},
+		}
+
+		cpZoned = &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "control-plane",
+				Namespace: namespace,
+			},
+			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				Region: "eu-west-1a",
+				SecretRef: corev1.SecretReference{
+					Name:      common.CloudProviderSecretName,
+					Namespace: namespace,
+				},
+				ProviderConfig: &runtime.RawExtension{
+					Raw: encode(&apisazure.ControlPlaneConfig{
+						CloudControllerManager: &apisazure.CloudControllerManagerConfig{
+							KubernetesConfig: gardenv1beta1.KubernetesConfig{
+								FeatureGates: map[string]bool{
+									"CustomResourceValidation": true,
+								},
+							},
+						},
+					}),
+				},
+				InfrastructureProviderStatus: &runtime.RawExtension{
+					Raw: encode(&apisazure.InfrastructureStatus{
+						ResourceGroup: apisazure.ResourceGroup{
+							Name: "rg-abcd1234",
+						},
+						Networks: apisazure.NetworkStatus{
+							VNet: apisazure.VNetStatus{
+								Name: "vnet-abcd1234",
+							},
+							Subnets: []apisazure.Subnet{
+								{
+									Name:    "subnet-abcd1234-nodes",
+									Purpose: "nodes",
+								},
+							},
+						},
+						SecurityGroups: []apisazure.SecurityGroup{
+							{
+								Purpose: "nodes",
+								Name:    "security-group-name-workers",
+							},
+						},
+						RouteTables: []apisazure.RouteTable{
+							{
+								Purpose: "nodes",
+								Name:    "route-table-name",
+							},
+						},
+						Zoned: true,
+					}),
+				},
+			},
+		}
+
 		cpNoSecurityGroups = &extensionsv1alpha1.ControlPlane{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "control-plane",
@@ -414,6 +538,38 @@ var _ = Describe("ValuesProvider", func() {
 			"kubernetesVersion":   "1.13.4",
 		}
 
+		natGatewayConfigChartValues = map[string]interface{}{
+			"tenantId":            "TenantID",
+			"subscriptionId":      "SubscriptionID",
+			"aadClientId":         "ClientID",
+			"aadClientSecret":     "ClientSecret",
+			"resourceGroup":       "rg-abcd1234",
+			"vnetName":            "vnet-abcd1234",
+			"vnetResourceGroup":   "rg-network",
+			"subnetName":          "subnet-abcd1234-nodes",
+			"region":              "eu-west-1a",
+			"availabilitySetName": "availability-set-name",
+			"routeTableName":      "route-table-name",
+			"securityGroupName":   "security-group-name-workers",
+			"kubernetesVersion":   "1.13.4",
+			"loadBalancerSku":     "standard",
+		}
+
+		zonedConfigChartValues = map[string]interface{}{
+			"tenantId":          "TenantID",
+			"subscriptionId":    "SubscriptionID",
+			"aadClientId":       "ClientID",
+			"aadClientSecret":   "ClientSecret",
+			"resourceGroup":     "rg-abcd1234",
+			"vnetName":          "vnet-abcd1234",
+			"subnetName":        "subnet-abcd1234-nodes",
+			"region":            "eu-west-1a",
+			"routeTableName":    "route-table-name",
+			"securityGroupName": "security-group-name-workers",
+			"kubernetesVersion": "1.13.4",
+			"loadBalancerSku":   "standard",
+		}
+
 		ccmChartValues = map[string]interface{}{
 			"replicas":          1,
 			"clusterName":       namespace,
@@ -501,6 +657,46 @@ var _ = Describe("ValuesProvider", func() {
 		})
 	})
 
+	Describe("#GetConfigChartValuesZoned", func() {
+		It("should return correct config chart values without availability set", func() {
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+			err = vp.(inject.Client).InjectClient(client)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetConfigChartValues method and check the result
+			values, err := vp.GetConfigChartValues(context.TODO(), cpZoned, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(zonedConfigChartValues))
+		})
+	})
+
+	Describe("#GetConfigChartValuesNatGateway", func() {
+		It("should return correct config chart values for a VNet in another resource group and a NAT gateway", func() {
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+			// Create valuesProvider
+			vp := NewValuesProvider(logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+			err = vp.(inject.Client).InjectClient(client)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetConfigChartValues method and check the result
+			values, err := vp.GetConfigChartValues(context.TODO(), cpNatGateway, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(natGatewayConfigChartValues))
+		})
+	})
+
 	Describe("#GetConfigChartValuesNoRouteTable", func() {
 		It("should return error, missing route tables", func() {
 			// Create mock client
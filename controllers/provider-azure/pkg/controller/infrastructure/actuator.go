@@ -18,6 +18,7 @@ import (
 	"context"
 
 	azurev1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/v1alpha1"
+	azureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/client"
 	infrainternal "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/infrastructure"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
@@ -40,12 +41,14 @@ type actuator struct {
 	client        client.Client
 	restConfig    *rest.Config
 	chartRenderer chartrenderer.Interface
+	clientFactory azureclient.Factory
 }
 
 // NewActuator creates a new infrastructure.Actuator.
 func NewActuator() infrastructure.Actuator {
 	return &actuator{
-		logger: log.Log.WithName("infrastructure-actuator"),
+		logger:        log.Log.WithName("infrastructure-actuator"),
+		clientFactory: azureclient.NewFactory(),
 	}
 }
 
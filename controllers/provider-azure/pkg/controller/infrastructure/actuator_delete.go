@@ -16,15 +16,27 @@ package infrastructure
 
 import (
 	"context"
+	"time"
 
+	azurev1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
+	azureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/client"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/infrastructure"
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/operation/terraformer"
+	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
 // Delete implements infrastructure.Actuator.
 func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
+	config, err := internal.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return err
+	}
+
 	clientAuth, err := internal.GetClientAuthData(ctx, a.client, infra.Spec.SecretRef)
 	if err != nil {
 		return err
@@ -35,5 +47,95 @@ func (a *actuator) Delete(ctx context.Context, infra *extensionsv1alpha1.Infrast
 		return err
 	}
 
-	return tf.Destroy()
+	configExists, err := tf.ConfigExists()
+	if err != nil {
+		return err
+	}
+
+	networkClient, err := a.clientFactory.Network(ctx, *clientAuth)
+	if err != nil {
+		return err
+	}
+
+	var (
+		g                              = flow.NewGraph("Azure infrastructure destruction")
+		destroyKubernetesLoadBalancers = g.Add(flow.Task{
+			Name: "Destroying Kubernetes load balancers and public IP addresses",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return a.destroyKubernetesLoadBalancers(ctx, networkClient, tf, config, infra.Namespace)
+			}).
+				RetryUntilTimeout(10*time.Second, 5*time.Minute).
+				DoIf(configExists),
+		})
+
+		_ = g.Add(flow.Task{
+			Name:         "Destroying Shoot infrastructure",
+			Fn:           flow.SimpleTaskFn(tf.Destroy),
+			Dependencies: flow.NewTaskIDs(destroyKubernetesLoadBalancers),
+		})
+
+		f = g.Compile()
+	)
+
+	if err := f.Run(flow.Opts{Context: ctx}); err != nil {
+		return &controllererrors.RequeueAfterError{
+			Cause:        flow.Causes(err),
+			RequeueAfter: 30 * time.Second,
+		}
+	}
+	return nil
+}
+
+// destroyKubernetesLoadBalancers deletes the load balancers and public IP addresses the cloud-controller-manager has
+// created for services of type `LoadBalancer`. They would otherwise keep the subnet (and the resource group, if it
+// is not managed by the infrastructure) from being deleted.
+//
+// Depending on the load balancer SKU, the cloud-controller-manager names its load balancers either after the cluster
+// or after the primary availability set, with an `-internal` suffix for internal load balancers. Zoned clusters do not
+// have an availability set and always use standard SKU load balancers named after the cluster.
+func (a *actuator) destroyKubernetesLoadBalancers(
+	ctx context.Context,
+	networkClient azureclient.Network,
+	tf *terraformer.Terraformer,
+	config *azurev1alpha1.InfrastructureConfig,
+	clusterName string,
+) error {
+	state, err := infrastructure.ExtractTerraformState(tf, config)
+	if err != nil {
+		if terraformer.IsVariablesNotFoundError(err) {
+			a.logger.Info("Skipping explicit Azure load balancer and public IP deletion because not all variables have been found in the Terraform state.")
+			return nil
+		}
+		return err
+	}
+
+	return deleteKubernetesLoadBalancers(ctx, networkClient, state, clusterName)
+}
+
+// deleteKubernetesLoadBalancers deletes the load balancers and public IP addresses of the cluster with the given name
+// from the resource group of the given Terraform state.
+func deleteKubernetesLoadBalancers(ctx context.Context, networkClient azureclient.Network, state *infrastructure.TerraformState, clusterName string) error {
+	prefixes := []string{clusterName}
+	if !state.Zoned {
+		prefixes = append(prefixes, state.AvailabilitySetName)
+	}
+
+	for _, prefix := range prefixes {
+		for _, name := range []string{prefix, prefix + "-internal"} {
+			if err := networkClient.DeleteLoadBalancerIfExists(ctx, state.ResourceGroupName, name); err != nil {
+				return err
+			}
+		}
+	}
+
+	publicIPs, err := networkClient.ListKubernetesPublicIPs(ctx, state.ResourceGroupName, clusterName)
+	if err != nil {
+		return err
+	}
+	for _, publicIP := range publicIPs {
+		if err := networkClient.DeletePublicIPIfExists(ctx, state.ResourceGroupName, publicIP); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,97 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/infrastructure"
+	mockazureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/mock/client"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Actuator", func() {
+	var (
+		ctrl *gomock.Controller
+		ctx  context.Context
+
+		networkClient *mockazureclient.MockNetwork
+
+		resourceGroupName = "resourcegroup"
+		clusterName       = "shoot--foo--bar"
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+
+		networkClient = mockazureclient.NewMockNetwork(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#deleteKubernetesLoadBalancers", func() {
+		It("should delete the load balancers named after the cluster and the availability set and the public IPs", func() {
+			state := &infrastructure.TerraformState{
+				ResourceGroupName:   resourceGroupName,
+				AvailabilitySetName: "availabilityset",
+			}
+
+			gomock.InOrder(
+				networkClient.EXPECT().DeleteLoadBalancerIfExists(ctx, resourceGroupName, clusterName),
+				networkClient.EXPECT().DeleteLoadBalancerIfExists(ctx, resourceGroupName, clusterName+"-internal"),
+				networkClient.EXPECT().DeleteLoadBalancerIfExists(ctx, resourceGroupName, "availabilityset"),
+				networkClient.EXPECT().DeleteLoadBalancerIfExists(ctx, resourceGroupName, "availabilityset-internal"),
+				networkClient.EXPECT().ListKubernetesPublicIPs(ctx, resourceGroupName, clusterName).Return([]string{"ip1", "ip2"}, nil),
+				networkClient.EXPECT().DeletePublicIPIfExists(ctx, resourceGroupName, "ip1"),
+				networkClient.EXPECT().DeletePublicIPIfExists(ctx, resourceGroupName, "ip2"),
+			)
+
+			Expect(deleteKubernetesLoadBalancers(ctx, networkClient, state, clusterName)).To(Succeed())
+		})
+
+		It("should skip the availability set load balancers for zoned clusters", func() {
+			state := &infrastructure.TerraformState{
+				ResourceGroupName: resourceGroupName,
+				Zoned:             true,
+			}
+
+			gomock.InOrder(
+				networkClient.EXPECT().DeleteLoadBalancerIfExists(ctx, resourceGroupName, clusterName),
+				networkClient.EXPECT().DeleteLoadBalancerIfExists(ctx, resourceGroupName, clusterName+"-internal"),
+				networkClient.EXPECT().ListKubernetesPublicIPs(ctx, resourceGroupName, clusterName),
+			)
+
+			Expect(deleteKubernetesLoadBalancers(ctx, networkClient, state, clusterName)).To(Succeed())
+		})
+
+		It("should fail if a load balancer cannot be deleted", func() {
+			state := &infrastructure.TerraformState{
+				ResourceGroupName: resourceGroupName,
+				Zoned:             true,
+			}
+
+			networkClient.EXPECT().DeleteLoadBalancerIfExists(ctx, resourceGroupName, clusterName).Return(fmt.Errorf("error"))
+
+			Expect(deleteKubernetesLoadBalancers(ctx, networkClient, state, clusterName)).To(HaveOccurred())
+		})
+	})
+})
@@ -48,7 +48,7 @@ type delegateFactory struct {
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
-func NewActuator(machineImages []config.MachineImage) worker.Actuator {
+func NewActuator(machineImages []config.MachineImage, deletionOptions worker.DeletionOptions) worker.Actuator {
 	delegateFactory := &delegateFactory{
 		logger:        log.Log.WithName("worker-actuator"),
 		machineImages: machineImages,
@@ -60,6 +60,7 @@ func NewActuator(machineImages []config.MachineImage) worker.Actuator {
 		mcmChart,
 		mcmShootChart,
 		imagevector.ImageVector(),
+		deletionOptions,
 	)
 
 }
@@ -18,17 +18,21 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strconv"
 
 	azureapi "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
 	azureapihelper "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/config"
 	confighelper "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/config/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // MachineClassKind yields the name of the AWS machine class.
@@ -100,13 +104,28 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	nodesAvailabilitySet, err := azureapihelper.FindAvailabilitySetByPurpose(infrastructureStatus.AvailabilitySets, azureapi.PurposeNodes)
-	if err != nil {
-		return err
+
+	// Zoned clusters spread their machines over availability zones and do not have an availability set.
+	var nodesAvailabilitySet *azureapi.AvailabilitySet
+	if !infrastructureStatus.Zoned {
+		nodesAvailabilitySet, err = azureapihelper.FindAvailabilitySetByPurpose(infrastructureStatus.AvailabilitySets, azureapi.PurposeNodes)
+		if err != nil {
+			return err
+		}
 	}
 
+	machineTypes := w.cloudProfileMachineTypes()
+	excludedZones := worker.ExcludedZonesFromAnnotations(w.worker.Annotations)
+
 	for _, pool := range w.worker.Spec.Pools {
-		machineImage, err := confighelper.FindImage(w.machineImages, pool.MachineImage.Name, pool.MachineImage.Version)
+		workerConfig := &azureapi.WorkerConfig{}
+		if pool.ProviderConfig != nil && pool.ProviderConfig.Raw != nil {
+			if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+				return fmt.Errorf("could not decode provider config of worker pool %q: %+v", pool.Name, err)
+			}
+		}
+
+		machineImage, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version)
 		if err != nil {
 			return err
 		}
@@ -116,57 +135,108 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			return err
 		}
 
-		machineClassSpec := map[string]interface{}{
-			"region":            w.worker.Spec.Region,
-			"resourceGroup":     infrastructureStatus.ResourceGroup.Name,
-			"vnetName":          infrastructureStatus.Networks.VNet.Name,
-			"subnetName":        nodesSubnet.Name,
-			"availabilitySetID": nodesAvailabilitySet.ID,
-			"tags": map[string]interface{}{
-				"Name": w.worker.Namespace,
-				fmt.Sprintf("kubernetes.io-cluster-%s", w.worker.Namespace): "1",
-				"kubernetes.io-role-node":                                   "1",
-			},
-			"secret": map[string]interface{}{
-				"cloudConfig": string(pool.UserData),
-			},
-			"machineType": pool.MachineType,
-			"image": map[string]interface{}{
-				"publisher": machineImage.Publisher,
-				"offer":     machineImage.Offer,
-				"sku":       machineImage.SKU,
-				"version":   machineImage.Version,
-			},
-			"volumeSize":   volumeSize,
-			"sshPublicKey": string(w.worker.Spec.SSHPublicKey),
+		generateMachineClassSpec := func() map[string]interface{} {
+			machineClassSpec := map[string]interface{}{
+				"region":        w.worker.Spec.Region,
+				"resourceGroup": infrastructureStatus.ResourceGroup.Name,
+				"vnetName":      infrastructureStatus.Networks.VNet.Name,
+				"subnetName":    nodesSubnet.Name,
+				"tags": map[string]interface{}{
+					"Name": w.worker.Namespace,
+					fmt.Sprintf("kubernetes.io-cluster-%s", w.worker.Namespace): "1",
+					"kubernetes.io-role-node":                                   "1",
+				},
+				"secret": map[string]interface{}{
+					"cloudConfig": string(pool.UserData),
+				},
+				"machineType": pool.MachineType,
+				"image": map[string]interface{}{
+					"publisher": machineImage.Publisher,
+					"offer":     machineImage.Offer,
+					"sku":       machineImage.SKU,
+					"version":   machineImage.Version,
+				},
+				"volumeSize":   volumeSize,
+				"sshPublicKey": string(w.worker.Spec.SSHPublicKey),
+			}
+
+			if infrastructureStatus.Networks.VNet.ResourceGroup != nil {
+				machineClassSpec["vnetResourceGroup"] = *infrastructureStatus.Networks.VNet.ResourceGroup
+			}
+
+			return machineClassSpec
+		}
+
+		addMachineDeployment := func(machineClassSpec map[string]interface{}, deploymentName string, minimum, maximum int, maxSurge, maxUnavailable intstr.IntOrString, zone string) {
+			var (
+				machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
+				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+			)
+
+			machineDeployments = append(machineDeployments, worker.MachineDeployment{
+				Name:           deploymentName,
+				ClassName:      className,
+				SecretName:     className,
+				Minimum:        minimum,
+				Maximum:        maximum,
+				MaxSurge:       maxSurge,
+				MaxUnavailable: maxUnavailable,
+				Labels:         pool.Labels,
+				Annotations:    pool.Annotations,
+				Taints:         pool.Taints,
+				NodeTemplate:   worker.NodeTemplateForMachineType(machineTypes, pool.MachineType, w.worker.Spec.Region, zone),
+				MachineImage: worker.MachineImage{
+					Pool:    pool.Name,
+					Name:    pool.MachineImage.Name,
+					Version: pool.MachineImage.Version,
+					ID:      azureImageURN(machineImage),
+				},
+			})
+
+			machineClassSpec["name"] = className
+			machineClassSpec["secret"].(map[string]interface{})[azure.ClientIDKey] = string(machineClassSecretData[machinev1alpha1.AzureClientID])
+			machineClassSpec["secret"].(map[string]interface{})[azure.ClientSecretKey] = string(machineClassSecretData[machinev1alpha1.AzureClientSecret])
+			machineClassSpec["secret"].(map[string]interface{})[azure.SubscriptionIDKey] = string(machineClassSecretData[machinev1alpha1.AzureSubscriptionID])
+			machineClassSpec["secret"].(map[string]interface{})[azure.TenantIDKey] = string(machineClassSecretData[machinev1alpha1.AzureTenantID])
+
+			machineClasses = append(machineClasses, machineClassSpec)
+		}
+
+		if !infrastructureStatus.Zoned {
+			machineClassSpec := generateMachineClassSpec()
+			machineClassSpec["availabilitySetID"] = nodesAvailabilitySet.ID
+
+			addMachineDeployment(machineClassSpec, fmt.Sprintf("%s-%s", w.worker.Namespace, pool.Name), pool.Minimum, pool.Maximum, pool.MaxSurge, pool.MaxUnavailable, "")
+			continue
+		}
+
+		if len(pool.Zones) == 0 {
+			return fmt.Errorf("worker pool %q of a zoned cluster must specify at least one zone", pool.Name)
 		}
 
-		var (
-			machineClassSpecHash = worker.MachineClassHash(machineClassSpec, shootVersionMajorMinor)
-			deploymentName       = fmt.Sprintf("%s-%s", w.worker.Namespace, pool.Name)
-			className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
-		)
-
-		machineDeployments = append(machineDeployments, worker.MachineDeployment{
-			Name:           deploymentName,
-			ClassName:      className,
-			SecretName:     className,
-			Minimum:        pool.Minimum,
-			Maximum:        pool.Maximum,
-			MaxSurge:       pool.MaxSurge,
-			MaxUnavailable: pool.MaxUnavailable,
-			Labels:         pool.Labels,
-			Annotations:    pool.Annotations,
-			Taints:         pool.Taints,
-		})
-
-		machineClassSpec["name"] = className
-		machineClassSpec["secret"].(map[string]interface{})[azure.ClientIDKey] = string(machineClassSecretData[machinev1alpha1.AzureClientID])
-		machineClassSpec["secret"].(map[string]interface{})[azure.ClientSecretKey] = string(machineClassSecretData[machinev1alpha1.AzureClientSecret])
-		machineClassSpec["secret"].(map[string]interface{})[azure.SubscriptionIDKey] = string(machineClassSecretData[machinev1alpha1.AzureSubscriptionID])
-		machineClassSpec["secret"].(map[string]interface{})[azure.TenantIDKey] = string(machineClassSecretData[machinev1alpha1.AzureTenantID])
-
-		machineClasses = append(machineClasses, machineClassSpec)
+		zoneDistribution := worker.NewZoneDistribution(pool.Zones, zoneWeights(workerConfig.ZoneWeights), excludedZones)
+
+		for zoneIndex, zone := range pool.Zones {
+			zoneNumber, err := strconv.Atoi(zone)
+			if err != nil {
+				return fmt.Errorf("zone %q of worker pool %q is not a valid Azure availability zone: %+v", zone, pool.Name, err)
+			}
+
+			machineClassSpec := generateMachineClassSpec()
+			machineClassSpec["zone"] = zoneNumber
+
+			minimum, maximum := zoneDistribution.DistributeMinMax(zoneIndex, pool.Minimum, pool.Maximum)
+
+			addMachineDeployment(
+				machineClassSpec,
+				fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1),
+				minimum,
+				maximum,
+				zoneDistribution.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, pool.Maximum),
+				zoneDistribution.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, pool.Minimum),
+				zone,
+			)
+		}
 	}
 
 	w.machineDeployments = machineDeployments
@@ -174,3 +244,43 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+// cloudProfileMachineTypes returns the machine types of the CloudProfile which are used to compute the node
+// templates of the machine deployments.
+func (w *workerDelegate) cloudProfileMachineTypes() []gardenv1beta1.MachineType {
+	if w.cluster.CloudProfile == nil || w.cluster.CloudProfile.Spec.Azure == nil {
+		return nil
+	}
+	return w.cluster.CloudProfile.Spec.Azure.Constraints.MachineTypes
+}
+
+// findMachineImage returns the given machine image. The machine images of the CloudProfile take precedence over the
+// ones of the controller configuration.
+func (w *workerDelegate) findMachineImage(name, version string) (*config.MachineImage, error) {
+	machineImage, err := confighelper.FindImageFromCloudProfile(w.cluster.CloudProfile, name, version)
+	if err == nil {
+		return machineImage, nil
+	}
+	if machineImage, configErr := confighelper.FindImage(w.machineImages, name, version); configErr == nil {
+		return machineImage, nil
+	}
+	return nil, err
+}
+
+// azureImageURN returns the URN of the given machine image, i.e. <publisher>:<offer>:<sku>:<version>.
+func azureImageURN(machineImage *config.MachineImage) string {
+	return fmt.Sprintf("%s:%s:%s:%s", machineImage.Publisher, machineImage.Offer, machineImage.SKU, machineImage.Version)
+}
+
+// zoneWeights converts the given zone weights of a worker pool into the weights for the distribution of its machines.
+func zoneWeights(weights []azureapi.ZoneWeight) map[string]int {
+	if len(weights) == 0 {
+		return nil
+	}
+
+	out := make(map[string]int, len(weights))
+	for _, weight := range weights {
+		out[weight.Zone] = int(weight.Weight)
+	}
+	return out
+}
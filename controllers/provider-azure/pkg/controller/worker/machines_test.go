@@ -325,6 +325,11 @@ var _ = Describe("Machines", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				// Test workerDelegate.GenerateMachineDeployments()
+				var (
+					machineImagePool1 = worker.MachineImage{Pool: namePool1, Name: machineImageName, Version: machineImageVersion, ID: machineImagePublisher + ":" + machineImageOffer + ":" + machineImageSKU + ":" + machineImageVersion}
+					machineImagePool2 = worker.MachineImage{Pool: namePool2, Name: machineImageName, Version: machineImageVersion, ID: machineImagePublisher + ":" + machineImageOffer + ":" + machineImageSKU + ":" + machineImageVersion}
+				)
+
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1,
@@ -334,6 +339,7 @@ var _ = Describe("Machines", func() {
 						Maximum:        maxPool1,
 						MaxSurge:       maxSurgePool1,
 						MaxUnavailable: maxUnavailablePool1,
+						MachineImage:   machineImagePool1,
 					},
 					{
 						Name:           machineClassNamePool2,
@@ -343,6 +349,7 @@ var _ = Describe("Machines", func() {
 						Maximum:        maxPool2,
 						MaxSurge:       maxSurgePool2,
 						MaxUnavailable: maxUnavailablePool2,
+						MachineImage:   machineImagePool2,
 					},
 				}
 
@@ -351,6 +358,156 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should reference the resource group of an existing VNet in the machine classes", func() {
+				expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+
+				vnetResourceGroup := "network-rg"
+				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+					Raw: encode(&apisazure.InfrastructureStatus{
+						ResourceGroup: apisazure.ResourceGroup{
+							Name: resourceGroupName,
+						},
+						Networks: apisazure.NetworkStatus{
+							VNet: apisazure.VNetStatus{
+								Name:          vnetName,
+								ResourceGroup: &vnetResourceGroup,
+							},
+							Subnets: []apisazure.Subnet{
+								{
+									Purpose: apisazure.PurposeNodes,
+									Name:    subnetName,
+								},
+							},
+						},
+						AvailabilitySets: []apisazure.AvailabilitySet{
+							{
+								Purpose: apisazure.PurposeNodes,
+								ID:      availabilitySetID,
+							},
+						},
+					}),
+				}
+				w.Spec.Pools = w.Spec.Pools[:1]
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+				chartApplier.
+					EXPECT().
+					ApplyChart(context.TODO(), filepath.Join(azure.InternalChartsPath, "machineclass"), namespace, "machineclass", gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _, _, _ string, values map[string]interface{}, _ map[string]interface{}) error {
+						machineClasses := values["machineClasses"].([]map[string]interface{})
+						Expect(machineClasses).To(HaveLen(1))
+						Expect(machineClasses[0]).To(HaveKeyWithValue("vnetName", vnetName))
+						Expect(machineClasses[0]).To(HaveKeyWithValue("vnetResourceGroup", vnetResourceGroup))
+						return nil
+					})
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+			})
+
+			Context("zoned cluster", func() {
+				BeforeEach(func() {
+					w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+						Raw: encode(&apisazure.InfrastructureStatus{
+							ResourceGroup: apisazure.ResourceGroup{
+								Name: resourceGroupName,
+							},
+							Networks: apisazure.NetworkStatus{
+								VNet: apisazure.VNetStatus{
+									Name: vnetName,
+								},
+								Subnets: []apisazure.Subnet{
+									{
+										Purpose: apisazure.PurposeNodes,
+										Name:    subnetName,
+									},
+								},
+							},
+							Zoned: true,
+						}),
+					}
+					w.Spec.Pools = w.Spec.Pools[:1]
+					w.Spec.Pools[0].Zones = []string{"1", "2"}
+
+					workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+				})
+
+				It("should generate one machine deployment per zone", func() {
+					expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+
+					chartApplier.
+						EXPECT().
+						ApplyChart(context.TODO(), filepath.Join(azure.InternalChartsPath, "machineclass"), namespace, "machineclass", gomock.Any(), nil).
+						DoAndReturn(func(_ context.Context, _, _, _ string, values map[string]interface{}, _ map[string]interface{}) error {
+							machineClasses := values["machineClasses"].([]map[string]interface{})
+							Expect(machineClasses).To(HaveLen(2))
+							for i, machineClass := range machineClasses {
+								Expect(machineClass).To(HaveKeyWithValue("zone", i+1))
+								Expect(machineClass).NotTo(HaveKey("availabilitySetID"))
+							}
+							return nil
+						})
+
+					Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(HaveLen(2))
+
+					Expect(result[0].Name).To(Equal(fmt.Sprintf("%s-%s-z1", namespace, namePool1)))
+					Expect(result[0].Minimum).To(Equal(3))
+					Expect(result[0].Maximum).To(Equal(5))
+					Expect(result[0].MaxSurge).To(Equal(intstr.FromInt(2)))
+					Expect(result[0].MaxUnavailable).To(Equal(intstr.FromInt(1)))
+
+					Expect(result[1].Name).To(Equal(fmt.Sprintf("%s-%s-z2", namespace, namePool1)))
+					Expect(result[1].Minimum).To(Equal(2))
+					Expect(result[1].Maximum).To(Equal(5))
+					Expect(result[1].MaxSurge).To(Equal(intstr.FromInt(1)))
+					Expect(result[1].MaxUnavailable).To(Equal(intstr.FromInt(1)))
+				})
+
+				It("should distribute the machines according to the zone weights", func() {
+					expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&apisazure.WorkerConfig{
+							ZoneWeights: []apisazure.ZoneWeight{
+								{Zone: "1", Weight: 3},
+							},
+						}),
+					}
+					workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(HaveLen(2))
+					Expect([]int{result[0].Minimum, result[0].Maximum, result[1].Minimum, result[1].Maximum}).To(Equal([]int{4, 8, 1, 2}))
+				})
+
+				It("should fail because the worker pool has no zones", func() {
+					expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+
+					w.Spec.Pools[0].Zones = nil
+					workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).To(HaveOccurred())
+					Expect(result).To(BeNil())
+				})
+
+				It("should fail because the zone is not numeric", func() {
+					expectGetSecretCallToWork(c, azureClientID, azureClientSecret, azureSubscriptionID, azureTenantID)
+
+					w.Spec.Pools[0].Zones = []string{"westeurope-1"}
+					workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).To(HaveOccurred())
+					Expect(result).To(BeNil())
+				})
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
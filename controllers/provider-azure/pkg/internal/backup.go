@@ -0,0 +1,31 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"crypto/sha256"
+	"fmt"
+)
+
+// storageAccountNameLength is the maximum length of an Azure storage account name.
+const storageAccountNameLength = 24
+
+// GenerateStorageAccountName generates the name of the storage account which holds the container of the
+// given backup bucket. Storage account names must be globally unique, between 3 and 24 characters long and may
+// only contain lower case letters and numbers, hence the name is derived from a hash of the bucket name.
+func GenerateStorageAccountName(backupBucketName string) string {
+	name := fmt.Sprintf("bkp%x", sha256.Sum256([]byte(backupBucketName)))
+	return name[:storageAccountNameLength]
+}
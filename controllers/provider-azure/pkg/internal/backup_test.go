@@ -0,0 +1,35 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Backup", func() {
+	Describe("#GenerateStorageAccountName", func() {
+		It("should generate a valid and stable storage account name", func() {
+			name := GenerateStorageAccountName("cloud--azure--fg2d6")
+
+			Expect(name).To(MatchRegexp("^bkp[0-9a-f]{21}$"))
+			Expect(GenerateStorageAccountName("cloud--azure--fg2d6")).To(Equal(name))
+		})
+
+		It("should generate different names for different buckets", func() {
+			Expect(GenerateStorageAccountName("foo")).NotTo(Equal(GenerateStorageAccountName("bar")))
+		})
+	})
+})
@@ -0,0 +1,301 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2018-07-01/storage"
+	azurestorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+// KubernetesServiceTagKey is the key of the tag the Azure cloud-controller-manager puts on all public IP addresses it
+// creates for services of type `LoadBalancer`.
+const KubernetesServiceTagKey = "service"
+
+type factory struct{}
+
+type groupClient struct {
+	client resources.GroupsClient
+}
+
+type storageAccountClient struct {
+	client storage.AccountsClient
+}
+
+type storageClient struct {
+	blobService azurestorage.BlobStorageClient
+}
+
+type networkClient struct {
+	loadBalancers     network.LoadBalancersClient
+	publicIPAddresses network.PublicIPAddressesClient
+}
+
+// NewFactory returns a new factory for Azure clients.
+func NewFactory() Factory {
+	return &factory{}
+}
+
+func newAuthorizer(clientAuth internal.ClientAuth) (autorest.Authorizer, error) {
+	oauthConfig, err := adal.NewOAuthConfig(azure.PublicCloud.ActiveDirectoryEndpoint, clientAuth.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := adal.NewServicePrincipalToken(*oauthConfig, clientAuth.ClientID, clientAuth.ClientSecret, azure.PublicCloud.ResourceManagerEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	return autorest.NewBearerAuthorizer(token), nil
+}
+
+// Group implements Factory.
+func (f *factory) Group(ctx context.Context, clientAuth internal.ClientAuth) (Group, error) {
+	authorizer, err := newAuthorizer(clientAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	client := resources.NewGroupsClient(clientAuth.SubscriptionID)
+	client.Authorizer = authorizer
+	return &groupClient{client}, nil
+}
+
+// StorageAccount implements Factory.
+func (f *factory) StorageAccount(ctx context.Context, clientAuth internal.ClientAuth) (StorageAccount, error) {
+	authorizer, err := newAuthorizer(clientAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	client := storage.NewAccountsClient(clientAuth.SubscriptionID)
+	client.Authorizer = authorizer
+	return &storageAccountClient{client}, nil
+}
+
+// Storage implements Factory.
+func (f *factory) Storage(ctx context.Context, storageAccountName, storageAccountKey string) (Storage, error) {
+	client, err := azurestorage.NewBasicClient(storageAccountName, storageAccountKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storageClient{client.GetBlobService()}, nil
+}
+
+// Network implements Factory.
+func (f *factory) Network(ctx context.Context, clientAuth internal.ClientAuth) (Network, error) {
+	authorizer, err := newAuthorizer(clientAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	loadBalancers := network.NewLoadBalancersClient(clientAuth.SubscriptionID)
+	loadBalancers.Authorizer = authorizer
+	publicIPAddresses := network.NewPublicIPAddressesClient(clientAuth.SubscriptionID)
+	publicIPAddresses.Authorizer = authorizer
+	return &networkClient{loadBalancers, publicIPAddresses}, nil
+}
+
+// CreateOrUpdate implements Group.
+func (g *groupClient) CreateOrUpdate(ctx context.Context, resourceGroupName, region string) error {
+	_, err := g.client.CreateOrUpdate(ctx, resourceGroupName, resources.Group{
+		Location: to.StringPtr(region),
+	})
+	return err
+}
+
+// DeleteIfExists implements Group.
+func (g *groupClient) DeleteIfExists(ctx context.Context, resourceGroupName string) error {
+	future, err := g.client.Delete(ctx, resourceGroupName)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	if err := future.WaitForCompletionRef(ctx, g.client.Client); err != nil {
+		if IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// CreateStorageAccount implements StorageAccount.
+func (s *storageAccountClient) CreateStorageAccount(ctx context.Context, resourceGroupName, storageAccountName, region string) error {
+	future, err := s.client.Create(ctx, resourceGroupName, storageAccountName, storage.AccountCreateParameters{
+		Kind:     storage.StorageV2,
+		Location: to.StringPtr(region),
+		Sku: &storage.Sku{
+			Name: storage.StandardLRS,
+		},
+		AccountPropertiesCreateParameters: &storage.AccountPropertiesCreateParameters{
+			AccessTier:             storage.Cool,
+			EnableHTTPSTrafficOnly: to.BoolPtr(true),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return future.WaitForCompletionRef(ctx, s.client.Client)
+}
+
+// ListStorageAccountKey implements StorageAccount.
+func (s *storageAccountClient) ListStorageAccountKey(ctx context.Context, resourceGroupName, storageAccountName string) (string, error) {
+	response, err := s.client.ListKeys(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return "", err
+	}
+
+	if response.Keys == nil || len(*response.Keys) == 0 || (*response.Keys)[0].Value == nil {
+		return "", fmt.Errorf("no keys found for storage account %s/%s", resourceGroupName, storageAccountName)
+	}
+	return *(*response.Keys)[0].Value, nil
+}
+
+// CreateContainerIfNotExists implements Storage.
+func (s *storageClient) CreateContainerIfNotExists(ctx context.Context, container string) error {
+	_, err := s.blobService.GetContainerReference(container).CreateIfNotExists(&azurestorage.CreateContainerOptions{
+		Access: azurestorage.ContainerAccessTypePrivate,
+	})
+	return err
+}
+
+// DeleteObjectsWithPrefix implements Storage.
+func (s *storageClient) DeleteObjectsWithPrefix(ctx context.Context, container, prefix string) error {
+	containerRef := s.blobService.GetContainerReference(container)
+
+	params := azurestorage.ListBlobsParameters{Prefix: prefix}
+	for {
+		response, err := containerRef.ListBlobs(params)
+		if err != nil {
+			return err
+		}
+
+		for _, blob := range response.Blobs {
+			if _, err := containerRef.GetBlobReference(blob.Name).DeleteIfExists(&azurestorage.DeleteBlobOptions{
+				DeleteSnapshots: to.BoolPtr(true),
+			}); err != nil {
+				return err
+			}
+		}
+
+		if response.NextMarker == "" {
+			return nil
+		}
+		params.Marker = response.NextMarker
+	}
+}
+
+// DeleteLoadBalancerIfExists implements Network.
+func (n *networkClient) DeleteLoadBalancerIfExists(ctx context.Context, resourceGroupName, name string) error {
+	future, err := n.loadBalancers.Delete(ctx, resourceGroupName, name)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	if err := future.WaitForCompletionRef(ctx, n.loadBalancers.Client); err != nil {
+		if IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// ListKubernetesPublicIPs implements Network.
+//
+// The Azure cloud-controller-manager names the public IP addresses it creates `<cluster-name>-<suffix>` and tags them
+// with the service they belong to.
+func (n *networkClient) ListKubernetesPublicIPs(ctx context.Context, resourceGroupName, clusterName string) ([]string, error) {
+	it, err := n.publicIPAddresses.ListComplete(ctx, resourceGroupName)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var names []string
+	for it.NotDone() {
+		if publicIP := it.Value(); isKubernetesPublicIP(publicIP, clusterName) {
+			names = append(names, *publicIP.Name)
+		}
+
+		if err := it.Next(); err != nil {
+			return nil, err
+		}
+	}
+	return names, nil
+}
+
+func isKubernetesPublicIP(publicIP network.PublicIPAddress, clusterName string) bool {
+	if publicIP.Name == nil || !strings.HasPrefix(*publicIP.Name, clusterName+"-") {
+		return false
+	}
+	_, ok := publicIP.Tags[KubernetesServiceTagKey]
+	return ok
+}
+
+// DeletePublicIPIfExists implements Network.
+func (n *networkClient) DeletePublicIPIfExists(ctx context.Context, resourceGroupName, name string) error {
+	future, err := n.publicIPAddresses.Delete(ctx, resourceGroupName, name)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	if err := future.WaitForCompletionRef(ctx, n.publicIPAddresses.Client); err != nil {
+		if IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// IsNotFoundError checks whether the given error is an Azure API error with status code 404.
+func IsNotFoundError(err error) bool {
+	switch e := err.(type) {
+	case autorest.DetailedError:
+		return e.StatusCode == http.StatusNotFound
+	case *autorest.DetailedError:
+		return e.StatusCode == http.StatusNotFound
+	case azurestorage.AzureStorageServiceError:
+		return e.StatusCode == http.StatusNotFound
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
+)
+
+// Factory creates the Azure clients for the different services.
+type Factory interface {
+	// Group creates a new resource group client for the given client auth.
+	Group(ctx context.Context, auth internal.ClientAuth) (Group, error)
+	// StorageAccount creates a new storage account client for the given client auth.
+	StorageAccount(ctx context.Context, auth internal.ClientAuth) (StorageAccount, error)
+	// Storage creates a new blob storage client for the given storage account.
+	Storage(ctx context.Context, storageAccountName, storageAccountKey string) (Storage, error)
+	// Network creates a new network client for the given client auth.
+	Network(ctx context.Context, auth internal.ClientAuth) (Network, error)
+}
+
+// Group is the interface for an Azure resource group client.
+type Group interface {
+	// CreateOrUpdate creates or updates the resource group with the given name in the given region.
+	CreateOrUpdate(ctx context.Context, resourceGroupName, region string) error
+	// DeleteIfExists deletes the resource group with the given name and waits until the deletion has completed.
+	// If the resource group does not exist, no error is returned.
+	DeleteIfExists(ctx context.Context, resourceGroupName string) error
+}
+
+// StorageAccount is the interface for an Azure storage account client.
+type StorageAccount interface {
+	// CreateStorageAccount creates or updates the storage account with the given name in the given resource group.
+	CreateStorageAccount(ctx context.Context, resourceGroupName, storageAccountName, region string) error
+	// ListStorageAccountKey returns the first access key of the given storage account.
+	ListStorageAccountKey(ctx context.Context, resourceGroupName, storageAccountName string) (string, error)
+}
+
+// Storage is the interface for an Azure blob storage client.
+type Storage interface {
+	// CreateContainerIfNotExists creates the container with the given name. If it already exists, no error is returned.
+	CreateContainerIfNotExists(ctx context.Context, container string) error
+	// DeleteObjectsWithPrefix deletes all blobs in the given container whose names start with the given prefix.
+	DeleteObjectsWithPrefix(ctx context.Context, container, prefix string) error
+}
+
+// Network is the interface for an Azure network client.
+type Network interface {
+	// DeleteLoadBalancerIfExists deletes the load balancer with the given name in the given resource group and waits
+	// until the deletion has completed. If the load balancer does not exist, no error is returned.
+	DeleteLoadBalancerIfExists(ctx context.Context, resourceGroupName, name string) error
+	// ListKubernetesPublicIPs lists the names of all public IP addresses in the given resource group that have been
+	// created by the Azure cloud-controller-manager of the given cluster.
+	ListKubernetesPublicIPs(ctx context.Context, resourceGroupName, clusterName string) ([]string, error)
+	// DeletePublicIPIfExists deletes the public IP address with the given name in the given resource group and waits
+	// until the deletion has completed. If the public IP address does not exist, no error is returned.
+	DeletePublicIPIfExists(ctx context.Context, resourceGroupName, name string) error
+}
@@ -17,6 +17,7 @@ package infrastructure
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	azurev1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
@@ -48,6 +49,10 @@ const (
 	TerraformerOutputKeyRouteTableName = "routeTableName"
 	// TerraformerOutputKeySecurityGroupName is the key for the securityGroupName output
 	TerraformerOutputKeySecurityGroupName = "securityGroupName"
+	// TerraformerOutputKeyNatGatewayID is the key for the natGatewayID output
+	TerraformerOutputKeyNatGatewayID = "natGatewayID"
+	// TerraformerOutputKeyNatGatewayPublicIPs is the key for the natGatewayPublicIPs output
+	TerraformerOutputKeyNatGatewayPublicIPs = "natGatewayPublicIPs"
 )
 
 var (
@@ -72,6 +77,7 @@ func ComputeTerraformerChartValues(infra *extensionsv1alpha1.Infrastructure, cli
 		resourceGroupName   = infra.Namespace
 		vnetName            = infra.Namespace
 		vnetCIDR            = config.Networks.Workers
+		vnetResourceGroup   string
 
 		findDomainCountByRegion = func(region string, domainCounts []v1beta1.AzureDomainCount) (v1beta1.AzureDomainCount, error) {
 			for _, domainCount := range domainCounts {
@@ -97,9 +103,25 @@ func ComputeTerraformerChartValues(infra *extensionsv1alpha1.Infrastructure, cli
 		vnetCIDR = *config.Networks.VNet.CIDR
 	}
 
+	// an existing VNet may live in a different resource group than the cluster
+	vnetResourceGroup = resourceGroupName
+	if config.Networks.VNet.ResourceGroup != nil {
+		vnetResourceGroup = *config.Networks.VNet.ResourceGroup
+	}
+
+	natGateway := map[string]interface{}{
+		"enabled": false,
+	}
+	if config.Networks.NatGateway != nil {
+		natGateway["enabled"] = true
+		natGateway["publicIPCount"] = natGatewayPublicIPCount(config.Networks.NatGateway)
+		natGateway["publicIPPrefixIDs"] = config.Networks.NatGateway.PublicIPPrefixIDs
+	}
+
 	var countUpdateDomainsCount, countFaultDomainsCount int
 
-	if cluster.CloudProfile.Spec.Azure != nil {
+	// Zoned clusters do not use an availability set, hence they do not need its domain counts.
+	if cluster.CloudProfile.Spec.Azure != nil && !config.Zoned {
 		countUpdateDomains, err := findDomainCountByRegion(infra.Spec.Region, cluster.CloudProfile.Spec.Azure.CountUpdateDomains)
 		if err != nil {
 			return nil, err
@@ -128,14 +150,17 @@ func ComputeTerraformerChartValues(infra *extensionsv1alpha1.Infrastructure, cli
 		"resourceGroup": map[string]interface{}{
 			"name": resourceGroupName,
 			"vnet": map[string]interface{}{
-				"name": vnetName,
-				"cidr": vnetCIDR,
+				"name":          vnetName,
+				"cidr":          vnetCIDR,
+				"resourceGroup": vnetResourceGroup,
 			},
 		},
 		"clusterName": infra.Namespace,
+		"zoned":       config.Zoned,
 		"networks": map[string]interface{}{
 			"worker": config.Networks.Workers,
 		},
+		"natGateway": natGateway,
 		"outputKeys": map[string]interface{}{
 			"resourceGroupName":   TerraformerOutputKeyResourceGroupName,
 			"vnetName":            TerraformerOutputKeyVNetName,
@@ -144,10 +169,24 @@ func ComputeTerraformerChartValues(infra *extensionsv1alpha1.Infrastructure, cli
 			"availabilitySetName": TerraformerOutputKeyAvailabilitySetName,
 			"routeTableName":      TerraformerOutputKeyRouteTableName,
 			"securityGroupName":   TerraformerOutputKeySecurityGroupName,
+			"natGatewayID":        TerraformerOutputKeyNatGatewayID,
+			"natGatewayPublicIPs": TerraformerOutputKeyNatGatewayPublicIPs,
 		},
 	}, nil
 }
 
+// natGatewayPublicIPCount returns the number of public IPs which shall be created for the given NAT gateway. If no
+// count is configured then one public IP is created unless public IP prefixes are given.
+func natGatewayPublicIPCount(natGateway *azurev1alpha1.NatGatewayConfig) int32 {
+	if natGateway.PublicIPCount != nil {
+		return *natGateway.PublicIPCount
+	}
+	if len(natGateway.PublicIPPrefixIDs) > 0 {
+		return 0
+	}
+	return 1
+}
+
 // RenderTerraformerChart renders the azure-infra chart with the given values.
 func RenderTerraformerChart(renderer chartrenderer.Interface, infra *extensionsv1alpha1.Infrastructure, clientAuth *internal.ClientAuth,
 	config *azurev1alpha1.InfrastructureConfig, cluster *controller.Cluster) (*TerraformFiles, error) {
@@ -179,6 +218,8 @@ type TerraformFiles struct {
 type TerraformState struct {
 	// VPCName is the name of the VNet created for an infrastructure.
 	VNetName string
+	// VNetResourceGroupName is the name of the resource group of the VNet if it differs from the cluster's one.
+	VNetResourceGroupName *string
 	// ResourceGroupName is the name of the resource group.
 	ResourceGroupName string
 	// AvailabilitySetID is the ID for the created availability set.
@@ -191,47 +232,69 @@ type TerraformState struct {
 	RouteTableName string
 	// SecurityGroupName is the name of the security group.
 	SecurityGroupName string
+	// Zoned indicates whether the cluster uses availability zones instead of an availability set.
+	Zoned bool
+	// NatGatewayID is the ID of the NAT gateway, if one has been created.
+	NatGatewayID string
+	// NatGatewayPublicIPs are the addresses of the public IPs created for the NAT gateway.
+	NatGatewayPublicIPs []string
 }
 
 // ExtractTerraformState extracts the TerraformState from the given Terraformer.
 func ExtractTerraformState(tf *terraformer.Terraformer, config *azurev1alpha1.InfrastructureConfig) (*TerraformState, error) {
 	outputKeys := []string{
-		TerraformerOutputKeyAvailabilitySetID,
-		TerraformerOutputKeyAvailabilitySetName,
 		TerraformerOutputKeyResourceGroupName,
 		TerraformerOutputKeyRouteTableName,
 		TerraformerOutputKeySecurityGroupName,
 		TerraformerOutputKeySubnetName,
 		TerraformerOutputKeyVNetName,
 	}
+	if !config.Zoned {
+		outputKeys = append(outputKeys, TerraformerOutputKeyAvailabilitySetID, TerraformerOutputKeyAvailabilitySetName)
+	}
+	if config.Networks.NatGateway != nil {
+		outputKeys = append(outputKeys, TerraformerOutputKeyNatGatewayID, TerraformerOutputKeyNatGatewayPublicIPs)
+	}
 
 	vars, err := tf.GetStateOutputVariables(outputKeys...)
 	if err != nil {
 		return nil, err
 	}
 
+	var natGatewayPublicIPs []string
+	for _, ip := range strings.Split(vars[TerraformerOutputKeyNatGatewayPublicIPs], ",") {
+		if len(ip) > 0 {
+			natGatewayPublicIPs = append(natGatewayPublicIPs, ip)
+		}
+	}
+
 	return &TerraformState{
-		AvailabilitySetID:   vars[TerraformerOutputKeyAvailabilitySetID],
-		AvailabilitySetName: vars[TerraformerOutputKeyAvailabilitySetName],
-		VNetName:            vars[TerraformerOutputKeyVNetName],
-		ResourceGroupName:   vars[TerraformerOutputKeyResourceGroupName],
-		RouteTableName:      vars[TerraformerOutputKeyRouteTableName],
-		SecurityGroupName:   vars[TerraformerOutputKeySecurityGroupName],
-		SubnetName:          vars[TerraformerOutputKeySubnetName],
+		AvailabilitySetID:     vars[TerraformerOutputKeyAvailabilitySetID],
+		AvailabilitySetName:   vars[TerraformerOutputKeyAvailabilitySetName],
+		VNetName:              vars[TerraformerOutputKeyVNetName],
+		VNetResourceGroupName: config.Networks.VNet.ResourceGroup,
+		ResourceGroupName:     vars[TerraformerOutputKeyResourceGroupName],
+		RouteTableName:        vars[TerraformerOutputKeyRouteTableName],
+		SecurityGroupName:     vars[TerraformerOutputKeySecurityGroupName],
+		SubnetName:            vars[TerraformerOutputKeySubnetName],
+		Zoned:                 config.Zoned,
+		NatGatewayID:          vars[TerraformerOutputKeyNatGatewayID],
+		NatGatewayPublicIPs:   natGatewayPublicIPs,
 	}, nil
 }
 
 // StatusFromTerraformState computes an InfrastructureStatus from the given
 // Terraform variables.
 func StatusFromTerraformState(state *TerraformState) *azurev1alpha1.InfrastructureStatus {
-	return &azurev1alpha1.InfrastructureStatus{
+	status := &azurev1alpha1.InfrastructureStatus{
 		TypeMeta: StatusTypeMeta,
 		ResourceGroup: azurev1alpha1.ResourceGroup{
 			Name: state.ResourceGroupName,
 		},
 		Networks: azurev1alpha1.NetworkStatus{
 			VNet: azurev1alpha1.VNetStatus{
-				Name: state.VNetName,
+				Name:          state.VNetName,
+				ResourceGroup: state.VNetResourceGroupName,
 			},
 			Subnets: []azurev1alpha1.Subnet{
 				{
@@ -240,16 +303,32 @@ func StatusFromTerraformState(state *TerraformState) *azurev1alpha1.Infrastructu
 				},
 			},
 		},
-		AvailabilitySets: []azurev1alpha1.AvailabilitySet{
-			{Name: state.AvailabilitySetName, ID: state.AvailabilitySetID, Purpose: azurev1alpha1.PurposeNodes},
-		},
+		AvailabilitySets: []azurev1alpha1.AvailabilitySet{},
 		RouteTables: []azurev1alpha1.RouteTable{
 			{Purpose: azurev1alpha1.PurposeNodes, Name: state.RouteTableName},
 		},
 		SecurityGroups: []azurev1alpha1.SecurityGroup{
 			{Name: state.SecurityGroupName, Purpose: azurev1alpha1.PurposeNodes},
 		},
+		Zoned: state.Zoned,
+	}
+
+	if !state.Zoned {
+		status.AvailabilitySets = append(status.AvailabilitySets, azurev1alpha1.AvailabilitySet{
+			Name:    state.AvailabilitySetName,
+			ID:      state.AvailabilitySetID,
+			Purpose: azurev1alpha1.PurposeNodes,
+		})
 	}
+
+	if len(state.NatGatewayID) > 0 {
+		status.Networks.NatGateway = &azurev1alpha1.NatGatewayStatus{
+			ID:                state.NatGatewayID,
+			PublicIPAddresses: state.NatGatewayPublicIPs,
+		}
+	}
+
+	return status
 }
 
 // ComputeStatus computes the status based on the Terraformer and the given InfrastructureConfig.
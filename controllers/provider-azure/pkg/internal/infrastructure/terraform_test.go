@@ -135,14 +135,19 @@ var _ = Describe("Terraform", func() {
 				"resourceGroup": map[string]interface{}{
 					"name": infra.Namespace,
 					"vnet": map[string]interface{}{
-						"name": *config.Networks.VNet.Name,
-						"cidr": config.Networks.Workers,
+						"name":          *config.Networks.VNet.Name,
+						"cidr":          config.Networks.Workers,
+						"resourceGroup": infra.Namespace,
 					},
 				},
 				"clusterName": infra.Namespace,
+				"zoned":       false,
 				"networks": map[string]interface{}{
 					"worker": config.Networks.Workers,
 				},
+				"natGateway": map[string]interface{}{
+					"enabled": false,
+				},
 				"outputKeys": map[string]interface{}{
 					"resourceGroupName":   TerraformerOutputKeyResourceGroupName,
 					"vnetName":            TerraformerOutputKeyVNetName,
@@ -151,10 +156,63 @@ var _ = Describe("Terraform", func() {
 					"availabilitySetName": TerraformerOutputKeyAvailabilitySetName,
 					"routeTableName":      TerraformerOutputKeyRouteTableName,
 					"securityGroupName":   TerraformerOutputKeySecurityGroupName,
+					"natGatewayID":        TerraformerOutputKeyNatGatewayID,
+					"natGatewayPublicIPs": TerraformerOutputKeyNatGatewayPublicIPs,
 				},
 			}
 			Expect(values).To(BeEquivalentTo(expectedValues))
 		})
+
+		It("should not require domain counts for zoned clusters", func() {
+			config.Zoned = true
+			cluster.CloudProfile.Spec.Azure.CountFaultDomains = nil
+			cluster.CloudProfile.Spec.Azure.CountUpdateDomains = nil
+
+			values, err := ComputeTerraformerChartValues(infra, clientAuth, config, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+
+			Expect(values).To(HaveKeyWithValue("zoned", true))
+			Expect(values["azure"]).To(HaveKeyWithValue("countUpdateDomains", 0))
+			Expect(values["azure"]).To(HaveKeyWithValue("countFaultDomains", 0))
+		})
+
+		It("should use the resource group of an existing VNet", func() {
+			vnetResourceGroup := "network-rg"
+			config.Networks.VNet.ResourceGroup = &vnetResourceGroup
+
+			values, err := ComputeTerraformerChartValues(infra, clientAuth, config, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+
+			Expect(values["resourceGroup"]).To(HaveKeyWithValue("name", infra.Namespace))
+			Expect(values["resourceGroup"].(map[string]interface{})["vnet"]).To(HaveKeyWithValue("resourceGroup", vnetResourceGroup))
+		})
+
+		It("should default the number of NAT gateway public IPs", func() {
+			config.Networks.NatGateway = &azurev1alpha1.NatGatewayConfig{}
+
+			values, err := ComputeTerraformerChartValues(infra, clientAuth, config, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+
+			Expect(values["natGateway"]).To(Equal(map[string]interface{}{
+				"enabled":           true,
+				"publicIPCount":     int32(1),
+				"publicIPPrefixIDs": []string(nil),
+			}))
+		})
+
+		It("should not create NAT gateway public IPs if only public IP prefixes are given", func() {
+			prefixIDs := []string{"prefix-id"}
+			config.Networks.NatGateway = &azurev1alpha1.NatGatewayConfig{PublicIPPrefixIDs: prefixIDs}
+
+			values, err := ComputeTerraformerChartValues(infra, clientAuth, config, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+
+			Expect(values["natGateway"]).To(Equal(map[string]interface{}{
+				"enabled":           true,
+				"publicIPCount":     int32(0),
+				"publicIPPrefixIDs": prefixIDs,
+			}))
+		})
 	})
 
 	Describe("#StatusFromTerraformState", func() {
@@ -210,5 +268,32 @@ var _ = Describe("Terraform", func() {
 				},
 			}))
 		})
+
+		It("should not report an availability set for zoned clusters", func() {
+			state.Zoned = true
+
+			status := StatusFromTerraformState(state)
+
+			Expect(status.Zoned).To(BeTrue())
+			Expect(status.AvailabilitySets).To(BeEmpty())
+		})
+
+		It("should report the VNet resource group and the NAT gateway", func() {
+			vnetResourceGroup := "network-rg"
+			state.VNetResourceGroupName = &vnetResourceGroup
+			state.NatGatewayID = "nat_id"
+			state.NatGatewayPublicIPs = []string{"1.2.3.4", "5.6.7.8"}
+
+			status := StatusFromTerraformState(state)
+
+			Expect(status.Networks.VNet).To(Equal(azurev1alpha1.VNetStatus{
+				Name:          vnetName,
+				ResourceGroup: &vnetResourceGroup,
+			}))
+			Expect(status.Networks.NatGateway).To(Equal(&azurev1alpha1.NatGatewayStatus{
+				ID:                "nat_id",
+				PublicIPAddresses: []string{"1.2.3.4", "5.6.7.8"},
+			}))
+		})
 	})
 })
@@ -0,0 +1,3 @@
+//go:generate mockgen -package=client -destination=mocks.go github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/client Factory,Group,StorageAccount,Storage,Network
+
+package client
@@ -0,0 +1,111 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	azurevalidation "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/validation"
+	"github.com/gardener/gardener-extensions/pkg/webhook/validation"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// NewValidator creates a new validator that validates the provider configs of Azure extension resources.
+func NewValidator(decoder runtime.Decoder) validation.Validator {
+	return &validator{
+		decoder: decoder,
+	}
+}
+
+type validator struct {
+	decoder runtime.Decoder
+}
+
+// Validate validates the provider config of the given Infrastructure, ControlPlane or Worker.
+func (v *validator) Validate(ctx context.Context, obj, oldObj runtime.Object) error {
+	switch x := obj.(type) {
+	case *extensionsv1alpha1.Infrastructure:
+		oldInfra, _ := oldObj.(*extensionsv1alpha1.Infrastructure)
+		return v.validateInfrastructure(x, oldInfra).ToAggregate()
+	case *extensionsv1alpha1.ControlPlane:
+		return v.validateControlPlane(x).ToAggregate()
+	case *extensionsv1alpha1.Worker:
+		return v.validateWorker(x).ToAggregate()
+	}
+	return nil
+}
+
+func (v *validator) validateInfrastructure(infra, oldInfra *extensionsv1alpha1.Infrastructure) field.ErrorList {
+	fldPath := field.NewPath("spec", "providerConfig")
+
+	infraConfig := &apisazure.InfrastructureConfig{}
+	if err := validation.DecodeRawExtension(v.decoder, infra.Spec.ProviderConfig, infraConfig, fldPath); err != nil {
+		return field.ErrorList{err}
+	}
+
+	allErrs := azurevalidation.ValidateInfrastructureConfig(infraConfig)
+
+	// The old provider config has already been validated, hence a decoding error is not reported again.
+	if oldInfra != nil {
+		oldInfraConfig := &apisazure.InfrastructureConfig{}
+		if err := validation.DecodeRawExtension(v.decoder, oldInfra.Spec.ProviderConfig, oldInfraConfig, fldPath); err == nil {
+			allErrs = append(allErrs, azurevalidation.ValidateInfrastructureConfigUpdate(oldInfraConfig, infraConfig)...)
+		}
+	}
+
+	return allErrs
+}
+
+func (v *validator) validateControlPlane(cp *extensionsv1alpha1.ControlPlane) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if err := validation.DecodeRawExtension(v.decoder, cp.Spec.ProviderConfig, &apisazure.ControlPlaneConfig{}, field.NewPath("spec", "providerConfig")); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if cp.Spec.InfrastructureProviderStatus != nil {
+		if err := validation.DecodeRawExtension(v.decoder, cp.Spec.InfrastructureProviderStatus, &apisazure.InfrastructureStatus{}, field.NewPath("spec", "infrastructureProviderStatus")); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+
+	return allErrs
+}
+
+func (v *validator) validateWorker(worker *extensionsv1alpha1.Worker) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, pool := range worker.Spec.Pools {
+		if pool.ProviderConfig == nil {
+			continue
+		}
+
+		fldPath := field.NewPath("spec", "pools").Index(i).Child("providerConfig")
+
+		workerConfig := &apisazure.WorkerConfig{}
+		if err := validation.DecodeRawExtension(v.decoder, pool.ProviderConfig, workerConfig, fldPath); err != nil {
+			allErrs = append(allErrs, err)
+			continue
+		}
+
+		allErrs = append(allErrs, azurevalidation.ValidateWorkerConfig(workerConfig, pool.Zones, fldPath)...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/install"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+)
+
+func TestValidation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Azure Validation Webhook Suite")
+}
+
+var _ = Describe("Validator", func() {
+	var (
+		ctx = context.TODO()
+
+		decoder runtime.Decoder
+
+		newInfrastructure = func(zoned string) *extensionsv1alpha1.Infrastructure {
+			return &extensionsv1alpha1.Infrastructure{
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureConfig",
+"networks": {
+  "vnet": {"cidr": "10.250.0.0/16"},
+  "workers": "10.250.0.0/19"
+},
+"zoned": ` + zoned + `
+}`)},
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		install.Install(scheme)
+		decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+	})
+
+	Describe("#Validate", func() {
+		It("should accept a valid infrastructure", func() {
+			Expect(NewValidator(decoder).Validate(ctx, newInfrastructure("true"), nil)).To(Succeed())
+		})
+
+		It("should accept an infrastructure update which does not change the zoned flag", func() {
+			Expect(NewValidator(decoder).Validate(ctx, newInfrastructure("true"), newInfrastructure("true"))).To(Succeed())
+		})
+
+		It("should reject an infrastructure update which changes the zoned flag", func() {
+			Expect(NewValidator(decoder).Validate(ctx, newInfrastructure("true"), newInfrastructure("false"))).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,41 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta
+
+	// Preemptible determines whether preemptible VMs are used for the machines of the worker pool. Preemptible VMs
+	// are cheaper, but can be stopped by GCP at any time and run at most 24 hours.
+	Preemptible *bool
+	// ZoneWeights contains weights for the zones of the worker pool. The machines of the worker pool are
+	// distributed over its zones proportionally to their weights. Zones without weight have a weight of 1.
+	ZoneWeights []ZoneWeight
+}
+
+// ZoneWeight is the weight of a zone of a worker pool.
+type ZoneWeight struct {
+	// Zone is the name of the zone.
+	Zone string
+	// Weight is the weight of the zone.
+	Weight int32
+}
@@ -116,6 +116,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*gcp.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(a.(*WorkerConfig), b.(*gcp.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*gcp.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ZoneWeight)(nil), (*gcp.ZoneWeight)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZoneWeight_To_gcp_ZoneWeight(a.(*ZoneWeight), b.(*gcp.ZoneWeight), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.ZoneWeight)(nil), (*ZoneWeight)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_ZoneWeight_To_v1alpha1_ZoneWeight(a.(*gcp.ZoneWeight), b.(*ZoneWeight), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -302,3 +322,47 @@ func autoConvert_gcp_VPC_To_v1alpha1_VPC(in *gcp.VPC, out *VPC, s conversion.Sco
 func Convert_gcp_VPC_To_v1alpha1_VPC(in *gcp.VPC, out *VPC, s conversion.Scope) error {
 	return autoConvert_gcp_VPC_To_v1alpha1_VPC(in, out, s)
 }
+
+func autoConvert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(in *WorkerConfig, out *gcp.WorkerConfig, s conversion.Scope) error {
+	out.Preemptible = (*bool)(unsafe.Pointer(in.Preemptible))
+	out.ZoneWeights = *(*[]gcp.ZoneWeight)(unsafe.Pointer(&in.ZoneWeights))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(in *WorkerConfig, out *gcp.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(in, out, s)
+}
+
+func autoConvert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(in *gcp.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.Preemptible = (*bool)(unsafe.Pointer(in.Preemptible))
+	out.ZoneWeights = *(*[]ZoneWeight)(unsafe.Pointer(&in.ZoneWeights))
+	return nil
+}
+
+// Convert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(in *gcp.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ZoneWeight_To_gcp_ZoneWeight(in *ZoneWeight, out *gcp.ZoneWeight, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1alpha1_ZoneWeight_To_gcp_ZoneWeight is an autogenerated conversion function.
+func Convert_v1alpha1_ZoneWeight_To_gcp_ZoneWeight(in *ZoneWeight, out *gcp.ZoneWeight, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ZoneWeight_To_gcp_ZoneWeight(in, out, s)
+}
+
+func autoConvert_gcp_ZoneWeight_To_v1alpha1_ZoneWeight(in *gcp.ZoneWeight, out *ZoneWeight, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_gcp_ZoneWeight_To_v1alpha1_ZoneWeight is an autogenerated conversion function.
+func Convert_gcp_ZoneWeight_To_v1alpha1_ZoneWeight(in *gcp.ZoneWeight, out *ZoneWeight, s conversion.Scope) error {
+	return autoConvert_gcp_ZoneWeight_To_v1alpha1_ZoneWeight(in, out, s)
+}
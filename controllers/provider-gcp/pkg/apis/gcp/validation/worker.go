@@ -0,0 +1,49 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateWorkerConfig validates a WorkerConfig object. The given zones are the zones of the worker pool the
+// configuration belongs to.
+func ValidateWorkerConfig(workerConfig *apisgcp.WorkerConfig, zones []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		poolZones     = sets.NewString(zones...)
+		weightedZones = sets.NewString()
+	)
+	for i, zoneWeight := range workerConfig.ZoneWeights {
+		zoneWeightPath := fldPath.Child("zoneWeights").Index(i)
+
+		if !poolZones.Has(zoneWeight.Zone) {
+			allErrs = append(allErrs, field.NotSupported(zoneWeightPath.Child("zone"), zoneWeight.Zone, zones))
+		} else if weightedZones.Has(zoneWeight.Zone) {
+			allErrs = append(allErrs, field.Duplicate(zoneWeightPath.Child("zone"), zoneWeight.Zone))
+		}
+		weightedZones.Insert(zoneWeight.Zone)
+
+		if zoneWeight.Weight <= 0 {
+			allErrs = append(allErrs, field.Invalid(zoneWeightPath.Child("weight"), zoneWeight.Weight, "must be greater than 0"))
+		}
+	}
+
+	return allErrs
+}
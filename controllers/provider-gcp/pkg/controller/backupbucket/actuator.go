@@ -0,0 +1,75 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
+	gcpclient "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+type actuator struct {
+	backupbucket.Actuator
+	client           client.Client
+	logger           logr.Logger
+	newStorageClient func(ctx context.Context, serviceAccount []byte) (gcpclient.StorageClient, error)
+}
+
+func newActuator() backupbucket.Actuator {
+	return &actuator{
+		logger:           log.Log.WithName("gcp-backupbucket-actuator"),
+		newStorageClient: gcpclient.NewStorageClientFromServiceAccount,
+	}
+}
+
+func (a *actuator) InjectClient(client client.Client) error {
+	a.client = client
+	return nil
+}
+
+func (a *actuator) Reconcile(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	serviceAccount, err := internal.GetServiceAccount(ctx, a.client, bb.Spec.SecretRef)
+	if err != nil {
+		return err
+	}
+
+	storageClient, err := a.newStorageClient(ctx, serviceAccount.Raw)
+	if err != nil {
+		return err
+	}
+
+	return storageClient.CreateBucketIfNotExists(ctx, serviceAccount.ProjectID, bb.Name, bb.Spec.Region)
+}
+
+func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	serviceAccount, err := internal.GetServiceAccount(ctx, a.client, bb.Spec.SecretRef)
+	if err != nil {
+		return err
+	}
+
+	storageClient, err := a.newStorageClient(ctx, serviceAccount.Raw)
+	if err != nil {
+		return err
+	}
+
+	return storageClient.DeleteBucketIfExists(ctx, bb.Name)
+}
@@ -21,6 +21,7 @@ import (
 	infrainternal "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/infrastructure"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+	extensionsterraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 
 	"github.com/go-logr/logr"
 
@@ -35,17 +36,25 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
 
+// Actuator is an infrastructure.Actuator that is also able to detect drifts of the infrastructure.
+type Actuator interface {
+	infrastructure.Actuator
+	infrastructure.DriftDetector
+}
+
 type actuator struct {
-	logger        logr.Logger
-	client        client.Client
-	restConfig    *rest.Config
-	chartRenderer chartrenderer.Interface
+	logger             logr.Logger
+	client             client.Client
+	restConfig         *rest.Config
+	chartRenderer      chartrenderer.Interface
+	terraformerFactory extensionsterraformer.Factory
 }
 
-// NewActuator creates a new infrastructure.Actuator.
-func NewActuator() infrastructure.Actuator {
+// NewActuator creates a new Actuator.
+func NewActuator() Actuator {
 	return &actuator{
-		logger: log.Log.WithName("infrastructure-actuator"),
+		logger:             log.Log.WithName("infrastructure-actuator"),
+		terraformerFactory: extensionsterraformer.DefaultFactory(),
 	}
 }
 
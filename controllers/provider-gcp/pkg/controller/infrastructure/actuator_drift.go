@@ -0,0 +1,69 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
+	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/imagevector"
+	infrainternal "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/infrastructure"
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/logger"
+)
+
+// DetectDrift implements infrastructure.DriftDetector.
+func (a *actuator) DetectDrift(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) (*infrastructure.Drift, error) {
+	config, err := internal.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceAccount, err := infrainternal.GetServiceAccountFromInfrastructure(ctx, a.client, infra)
+	if err != nil {
+		return nil, err
+	}
+
+	terraformFiles, err := infrainternal.RenderTerraformerChart(a.chartRenderer, infra, serviceAccount, config, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	variables, err := internal.TerraformerVariablesEnvironmentFromServiceAccount(serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	tf, err := a.terraformerFactory.NewForConfig(logger.NewLogger("info"), a.restConfig, infrainternal.TerraformerPurpose, infra.Namespace, infra.Name, imagevector.TerraformerImage())
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := tf.
+		SetVariablesEnvironment(variables).
+		InitializeWith(a.terraformerFactory.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars)).
+		Plan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !plan.HasChanges {
+		return nil, nil
+	}
+
+	return &infrastructure.Drift{Summary: plan.String()}, nil
+}
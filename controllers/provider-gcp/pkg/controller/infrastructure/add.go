@@ -33,15 +33,23 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// DriftDetection is the configuration for the periodic drift detection.
+	DriftDetection infrastructure.DriftDetectionConfig
 }
 
 // AddToManagerWithOptions adds a controller with the given AddOptions to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(mgr manager.Manager, options AddOptions) error {
+	actuator := NewActuator()
 	return infrastructure.Add(mgr, infrastructure.AddArgs{
-		Actuator:          infrastructure.OperationAnnotationWrapper(NewActuator()),
+		Actuator:          infrastructure.OperationAnnotationWrapper(actuator),
 		ControllerOptions: options.Controller,
 		Predicates:        infrastructure.DefaultPredicates(mgr.GetClient(), gcp.Type, options.IgnoreOperationAnnotation),
+		DriftDetection: &infrastructure.DriftDetectionArgs{
+			DriftDetector: actuator,
+			Type:          gcp.Type,
+			Config:        options.DriftDetection,
+		},
 	})
 }
 
@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -98,10 +99,26 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	machineTypes := w.cloudProfileMachineTypes()
+	excludedZones := worker.ExcludedZonesFromAnnotations(w.worker.Annotations)
+
 	for _, pool := range w.worker.Spec.Pools {
-		zoneLen := len(pool.Zones)
+		workerConfig := &gcpapi.WorkerConfig{}
+		if pool.ProviderConfig != nil && pool.ProviderConfig.Raw != nil {
+			if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+				return fmt.Errorf("could not decode provider config of worker pool %q: %+v", pool.Name, err)
+			}
+		}
+
+		zoneDistribution := worker.NewZoneDistribution(pool.Zones, zoneWeights(workerConfig.ZoneWeights), excludedZones)
+		preemptible := workerConfig.Preemptible != nil && *workerConfig.Preemptible
 
-		machineImage, err := confighelper.FindImage(w.machineImages, pool.MachineImage.Name, pool.MachineImage.Version)
+		labels, taints := pool.Labels, pool.Taints
+		if preemptible {
+			labels, taints = worker.InterruptibleLabels(labels), worker.InterruptibleTaints(taints)
+		}
+
+		machineImage, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version)
 		if err != nil {
 			return err
 		}
@@ -139,11 +156,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 						"subnetwork": nodesSubnet.Name,
 					},
 				},
-				"scheduling": map[string]interface{}{
-					"automaticRestart":  true,
-					"onHostMaintenance": "MIGRATE",
-					"preemptible":       false,
-				},
+				"scheduling": computeScheduling(preemptible),
 				"secret": map[string]interface{}{
 					"cloudConfig": string(pool.UserData),
 				},
@@ -168,17 +181,26 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 			)
 
+			minimum, maximum := zoneDistribution.DistributeMinMax(zoneIndex, pool.Minimum, pool.Maximum)
+
 			machineDeployments = append(machineDeployments, worker.MachineDeployment{
 				Name:           deploymentName,
 				ClassName:      className,
 				SecretName:     className,
-				Minimum:        worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
-				Maximum:        worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
-				Labels:         pool.Labels,
+				Minimum:        minimum,
+				Maximum:        maximum,
+				MaxSurge:       zoneDistribution.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, pool.Maximum),
+				MaxUnavailable: zoneDistribution.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, pool.Minimum),
+				Labels:         labels,
 				Annotations:    pool.Annotations,
-				Taints:         pool.Taints,
+				Taints:         taints,
+				NodeTemplate:   worker.NodeTemplateForMachineType(machineTypes, pool.MachineType, w.worker.Spec.Region, zone),
+				MachineImage: worker.MachineImage{
+					Pool:    pool.Name,
+					Name:    pool.MachineImage.Name,
+					Version: pool.MachineImage.Version,
+					ID:      machineImage,
+				},
 			})
 
 			machineClassSpec["name"] = className
@@ -193,3 +215,56 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+// cloudProfileMachineTypes returns the machine types of the CloudProfile which are used to compute the node
+// templates of the machine deployments.
+func (w *workerDelegate) cloudProfileMachineTypes() []gardenv1beta1.MachineType {
+	if w.cluster.CloudProfile == nil || w.cluster.CloudProfile.Spec.GCP == nil {
+		return nil
+	}
+	return w.cluster.CloudProfile.Spec.GCP.Constraints.MachineTypes
+}
+
+// findMachineImage returns the image of the given machine image. The versioned machine images of the CloudProfile take
+// precedence over the ones of the controller configuration.
+func (w *workerDelegate) findMachineImage(name, version string) (string, error) {
+	image, err := confighelper.FindImageFromCloudProfile(w.cluster.CloudProfile, name, version)
+	if err == nil {
+		return image, nil
+	}
+	if image, configErr := confighelper.FindImage(w.machineImages, name, version); configErr == nil {
+		return image, nil
+	}
+	return "", err
+}
+
+// computeScheduling computes the scheduling configuration of the machines. Preemptible VMs can neither be restarted
+// automatically nor be migrated during host maintenance.
+func computeScheduling(preemptible bool) map[string]interface{} {
+	if preemptible {
+		return map[string]interface{}{
+			"automaticRestart":  false,
+			"onHostMaintenance": "TERMINATE",
+			"preemptible":       true,
+		}
+	}
+
+	return map[string]interface{}{
+		"automaticRestart":  true,
+		"onHostMaintenance": "MIGRATE",
+		"preemptible":       false,
+	}
+}
+
+// zoneWeights converts the given zone weights of a worker pool into the weights for the distribution of its machines.
+func zoneWeights(weights []gcpapi.ZoneWeight) map[string]int {
+	if len(weights) == 0 {
+		return nil
+	}
+
+	out := make(map[string]int, len(weights))
+	for _, weight := range weights {
+		out[weight.Zone] = int(weight.Weight)
+	}
+	return out
+}
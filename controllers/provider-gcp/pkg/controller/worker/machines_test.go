@@ -354,6 +354,11 @@ var _ = Describe("Machines", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				// Test workerDelegate.GenerateMachineDeployments()
+				var (
+					machineImagePool1 = worker.MachineImage{Pool: namePool1, Name: machineImageName, Version: machineImageVersion, ID: machineImage}
+					machineImagePool2 = worker.MachineImage{Pool: namePool2, Name: machineImageName, Version: machineImageVersion, ID: machineImage}
+				)
+
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1Zone1,
@@ -363,6 +368,7 @@ var _ = Describe("Machines", func() {
 						Maximum:        worker.DistributeOverZones(0, maxPool1, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool1, 2, maxPool1),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool1, 2, minPool1),
+						MachineImage:   machineImagePool1,
 					},
 					{
 						Name:           machineClassNamePool1Zone2,
@@ -372,6 +378,7 @@ var _ = Describe("Machines", func() {
 						Maximum:        worker.DistributeOverZones(1, maxPool1, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool1, 2, maxPool1),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool1, 2, minPool1),
+						MachineImage:   machineImagePool1,
 					},
 					{
 						Name:           machineClassNamePool2Zone1,
@@ -381,6 +388,7 @@ var _ = Describe("Machines", func() {
 						Maximum:        worker.DistributeOverZones(0, maxPool2, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool2, 2, maxPool2),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool2, 2, minPool2),
+						MachineImage:   machineImagePool2,
 					},
 					{
 						Name:           machineClassNamePool2Zone2,
@@ -390,6 +398,7 @@ var _ = Describe("Machines", func() {
 						Maximum:        worker.DistributeOverZones(1, maxPool2, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool2, 2, maxPool2),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool2, 2, minPool2),
+						MachineImage:   machineImagePool2,
 					},
 				}
 
@@ -398,6 +407,68 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should use preemptible VMs if configured in the worker pool provider config", func() {
+				expectGetSecretCallToWork(c, serviceAccountJSON)
+
+				preemptible := true
+				w.Spec.Pools = w.Spec.Pools[:1]
+				w.Spec.Pools[0].Zones = []string{zone1}
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apisgcp.WorkerConfig{
+						Preemptible: &preemptible,
+					}),
+				}
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+				chartApplier.
+					EXPECT().
+					ApplyChart(context.TODO(), filepath.Join(gcp.InternalChartsPath, "machineclass"), namespace, "machineclass", gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _, _, _ string, values map[string]interface{}, _ map[string]interface{}) error {
+						machineClasses := values["machineClasses"].([]map[string]interface{})
+						Expect(machineClasses).To(HaveLen(1))
+						Expect(machineClasses[0]["scheduling"]).To(Equal(map[string]interface{}{
+							"automaticRestart":  false,
+							"onHostMaintenance": "TERMINATE",
+							"preemptible":       true,
+						}))
+						return nil
+					})
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(HaveLen(1))
+				Expect(result[0].Labels).To(HaveKeyWithValue(worker.LabelInterruptible, "true"))
+				Expect(result[0].Taints).To(ContainElement(corev1.Taint{
+					Key:    worker.TaintKeyInterruptible,
+					Value:  "true",
+					Effect: corev1.TaintEffectPreferNoSchedule,
+				}))
+			})
+
+			It("should distribute the machines according to the zone weights", func() {
+				expectGetSecretCallToWork(c, serviceAccountJSON)
+
+				w.Spec.Pools = w.Spec.Pools[:1]
+				w.Spec.Pools[0].Zones = []string{zone1, zone2}
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apisgcp.WorkerConfig{
+						ZoneWeights: []apisgcp.ZoneWeight{
+							{Zone: zone1, Weight: 3},
+						},
+					}),
+				}
+
+				workerDelegate = NewWorkerDelegate(c, decoder, machineImages, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(HaveLen(2))
+				Expect([]int{result[0].Minimum, result[0].Maximum, result[1].Minimum, result[1].Maximum}).To(Equal([]int{4, 8, 1, 2}))
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
@@ -0,0 +1,121 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/storage/v1"
+)
+
+type storageClient struct {
+	service *storage.Service
+}
+
+// NewStorageClientFromServiceAccount creates a new storage client from the given service account.
+func NewStorageClientFromServiceAccount(ctx context.Context, serviceAccount []byte) (StorageClient, error) {
+	jwt, err := google.JWTConfigFromJSON(serviceAccount, storage.DevstorageFullControlScope)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := oauth2.NewClient(ctx, jwt.TokenSource(ctx))
+	service, err := storage.New(httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStorageClient(service), nil
+}
+
+// NewStorageClient creates a new storage client backed by the given storage service.
+func NewStorageClient(service *storage.Service) StorageClient {
+	return &storageClient{service}
+}
+
+// CreateBucketIfNotExists implements StorageClient.
+func (s *storageClient) CreateBucketIfNotExists(ctx context.Context, projectID, bucket, region string) error {
+	if _, err := s.service.Buckets.Insert(projectID, &storage.Bucket{
+		Name:     bucket,
+		Location: region,
+	}).PredefinedAcl("projectPrivate").Context(ctx).Do(); err != nil {
+		if isHTTPError(err, http.StatusConflict) {
+			return s.verifyBucketAccessible(ctx, bucket)
+		}
+		return err
+	}
+	return nil
+}
+
+// verifyBucketAccessible checks that an already existing bucket can be accessed with the used service account.
+// Bucket names are globally unique, hence a conflict on creation may also be caused by a bucket owned by another
+// project. For such buckets, the storage API denies access.
+func (s *storageClient) verifyBucketAccessible(ctx context.Context, bucket string) error {
+	if _, err := s.service.Buckets.Get(bucket).Context(ctx).Do(); err != nil {
+		if isHTTPError(err, http.StatusForbidden) || isHTTPError(err, http.StatusNotFound) {
+			return fmt.Errorf("bucket %q already exists but is owned by another project: %v", bucket, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// DeleteBucketIfExists implements StorageClient.
+func (s *storageClient) DeleteBucketIfExists(ctx context.Context, bucket string) error {
+	if err := s.DeleteObjectsWithPrefix(ctx, bucket, ""); err != nil {
+		if isHTTPError(err, http.StatusNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if err := s.service.Buckets.Delete(bucket).Context(ctx).Do(); err != nil {
+		if isHTTPError(err, http.StatusNotFound) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// DeleteObjectsWithPrefix implements StorageClient.
+func (s *storageClient) DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error {
+	var objectNames []string
+	if err := s.service.Objects.List(bucket).Prefix(prefix).Pages(ctx, func(objects *storage.Objects) error {
+		for _, object := range objects.Items {
+			objectNames = append(objectNames, object.Name)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, name := range objectNames {
+		if err := s.service.Objects.Delete(bucket, name).Context(ctx).Do(); err != nil && !isHTTPError(err, http.StatusNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+func isHTTPError(err error, code int) bool {
+	apiErr, ok := err.(*googleapi.Error)
+	return ok && apiErr.Code == code
+}
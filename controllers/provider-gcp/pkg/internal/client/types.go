@@ -72,3 +72,15 @@ type RoutesDeleteCall interface {
 	// Context sets the context for the deletion call.
 	Context(context.Context) RoutesDeleteCall
 }
+
+// StorageClient is the interface for the GCP storage (GCS) client.
+type StorageClient interface {
+	// CreateBucketIfNotExists creates the bucket with the given name in the given region. If it already exists and
+	// is accessible with the client's credentials, no error is returned.
+	CreateBucketIfNotExists(ctx context.Context, projectID, bucket, region string) error
+	// DeleteBucketIfExists deletes the bucket with the given name including all of its objects. If it does not
+	// exist, no error is returned.
+	DeleteBucketIfExists(ctx context.Context, bucket string) error
+	// DeleteObjectsWithPrefix deletes all objects in the given bucket whose names start with the given prefix.
+	DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error
+}
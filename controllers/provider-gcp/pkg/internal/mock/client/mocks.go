@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client (interfaces: Interface,FirewallsService,RoutesService,FirewallsListCall,RoutesListCall,FirewallsDeleteCall,RoutesDeleteCall)
+// Source: github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client (interfaces: Interface,FirewallsService,RoutesService,FirewallsListCall,RoutesListCall,FirewallsDeleteCall,RoutesDeleteCall,StorageClient)
 
 // Package client is a generated GoMock package.
 package client
@@ -351,3 +351,68 @@ func (mr *MockRoutesDeleteCallMockRecorder) Do(arg0 ...interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockRoutesDeleteCall)(nil).Do), arg0...)
 }
+
+// MockStorageClient is a mock of StorageClient interface
+type MockStorageClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageClientMockRecorder
+}
+
+// MockStorageClientMockRecorder is the mock recorder for MockStorageClient
+type MockStorageClientMockRecorder struct {
+	mock *MockStorageClient
+}
+
+// NewMockStorageClient creates a new mock instance
+func NewMockStorageClient(ctrl *gomock.Controller) *MockStorageClient {
+	mock := &MockStorageClient{ctrl: ctrl}
+	mock.recorder = &MockStorageClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockStorageClient) EXPECT() *MockStorageClientMockRecorder {
+	return m.recorder
+}
+
+// CreateBucketIfNotExists mocks base method
+func (m *MockStorageClient) CreateBucketIfNotExists(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBucketIfNotExists", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBucketIfNotExists indicates an expected call of CreateBucketIfNotExists
+func (mr *MockStorageClientMockRecorder) CreateBucketIfNotExists(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBucketIfNotExists", reflect.TypeOf((*MockStorageClient)(nil).CreateBucketIfNotExists), arg0, arg1, arg2, arg3)
+}
+
+// DeleteBucketIfExists mocks base method
+func (m *MockStorageClient) DeleteBucketIfExists(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBucketIfExists", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBucketIfExists indicates an expected call of DeleteBucketIfExists
+func (mr *MockStorageClientMockRecorder) DeleteBucketIfExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBucketIfExists", reflect.TypeOf((*MockStorageClient)(nil).DeleteBucketIfExists), arg0, arg1)
+}
+
+// DeleteObjectsWithPrefix mocks base method
+func (m *MockStorageClient) DeleteObjectsWithPrefix(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObjectsWithPrefix", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObjectsWithPrefix indicates an expected call of DeleteObjectsWithPrefix
+func (mr *MockStorageClientMockRecorder) DeleteObjectsWithPrefix(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectsWithPrefix", reflect.TypeOf((*MockStorageClient)(nil).DeleteObjectsWithPrefix), arg0, arg1, arg2)
+}
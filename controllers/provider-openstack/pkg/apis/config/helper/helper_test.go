@@ -18,9 +18,11 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config"
 	. "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config/helper"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("Helper", func() {
@@ -43,6 +45,26 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found (region does not exist)", makeMachineImages("ubuntu", "1", "us-ca-1", "0"), "ubuntu", "1", "eu-de-1", ""),
 		Entry("entry", makeMachineImages("ubuntu", "1", "eu-de-1", "image-1234"), "ubuntu", "1", "eu-de-1", "image-1234"),
 	)
+
+	DescribeTable("#FindImageFromCloudProfile",
+		func(cloudProfile *gardenv1beta1.CloudProfile, imageName, version string, expectedImage string) {
+			image, err := FindImageFromCloudProfile(cloudProfile, imageName, version)
+
+			Expect(image).To(Equal(expectedImage))
+			if expectedImage != "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+
+		Entry("cloud profile is nil", nil, "ubuntu", "1", ""),
+		Entry("machine images annotation is missing", &gardenv1beta1.CloudProfile{}, "ubuntu", "1", ""),
+		Entry("machine images annotation is invalid", makeCloudProfile(`{`), "ubuntu", "1", ""),
+		Entry("entry not found (image does not exist)", makeCloudProfile(`[{"name":"debian","version":"1","cloudProfiles":[{"name":"cloud-profile","image":"image-1234"}]}]`), "ubuntu", "1", ""),
+		Entry("entry not found (version does not exist)", makeCloudProfile(`[{"name":"ubuntu","version":"2","cloudProfiles":[{"name":"cloud-profile","image":"image-1234"}]}]`), "ubuntu", "1", ""),
+		Entry("entry", makeCloudProfile(`[{"name":"ubuntu","version":"1","cloudProfiles":[{"name":"cloud-profile","image":"image-1234"}]}]`), "ubuntu", "1", "image-1234"),
+	)
 })
 
 func makeMachineImages(name, version, region, image string) []config.MachineImage {
@@ -62,3 +84,12 @@ func makeMachineImages(name, version, region, image string) []config.MachineImag
 		},
 	}
 }
+
+func makeCloudProfile(machineImages string) *gardenv1beta1.CloudProfile {
+	return &gardenv1beta1.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "cloud-profile",
+			Annotations: map[string]string{AnnotationMachineImages: machineImages},
+		},
+	}
+}
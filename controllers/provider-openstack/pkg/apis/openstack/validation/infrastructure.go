@@ -0,0 +1,43 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	cidrvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object.
+func ValidateInfrastructureConfig(infra *apisopenstack.InfrastructureConfig) field.ErrorList {
+	var (
+		allErrs      = field.ErrorList{}
+		networksPath = field.NewPath("networks")
+	)
+
+	if len(infra.FloatingPoolName) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("floatingPoolName"), "must provide the name of a floating pool"))
+	}
+
+	if infra.Networks.Router != nil && len(infra.Networks.Router.ID) == 0 {
+		allErrs = append(allErrs, field.Required(networksPath.Child("router", "id"), "must not be empty"))
+	}
+
+	workerCIDR := cidrvalidation.NewCIDR(string(infra.Networks.Worker), networksPath.Child("worker"))
+	allErrs = append(allErrs, workerCIDR.ValidateParse()...)
+
+	return allErrs
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+	mockopenstackclient "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal/mock/client"
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Actuator", func() {
+	var (
+		ctrl *gomock.Controller
+		ctx  context.Context
+
+		secretData map[string][]byte
+		be         *extensionsv1alpha1.BackupEntry
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+
+		secretData = map[string][]byte{
+			openstack.AuthURL:    []byte("https://keystone"),
+			openstack.DomainName: []byte("domain"),
+			openstack.TenantName: []byte("tenant"),
+			openstack.UserName:   []byte("user"),
+			openstack.Password:   []byte("password"),
+		}
+		be = &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar--abcde"},
+			Spec: extensionsv1alpha1.BackupEntrySpec{
+				BucketName: "bucket",
+				Region:     "eu-de-1",
+				SecretRef:  corev1.SecretReference{Namespace: "garden", Name: "backupprovider"},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#GetETCDSecretData", func() {
+		It("should add the bucket name to the secret data", func() {
+			data, err := newActuator().GetETCDSecretData(ctx, be, secretData)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(HaveKeyWithValue(openstack.BucketName, []byte("bucket")))
+			Expect(data).To(HaveKeyWithValue(openstack.AuthURL, []byte("https://keystone")))
+		})
+
+		It("should fail if the secret data does not contain an auth url", func() {
+			delete(secretData, openstack.AuthURL)
+
+			_, err := newActuator().GetETCDSecretData(ctx, be, secretData)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete all objects under the shoot prefix", func() {
+			var (
+				c             = mockclient.NewMockClient(ctrl)
+				factory       = mockopenstackclient.NewMockFactory(ctrl)
+				storageClient = mockopenstackclient.NewMockStorage(ctrl)
+				a             = &actuator{client: c, clientFactory: factory}
+			)
+
+			c.EXPECT().Get(ctx, kutil.Key("garden", "backupprovider"), gomock.AssignableToTypeOf(&corev1.Secret{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, actual *corev1.Secret) error {
+					actual.Data = secretData
+					return nil
+				})
+			factory.EXPECT().Storage(&internal.Credentials{
+				DomainName: "domain",
+				TenantName: "tenant",
+				Username:   "user",
+				Password:   "password",
+				AuthURL:    "https://keystone",
+			}, "eu-de-1").Return(storageClient, nil)
+			storageClient.EXPECT().DeleteObjectsWithPrefix(ctx, "bucket", "shoot--foo--bar--abcde/")
+
+			Expect(a.Delete(ctx, be)).To(Succeed())
+		})
+	})
+})
@@ -20,6 +20,7 @@ import (
 	"k8s.io/client-go/util/retry"
 
 	openstackv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/v1alpha1"
+	openstackclient "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal/client"
 	infrainternal "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal/infrastructure"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/infrastructure"
@@ -47,12 +48,14 @@ type actuator struct {
 	decoder runtime.Decoder
 
 	chartRenderer chartrenderer.Interface
+	clientFactory openstackclient.Factory
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled Infrastructure resources.
 func NewActuator() infrastructure.Actuator {
 	return &actuator{
-		logger: log.Log.WithName("infrastructure-actuator"),
+		logger:        log.Log.WithName("infrastructure-actuator"),
+		clientFactory: openstackclient.NewFactory(),
 	}
 }
 
@@ -17,10 +17,18 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"time"
+
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+	openstackclient "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal/client"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal/infrastructure"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
+
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/operation/terraformer"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 func (a *actuator) delete(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
@@ -34,7 +42,80 @@ func (a *actuator) delete(ctx context.Context, infra *extensionsv1alpha1.Infrast
 		return fmt.Errorf("could not create the Terraformer: %+v", err)
 	}
 
-	return tf.
-		SetVariablesEnvironment(internal.TerraformerVariablesEnvironmentFromCredentials(creds)).
-		Destroy()
+	configExists, err := tf.ConfigExists()
+	if err != nil {
+		return err
+	}
+
+	networkingClient, err := a.clientFactory.Networking(creds, infra.Spec.Region)
+	if err != nil {
+		return err
+	}
+
+	var (
+		g                              = flow.NewGraph("OpenStack infrastructure destruction")
+		destroyKubernetesLoadBalancers = g.Add(flow.Task{
+			Name: "Destroying Kubernetes load balancers",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return a.destroyKubernetesLoadBalancers(ctx, networkingClient, tf, infra.Namespace)
+			}).
+				RetryUntilTimeout(10*time.Second, 5*time.Minute).
+				DoIf(configExists),
+		})
+
+		_ = g.Add(flow.Task{
+			Name:         "Destroying Shoot infrastructure",
+			Fn:           flow.SimpleTaskFn(tf.SetVariablesEnvironment(internal.TerraformerVariablesEnvironmentFromCredentials(creds)).Destroy),
+			Dependencies: flow.NewTaskIDs(destroyKubernetesLoadBalancers),
+		})
+
+		f = g.Compile()
+	)
+
+	if err := f.Run(flow.Opts{Context: ctx}); err != nil {
+		return &controllererrors.RequeueAfterError{
+			Cause:        flow.Causes(err),
+			RequeueAfter: 30 * time.Second,
+		}
+	}
+	return nil
+}
+
+// destroyKubernetesLoadBalancers deletes the load balancers the cloud-controller-manager has created in the worker
+// subnet, together with their floating IPs. Their ports would otherwise keep the subnet and the router interface
+// from being deleted.
+//
+// Load balancers are deleted asynchronously, hence an error is returned as long as load balancers are left so that
+// the task is retried until all of them are gone.
+func (a *actuator) destroyKubernetesLoadBalancers(ctx context.Context, networkingClient openstackclient.Networking, tf *terraformer.Terraformer, clusterName string) error {
+	stateVariables, err := tf.GetStateOutputVariables(infrastructure.TerraformOutputKeySubnetID)
+	if err != nil {
+		if apierrors.IsNotFound(err) || terraformer.IsVariablesNotFoundError(err) {
+			a.logger.Info("Skipping explicit OpenStack load balancer deletion because not all variables have been found in the Terraform state.")
+			return nil
+		}
+		return err
+	}
+
+	return deleteKubernetesLoadBalancers(ctx, networkingClient, stateVariables[infrastructure.TerraformOutputKeySubnetID], clusterName)
+}
+
+// deleteKubernetesLoadBalancers deletes the load balancers of the cluster with the given name in the given subnet.
+// It returns an error as long as load balancers had to be deleted so that the caller retries until all are gone.
+func deleteKubernetesLoadBalancers(ctx context.Context, networkingClient openstackclient.Networking, subnetID, clusterName string) error {
+	loadBalancerIDs, err := networkingClient.ListKubernetesLoadBalancers(ctx, subnetID, clusterName)
+	if err != nil {
+		return err
+	}
+
+	for _, loadBalancerID := range loadBalancerIDs {
+		if err := networkingClient.DeleteLoadBalancerIfExists(ctx, loadBalancerID); err != nil {
+			return err
+		}
+	}
+
+	if len(loadBalancerIDs) > 0 {
+		return fmt.Errorf("waiting for %d load balancer(s) to be deleted", len(loadBalancerIDs))
+	}
+	return nil
 }
@@ -0,0 +1,76 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	mockopenstackclient "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal/mock/client"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Actuator", func() {
+	var (
+		ctrl *gomock.Controller
+		ctx  context.Context
+
+		networkingClient *mockopenstackclient.MockNetworking
+
+		subnetID    = "subnet"
+		clusterName = "shoot--foo--bar"
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+
+		networkingClient = mockopenstackclient.NewMockNetworking(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#deleteKubernetesLoadBalancers", func() {
+		It("should succeed if there are no load balancers", func() {
+			networkingClient.EXPECT().ListKubernetesLoadBalancers(ctx, subnetID, clusterName)
+
+			Expect(deleteKubernetesLoadBalancers(ctx, networkingClient, subnetID, clusterName)).To(Succeed())
+		})
+
+		It("should delete the load balancers and fail until they are gone", func() {
+			gomock.InOrder(
+				networkingClient.EXPECT().ListKubernetesLoadBalancers(ctx, subnetID, clusterName).Return([]string{"lb1", "lb2"}, nil),
+				networkingClient.EXPECT().DeleteLoadBalancerIfExists(ctx, "lb1"),
+				networkingClient.EXPECT().DeleteLoadBalancerIfExists(ctx, "lb2"),
+			)
+
+			Expect(deleteKubernetesLoadBalancers(ctx, networkingClient, subnetID, clusterName)).To(MatchError("waiting for 2 load balancer(s) to be deleted"))
+		})
+
+		It("should fail if a load balancer cannot be deleted", func() {
+			gomock.InOrder(
+				networkingClient.EXPECT().ListKubernetesLoadBalancers(ctx, subnetID, clusterName).Return([]string{"lb1", "lb2"}, nil),
+				networkingClient.EXPECT().DeleteLoadBalancerIfExists(ctx, "lb1").Return(fmt.Errorf("error")),
+			)
+
+			Expect(deleteKubernetesLoadBalancers(ctx, networkingClient, subnetID, clusterName)).To(MatchError("error"))
+		})
+	})
+})
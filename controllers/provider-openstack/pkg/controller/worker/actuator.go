@@ -48,7 +48,7 @@ type delegateFactory struct {
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
-func NewActuator(machineImageToCloudProfilesMapping []config.MachineImage) worker.Actuator {
+func NewActuator(machineImageToCloudProfilesMapping []config.MachineImage, deletionOptions worker.DeletionOptions) worker.Actuator {
 	delegateFactory := &delegateFactory{
 		logger:                             log.Log.WithName("worker-actuator"),
 		machineImageToCloudProfilesMapping: machineImageToCloudProfilesMapping,
@@ -60,6 +60,7 @@ func NewActuator(machineImageToCloudProfilesMapping []config.MachineImage) worke
 		mcmChart,
 		mcmShootChart,
 		imagevector.ImageVector(),
+		deletionOptions,
 	)
 }
 
@@ -36,6 +36,8 @@ type AddOptions struct {
 	Controller controller.Options
 	// MachineImagesToCloudProfilesMapping is the default mapping from machine images to cloud profiles.
 	MachineImagesToCloudProfilesMapping []config.MachineImage
+	// Deletion are the options for deleting the machines of a worker.
+	Deletion worker.DeletionOptions
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -50,7 +52,7 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 	}
 
 	return worker.Add(mgr, worker.AddArgs{
-		Actuator:          NewActuator(opts.MachineImagesToCloudProfilesMapping),
+		Actuator:          NewActuator(opts.MachineImagesToCloudProfilesMapping, opts.Deletion),
 		ControllerOptions: opts.Controller,
 		Predicates:        worker.DefaultPredicates(mgr.GetClient(), openstack.Type),
 	})
@@ -28,6 +28,7 @@ import (
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -104,10 +105,19 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	podNetwork, err := extensionscontroller.GetPodNetwork(w.cluster.Shoot)
+	if err != nil {
+		return err
+	}
+
+	machineTypes := w.cloudProfileMachineTypes()
+	excludedZones := worker.ExcludedZonesFromAnnotations(w.worker.Annotations)
+
 	for _, pool := range w.worker.Spec.Pools {
-		zoneLen := len(pool.Zones)
+		// Worker pools do not have a provider config yet, hence their zones cannot be weighted.
+		zoneDistribution := worker.NewZoneDistribution(pool.Zones, nil, excludedZones)
 
-		machineImage, err := confighelper.FindImageForCloudProfile(w.machineImageToCloudProfilesMapping, pool.MachineImage.Name, pool.MachineImage.Version, w.cluster.CloudProfile.Name)
+		machineImage, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version)
 		if err != nil {
 			return err
 		}
@@ -120,7 +130,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				"keyName":          infrastructureStatus.Node.KeyName,
 				"imageName":        machineImage,
 				"networkID":        infrastructureStatus.Networks.ID,
-				"podNetworkCidr":   extensionscontroller.GetPodNetwork(w.cluster.Shoot),
+				"podNetworkCidr":   podNetwork,
 				"securityGroups":   []string{nodesSecurityGroup.Name},
 				"tags": map[string]string{
 					fmt.Sprintf("kubernetes.io-cluster-%s", w.worker.Namespace): "1",
@@ -137,17 +147,26 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 			)
 
+			minimum, maximum := zoneDistribution.DistributeMinMax(zoneIndex, pool.Minimum, pool.Maximum)
+
 			machineDeployments = append(machineDeployments, worker.MachineDeployment{
 				Name:           deploymentName,
 				ClassName:      className,
 				SecretName:     className,
-				Minimum:        worker.DistributeOverZones(zoneIndex, pool.Minimum, zoneLen),
-				Maximum:        worker.DistributeOverZones(zoneIndex, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, zoneLen, pool.Minimum),
+				Minimum:        minimum,
+				Maximum:        maximum,
+				MaxSurge:       zoneDistribution.DistributePositiveIntOrPercent(zoneIndex, pool.MaxSurge, pool.Maximum),
+				MaxUnavailable: zoneDistribution.DistributePositiveIntOrPercent(zoneIndex, pool.MaxUnavailable, pool.Minimum),
 				Labels:         pool.Labels,
 				Annotations:    pool.Annotations,
 				Taints:         pool.Taints,
+				NodeTemplate:   worker.NodeTemplateForMachineType(machineTypes, pool.MachineType, w.worker.Spec.Region, zone),
+				MachineImage: worker.MachineImage{
+					Pool:    pool.Name,
+					Name:    pool.MachineImage.Name,
+					Version: pool.MachineImage.Version,
+					ID:      machineImage,
+				},
 			})
 
 			machineClassSpec["name"] = className
@@ -166,3 +185,30 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+// cloudProfileMachineTypes returns the machine types of the CloudProfile which are used to compute the node
+// templates of the machine deployments.
+func (w *workerDelegate) cloudProfileMachineTypes() []gardenv1beta1.MachineType {
+	if w.cluster.CloudProfile == nil || w.cluster.CloudProfile.Spec.OpenStack == nil {
+		return nil
+	}
+
+	var machineTypes []gardenv1beta1.MachineType
+	for _, machineType := range w.cluster.CloudProfile.Spec.OpenStack.Constraints.MachineTypes {
+		machineTypes = append(machineTypes, machineType.MachineType)
+	}
+	return machineTypes
+}
+
+// findMachineImage returns the image of the given machine image. The versioned machine images of the CloudProfile take
+// precedence over the ones of the controller configuration.
+func (w *workerDelegate) findMachineImage(name, version string) (string, error) {
+	image, err := confighelper.FindImageFromCloudProfile(w.cluster.CloudProfile, name, version)
+	if err == nil {
+		return image, nil
+	}
+	if image, configErr := confighelper.FindImageForCloudProfile(w.machineImageToCloudProfilesMapping, name, version, w.cluster.CloudProfile.Name); configErr == nil {
+		return image, nil
+	}
+	return "", err
+}
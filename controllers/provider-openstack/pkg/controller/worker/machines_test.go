@@ -347,6 +347,11 @@ var _ = Describe("Machines", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				// Test workerDelegate.GenerateMachineDeployments()
+				var (
+					machineImagePool1 = worker.MachineImage{Pool: namePool1, Name: machineImageName, Version: machineImageVersion, ID: machineImage}
+					machineImagePool2 = worker.MachineImage{Pool: namePool2, Name: machineImageName, Version: machineImageVersion, ID: machineImage}
+				)
+
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1Zone1,
@@ -356,6 +361,7 @@ var _ = Describe("Machines", func() {
 						Maximum:        worker.DistributeOverZones(0, maxPool1, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool1, 2, maxPool1),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool1, 2, minPool1),
+						MachineImage:   machineImagePool1,
 					},
 					{
 						Name:           machineClassNamePool1Zone2,
@@ -365,6 +371,7 @@ var _ = Describe("Machines", func() {
 						Maximum:        worker.DistributeOverZones(1, maxPool1, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool1, 2, maxPool1),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool1, 2, minPool1),
+						MachineImage:   machineImagePool1,
 					},
 					{
 						Name:           machineClassNamePool2Zone1,
@@ -374,6 +381,7 @@ var _ = Describe("Machines", func() {
 						Maximum:        worker.DistributeOverZones(0, maxPool2, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(0, maxSurgePool2, 2, maxPool2),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(0, maxUnavailablePool2, 2, minPool2),
+						MachineImage:   machineImagePool2,
 					},
 					{
 						Name:           machineClassNamePool2Zone2,
@@ -383,6 +391,7 @@ var _ = Describe("Machines", func() {
 						Maximum:        worker.DistributeOverZones(1, maxPool2, 2),
 						MaxSurge:       worker.DistributePositiveIntOrPercent(1, maxSurgePool2, 2, maxPool2),
 						MaxUnavailable: worker.DistributePositiveIntOrPercent(1, maxUnavailablePool2, 2, minPool2),
+						MachineImage:   machineImagePool2,
 					},
 				}
 
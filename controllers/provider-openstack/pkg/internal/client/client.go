@@ -0,0 +1,212 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+type factory struct{}
+
+type storageClient struct {
+	client *gophercloud.ServiceClient
+}
+
+type networkingClient struct {
+	network      *gophercloud.ServiceClient
+	loadBalancer *gophercloud.ServiceClient
+}
+
+// NewFactory returns a new factory for OpenStack clients.
+func NewFactory() Factory {
+	return &factory{}
+}
+
+func newProviderClient(credentials *internal.Credentials) (*gophercloud.ProviderClient, error) {
+	if len(credentials.AuthURL) == 0 {
+		return nil, fmt.Errorf("credentials do not contain an auth url")
+	}
+
+	return openstack.AuthenticatedClient(gophercloud.AuthOptions{
+		IdentityEndpoint: credentials.AuthURL,
+		DomainName:       credentials.DomainName,
+		TenantName:       credentials.TenantName,
+		Username:         credentials.Username,
+		Password:         credentials.Password,
+	})
+}
+
+// Storage implements Factory.
+func (f *factory) Storage(credentials *internal.Credentials, region string) (Storage, error) {
+	provider, err := newProviderClient(credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := openstack.NewObjectStorageV1(provider, gophercloud.EndpointOpts{Region: region})
+	if err != nil {
+		return nil, err
+	}
+
+	return &storageClient{client}, nil
+}
+
+// Networking implements Factory.
+func (f *factory) Networking(credentials *internal.Credentials, region string) (Networking, error) {
+	provider, err := newProviderClient(credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	network, err := openstack.NewNetworkV2(provider, gophercloud.EndpointOpts{Region: region})
+	if err != nil {
+		return nil, err
+	}
+
+	loadBalancer, err := openstack.NewLoadBalancerV2(provider, gophercloud.EndpointOpts{Region: region})
+	if err != nil {
+		return nil, err
+	}
+
+	return &networkingClient{network, loadBalancer}, nil
+}
+
+// CreateContainerIfNotExists implements Storage.
+func (s *storageClient) CreateContainerIfNotExists(_ context.Context, container string) error {
+	// Creating a container is idempotent in Swift.
+	_, err := containers.Create(s.client, container, containers.CreateOpts{}).Extract()
+	return err
+}
+
+// DeleteContainerIfExists implements Storage.
+func (s *storageClient) DeleteContainerIfExists(ctx context.Context, container string) error {
+	if err := s.DeleteObjectsWithPrefix(ctx, container, ""); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	if _, err := containers.Delete(s.client, container).Extract(); err != nil && !isNotFoundError(err) {
+		return err
+	}
+	return nil
+}
+
+// DeleteObjectsWithPrefix implements Storage.
+func (s *storageClient) DeleteObjectsWithPrefix(_ context.Context, container, prefix string) error {
+	var objectNames []string
+	if err := objects.List(s.client, container, objects.ListOpts{Prefix: prefix}).EachPage(func(page pagination.Page) (bool, error) {
+		names, err := objects.ExtractNames(page)
+		if err != nil {
+			return false, err
+		}
+		objectNames = append(objectNames, names...)
+		return true, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, name := range objectNames {
+		if _, err := objects.Delete(s.client, container, name, nil).Extract(); err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListKubernetesLoadBalancers implements Networking.
+//
+// The OpenStack cloud-controller-manager names the load balancers it creates `kube_service_<cluster-name>_<namespace>_<name>`.
+func (n *networkingClient) ListKubernetesLoadBalancers(_ context.Context, subnetID, clusterName string) ([]string, error) {
+	var (
+		prefix = fmt.Sprintf("kube_service_%s_", clusterName)
+		ids    []string
+	)
+
+	if err := loadbalancers.List(n.loadBalancer, loadbalancers.ListOpts{VipSubnetID: subnetID}).EachPage(func(page pagination.Page) (bool, error) {
+		loadBalancers, err := loadbalancers.ExtractLoadBalancers(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, loadBalancer := range loadBalancers {
+			if strings.HasPrefix(loadBalancer.Name, prefix) {
+				ids = append(ids, loadBalancer.ID)
+			}
+		}
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// DeleteLoadBalancerIfExists implements Networking.
+func (n *networkingClient) DeleteLoadBalancerIfExists(_ context.Context, id string) error {
+	loadBalancer, err := loadbalancers.Get(n.loadBalancer, id).Extract()
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	var floatingIPIDs []string
+	if err := floatingips.List(n.network, floatingips.ListOpts{PortID: loadBalancer.VipPortID}).EachPage(func(page pagination.Page) (bool, error) {
+		floatingIPs, err := floatingips.ExtractFloatingIPs(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, floatingIP := range floatingIPs {
+			floatingIPIDs = append(floatingIPIDs, floatingIP.ID)
+		}
+		return true, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, floatingIPID := range floatingIPIDs {
+		if err := floatingips.Delete(n.network, floatingIPID).ExtractErr(); err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+
+	if err := loadbalancers.Delete(n.loadBalancer, id, loadbalancers.DeleteOpts{Cascade: true}).ExtractErr(); err != nil && !isNotFoundError(err) {
+		return err
+	}
+	return nil
+}
+
+func isNotFoundError(err error) bool {
+	switch err.(type) {
+	case gophercloud.ErrDefault404, *gophercloud.ErrDefault404:
+		return true
+	}
+	return false
+}
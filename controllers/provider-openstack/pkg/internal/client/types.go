@@ -0,0 +1,50 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+)
+
+// Factory creates the OpenStack clients for the different services.
+type Factory interface {
+	// Storage creates a new object storage (Swift) client for the given credentials and region.
+	Storage(credentials *internal.Credentials, region string) (Storage, error)
+	// Networking creates a new networking (Neutron and Octavia) client for the given credentials and region.
+	Networking(credentials *internal.Credentials, region string) (Networking, error)
+}
+
+// Storage is the interface for an OpenStack object storage (Swift) client.
+type Storage interface {
+	// CreateContainerIfNotExists creates the container with the given name. If it already exists, no error is returned.
+	CreateContainerIfNotExists(ctx context.Context, container string) error
+	// DeleteContainerIfExists deletes the container with the given name including all of its objects.
+	// If it does not exist, no error is returned.
+	DeleteContainerIfExists(ctx context.Context, container string) error
+	// DeleteObjectsWithPrefix deletes all objects in the given container whose names start with the given prefix.
+	DeleteObjectsWithPrefix(ctx context.Context, container, prefix string) error
+}
+
+// Networking is the interface for an OpenStack networking (Neutron and Octavia) client.
+type Networking interface {
+	// ListKubernetesLoadBalancers lists the IDs of all load balancers in the given subnet that have been created by
+	// the OpenStack cloud-controller-manager of the given cluster.
+	ListKubernetesLoadBalancers(ctx context.Context, subnetID, clusterName string) ([]string, error)
+	// DeleteLoadBalancerIfExists deletes the load balancer with the given ID including its listeners and pools, and
+	// releases the floating IPs associated with its VIP port. If it does not exist, no error is returned.
+	DeleteLoadBalancerIfExists(ctx context.Context, id string) error
+}
@@ -32,6 +32,10 @@ type Credentials struct {
 	TenantName string
 	Username   string
 	Password   string
+
+	// AuthURL is the optional identity endpoint. It is only contained in secrets which are used without
+	// a cloud profile, e.g. backup secrets.
+	AuthURL string
 }
 
 // GetCredentials computes for a given context and infrastructure the corresponding credentials object.
@@ -79,6 +83,7 @@ func ExtractCredentials(secret *corev1.Secret) (*Credentials, error) {
 		TenantName: tenantName,
 		Username:   userName,
 		Password:   password,
+		AuthURL:    string(secret.Data[openstack.AuthURL]),
 	}, nil
 }
 
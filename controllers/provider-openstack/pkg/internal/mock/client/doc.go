@@ -0,0 +1,3 @@
+//go:generate mockgen -package=client -destination=mocks.go github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal/client Factory,Storage,Networking
+
+package client
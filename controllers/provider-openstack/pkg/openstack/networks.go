@@ -0,0 +1,39 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openstack
+
+import (
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+)
+
+func init() {
+	extensionscontroller.RegisterShootNetworksAccessor(Type, ShootNetworks)
+}
+
+// ShootNetworks returns the networks and zones of the given OpenStack cloud specification of a Shoot.
+func ShootNetworks(cloud *gardenv1beta1.Cloud) (*extensionscontroller.ShootNetworks, bool) {
+	if cloud.OpenStack == nil {
+		return nil, false
+	}
+
+	return &extensionscontroller.ShootNetworks{
+		Pods:     cloud.OpenStack.Networks.Pods,
+		Services: cloud.OpenStack.Networks.Services,
+		Nodes:    cloud.OpenStack.Networks.Nodes,
+		Zones:    cloud.OpenStack.Zones,
+	}, true
+}
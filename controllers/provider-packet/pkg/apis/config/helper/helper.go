@@ -15,9 +15,13 @@
 package helper
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config"
+	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config/v1alpha1"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 )
 
 // FindImage takes a list of machine images, and the desired image name and version. It tries
@@ -32,3 +36,44 @@ func FindImage(machineImages []config.MachineImage, imageName, version string) (
 
 	return "", fmt.Errorf("could not find an image for name %q in version %q", imageName, version)
 }
+
+// AnnotationMachineImages is the annotation on a CloudProfile containing a versioned mapping of machine images to
+// image IDs in the format of the `machineImages` of the controller configuration. The Packet section of the CloudProfile
+// does not version its machine images, hence it cannot serve this purpose.
+const AnnotationMachineImages = "packet.provider.extensions.gardener.cloud/machine-images"
+
+// MachineImagesFromCloudProfile decodes the versioned machine images of the given CloudProfile (see
+// AnnotationMachineImages). It returns nil if the CloudProfile has none.
+func MachineImagesFromCloudProfile(cloudProfile *gardenv1beta1.CloudProfile) ([]config.MachineImage, error) {
+	if cloudProfile == nil {
+		return nil, nil
+	}
+	data, ok := cloudProfile.Annotations[AnnotationMachineImages]
+	if !ok {
+		return nil, nil
+	}
+
+	var versionedMachineImages []v1alpha1.MachineImage
+	if err := json.Unmarshal([]byte(data), &versionedMachineImages); err != nil {
+		return nil, fmt.Errorf("could not decode the machine images of cloud profile %q: %v", cloudProfile.Name, err)
+	}
+
+	machineImages := make([]config.MachineImage, len(versionedMachineImages))
+	for i := range versionedMachineImages {
+		if err := v1alpha1.Convert_v1alpha1_MachineImage_To_config_MachineImage(&versionedMachineImages[i], &machineImages[i], nil); err != nil {
+			return nil, err
+		}
+	}
+	return machineImages, nil
+}
+
+// FindImageFromCloudProfile takes a CloudProfile, an image name and a version and tries to find the image ID of the
+// machine image in the versioned machine images of the CloudProfile (see AnnotationMachineImages). If it cannot be
+// found then an error is returned.
+func FindImageFromCloudProfile(cloudProfile *gardenv1beta1.CloudProfile, imageName, version string) (string, error) {
+	machineImages, err := MachineImagesFromCloudProfile(cloudProfile)
+	if err != nil {
+		return "", err
+	}
+	return FindImage(machineImages, imageName, version)
+}
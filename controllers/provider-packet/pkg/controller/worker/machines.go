@@ -25,6 +25,7 @@ import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -98,8 +99,10 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	machineTypes := w.cloudProfileMachineTypes()
+
 	for _, pool := range w.worker.Spec.Pools {
-		machineImage, err := confighelper.FindImage(w.machineImages, pool.MachineImage.Name, pool.MachineImage.Version)
+		machineImage, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version)
 		if err != nil {
 			return err
 		}
@@ -137,6 +140,13 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			Labels:         pool.Labels,
 			Annotations:    pool.Annotations,
 			Taints:         pool.Taints,
+			NodeTemplate:   worker.NodeTemplateForMachineType(machineTypes, pool.MachineType, "", ""),
+			MachineImage: worker.MachineImage{
+				Pool:    pool.Name,
+				Name:    pool.MachineImage.Name,
+				Version: pool.MachineImage.Version,
+				ID:      machineImage,
+			},
 		})
 
 		machineClassSpec["name"] = className
@@ -150,3 +160,25 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	return nil
 }
+
+// cloudProfileMachineTypes returns the machine types of the CloudProfile which are used to compute the node
+// templates of the machine deployments.
+func (w *workerDelegate) cloudProfileMachineTypes() []gardenv1beta1.MachineType {
+	if w.cluster.CloudProfile == nil || w.cluster.CloudProfile.Spec.Packet == nil {
+		return nil
+	}
+	return w.cluster.CloudProfile.Spec.Packet.Constraints.MachineTypes
+}
+
+// findMachineImage returns the image of the given machine image. The versioned machine images of the CloudProfile take
+// precedence over the ones of the controller configuration.
+func (w *workerDelegate) findMachineImage(name, version string) (string, error) {
+	image, err := confighelper.FindImageFromCloudProfile(w.cluster.CloudProfile, name, version)
+	if err == nil {
+		return image, nil
+	}
+	if image, configErr := confighelper.FindImage(w.machineImages, name, version); configErr == nil {
+		return image, nil
+	}
+	return "", err
+}
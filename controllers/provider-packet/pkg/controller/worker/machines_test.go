@@ -287,6 +287,11 @@ var _ = Describe("Machines", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				// Test workerDelegate.GenerateMachineDeployments()
+				var (
+					machineImagePool1 = worker.MachineImage{Pool: namePool1, Name: machineImageName, Version: machineImageVersion, ID: machineImage}
+					machineImagePool2 = worker.MachineImage{Pool: namePool2, Name: machineImageName, Version: machineImageVersion, ID: machineImage}
+				)
+
 				machineDeployments := worker.MachineDeployments{
 					{
 						Name:           machineClassNamePool1,
@@ -296,6 +301,7 @@ var _ = Describe("Machines", func() {
 						Maximum:        maxPool1,
 						MaxSurge:       maxSurgePool1,
 						MaxUnavailable: maxUnavailablePool1,
+						MachineImage:   machineImagePool1,
 					},
 					{
 						Name:           machineClassNamePool2,
@@ -305,6 +311,7 @@ var _ = Describe("Machines", func() {
 						Maximum:        maxPool2,
 						MaxSurge:       maxSurgePool2,
 						MaxUnavailable: maxUnavailablePool2,
+						MachineImage:   machineImagePool2,
 					},
 				}
 
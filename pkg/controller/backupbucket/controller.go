@@ -16,6 +16,7 @@ package backupbucket
 
 import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/metrics"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
 	corev1 "k8s.io/api/core/v1"
@@ -57,6 +58,7 @@ func DefaultPredicates(mgr manager.Manager) []predicate.Predicate {
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
 	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
+	extensionsmetrics.AddObjectsInErrorStateKind("BackupBucket", mgr.GetClient(), &extensionsv1alpha1.BackupBucketList{})
 	return add(mgr, args.ControllerOptions, args.Predicates)
 }
 
@@ -15,55 +15,17 @@
 package backupbucket
 
 import (
-	"context"
-
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	extensions1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
-	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
-type secretToBackupBucketMapper struct {
-	client     client.Client
-	predicates []predicate.Predicate
-}
-
-func (m *secretToBackupBucketMapper) Map(obj handler.MapObject) []reconcile.Request {
-	if obj.Object == nil {
-		return nil
-	}
-
-	secret, ok := obj.Object.(*corev1.Secret)
-	if !ok {
-		return nil
-	}
-
-	backupBucketList := &extensions1alpha1.BackupBucketList{}
-	if err := m.client.List(context.TODO(), client.MatchingField("spec.secretRef.name", secret.Name).MatchingField("spec.secretRef.namespace", secret.Namespace), backupBucketList); err != nil {
-		return nil
-	}
-
-	var requests []reconcile.Request
-	for _, backupBucket := range backupBucketList.Items {
-		if !extensionscontroller.EvalGenericPredicate(&backupBucket, m.predicates...) {
-			continue
-		}
-		requests = append(requests, reconcile.Request{
-			NamespacedName: types.NamespacedName{
-				Name: backupBucket.Name,
-			},
-		})
-	}
-	return requests
-}
-
-// SecretToBackupBucketMapper returns a mapper that returns requests for BackupBucket whose
+// SecretToBackupBucketMapper returns a mapper that returns requests for BackupBuckets whose
 // referenced secrets have been modified.
 func SecretToBackupBucketMapper(client client.Client, predicates []predicate.Predicate) handler.Mapper {
-	return &secretToBackupBucketMapper{client, predicates}
+	return extensionscontroller.ClusterScopedSecretToObjectMapper(client, func() runtime.Object { return &extensions1alpha1.BackupBucketList{} }, predicates)
 }
@@ -17,8 +17,10 @@ package backupbucket
 import (
 	"context"
 	"fmt"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -96,6 +98,11 @@ func (r *reconciler) reconcile(ctx context.Context, bb *extensionsv1alpha1.Backu
 		return reconcile.Result{}, err
 	}
 
+	secretChecksum, err := extensionscontroller.GetReferencedSecretChecksum(ctx, r.client, bb)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	operationType := gardencorev1alpha1helper.ComputeOperationType(bb.ObjectMeta, bb.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, bb, operationType, "Reconciling the backupbucket"); err != nil {
 		return reconcile.Result{}, err
@@ -103,7 +110,10 @@ func (r *reconciler) reconcile(ctx context.Context, bb *extensionsv1alpha1.Backu
 
 	r.logger.Info("Starting the reconciliation of backupbucket", "backupbucket", bb.Name)
 	r.recorder.Event(bb, corev1.EventTypeNormal, EventBackupBucketReconciliation, "Reconciling the backupbucket")
-	if err := r.actuator.Reconcile(ctx, bb); err != nil {
+	startTime := time.Now()
+	err = r.actuator.Reconcile(ctx, bb)
+	extensionsmetrics.RecordOperation("BackupBucket", bb.Spec.Type, operationType, startTime, err)
+	if err != nil {
 		msg := "Error reconciling backupbucket"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), bb, operationType, msg)
 		r.logger.Error(err, msg, "backupbucket", bb.Name)
@@ -116,6 +126,9 @@ func (r *reconciler) reconcile(ctx context.Context, bb *extensionsv1alpha1.Backu
 	if err := r.updateStatusSuccess(ctx, bb, operationType, msg); err != nil {
 		return reconcile.Result{}, err
 	}
+	if err := extensionscontroller.EnsureSecretChecksumAnnotation(ctx, r.client, bb, secretChecksum); err != nil {
+		return reconcile.Result{}, err
+	}
 
 	return reconcile.Result{}, nil
 }
@@ -138,7 +151,10 @@ func (r *reconciler) delete(ctx context.Context, bb *extensionsv1alpha1.BackupBu
 
 	r.logger.Info("Starting the deletion of backupbucket", "backupbucket", bb.Name)
 	r.recorder.Event(bb, corev1.EventTypeNormal, EventBackupBucketDeletion, "Deleting the backupbucket")
-	if err := r.actuator.Delete(r.ctx, bb); err != nil {
+	startTime := time.Now()
+	err = r.actuator.Delete(r.ctx, bb)
+	extensionsmetrics.RecordOperation("BackupBucket", bb.Spec.Type, operationType, startTime, err)
+	if err != nil {
 		msg := "Error deleting backupbucket"
 		r.recorder.Eventf(bb, corev1.EventTypeWarning, EventBackupBucketDeletion, "%s: %+v", msg, err)
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), bb, operationType, msg)
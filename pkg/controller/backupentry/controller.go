@@ -16,6 +16,7 @@ package backupentry
 
 import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/metrics"
 	corev1 "k8s.io/api/core/v1"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -57,6 +58,7 @@ func DefaultPredicates(mgr manager.Manager) []predicate.Predicate {
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
 	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
+	extensionsmetrics.AddObjectsInErrorStateKind("BackupEntry", mgr.GetClient(), &extensionsv1alpha1.BackupEntryList{})
 	return add(mgr, args.ControllerOptions, args.Predicates)
 }
 
@@ -17,8 +17,10 @@ package backupentry
 import (
 	"context"
 	"fmt"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -103,7 +105,10 @@ func (r *reconciler) reconcile(ctx context.Context, be *extensionsv1alpha1.Backu
 
 	r.logger.Info("Starting the reconciliation of backupentry", "backupentry", be.Name)
 	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryReconciliation, "Reconciling the backupentry")
-	if err := r.actuator.Reconcile(ctx, be); err != nil {
+	startTime := time.Now()
+	err := r.actuator.Reconcile(ctx, be)
+	extensionsmetrics.RecordOperation("BackupEntry", be.Spec.Type, operationType, startTime, err)
+	if err != nil {
 		msg := "Error reconciling backupentry"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), be, operationType, msg)
 		r.logger.Error(err, msg, "backupentry", be.Name)
@@ -138,7 +143,10 @@ func (r *reconciler) delete(ctx context.Context, be *extensionsv1alpha1.BackupEn
 
 	r.logger.Info("Starting the deletion of backupentry", "backupentry", be.Name)
 	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryDeletion, "Deleting the backupentry")
-	if err := r.actuator.Delete(r.ctx, be); err != nil {
+	startTime := time.Now()
+	err = r.actuator.Delete(r.ctx, be)
+	extensionsmetrics.RecordOperation("BackupEntry", be.Spec.Type, operationType, startTime, err)
+	if err != nil {
 		msg := "Error deleting backupentry"
 		r.recorder.Eventf(be, corev1.EventTypeWarning, EventBackupEntryDeletion, "%s: %+v", msg, err)
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), be, operationType, msg)
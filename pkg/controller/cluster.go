@@ -20,10 +20,10 @@ import (
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
-	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -36,13 +36,36 @@ type Cluster struct {
 	Shoot        *gardenv1beta1.Shoot
 }
 
-// GetCluster tries to read Gardener's Cluster extension resource in the given namespace.
-func GetCluster(ctx context.Context, c client.Client, namespace string) (*Cluster, error) {
-	cluster := &extensionsv1alpha1.Cluster{}
-	if err := c.Get(ctx, kutil.Key(namespace), cluster); err != nil {
-		return nil, err
+// DeepCopy returns a deep copy of the Cluster.
+func (c *Cluster) DeepCopy() *Cluster {
+	if c == nil {
+		return nil
 	}
+	return &Cluster{
+		CloudProfile: c.CloudProfile.DeepCopy(),
+		Seed:         c.Seed.DeepCopy(),
+		Shoot:        c.Shoot.DeepCopy(),
+	}
+}
 
+// gardenDecoder is the decoder for the Gardener resources inside the Cluster resource. It is safe for concurrent use.
+var gardenDecoder runtime.Decoder
+
+func init() {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(gardenv1beta1.AddToScheme(scheme))
+	gardenDecoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+}
+
+// GetCluster tries to read Gardener's Cluster extension resource in the given namespace. The decoded resources are
+// served from the DefaultClusterCache as long as the Cluster resource has not changed. The returned Cluster is shared
+// and must not be modified, callers which want to modify it have to copy it first.
+func GetCluster(ctx context.Context, c client.Client, namespace string) (*Cluster, error) {
+	return DefaultClusterCache.Get(ctx, c, namespace)
+}
+
+// DecodeCluster decodes the resources inside the given Cluster resource.
+func DecodeCluster(cluster *extensionsv1alpha1.Cluster) (*Cluster, error) {
 	cloudProfile, err := CloudProfileFromCluster(cluster)
 	if err != nil {
 		return nil, err
@@ -61,37 +84,22 @@ func GetCluster(ctx context.Context, c client.Client, namespace string) (*Cluste
 
 // CloudProfileFromCluster returns the CloudProfile resource inside the Cluster resource.
 func CloudProfileFromCluster(cluster *extensionsv1alpha1.Cluster) (*gardenv1beta1.CloudProfile, error) {
-	decoder, err := newGardenDecoder()
-	if err != nil {
-		return nil, err
-	}
-
 	cloudProfile := &gardenv1beta1.CloudProfile{}
-	_, _, err = decoder.Decode(cluster.Spec.CloudProfile.Raw, nil, cloudProfile)
+	_, _, err := gardenDecoder.Decode(cluster.Spec.CloudProfile.Raw, nil, cloudProfile)
 	return cloudProfile, err
 }
 
 // SeedFromCluster returns the Seed resource inside the Cluster resource.
 func SeedFromCluster(cluster *extensionsv1alpha1.Cluster) (*gardenv1beta1.Seed, error) {
-	decoder, err := newGardenDecoder()
-	if err != nil {
-		return nil, err
-	}
-
 	seed := &gardenv1beta1.Seed{}
-	_, _, err = decoder.Decode(cluster.Spec.Seed.Raw, nil, seed)
+	_, _, err := gardenDecoder.Decode(cluster.Spec.Seed.Raw, nil, seed)
 	return seed, err
 }
 
 // ShootFromCluster returns the Shoot resource inside the Cluster resource.
 func ShootFromCluster(cluster *extensionsv1alpha1.Cluster) (*gardenv1beta1.Shoot, error) {
-	decoder, err := newGardenDecoder()
-	if err != nil {
-		return nil, err
-	}
-
 	shoot := &gardenv1beta1.Shoot{}
-	_, _, err = decoder.Decode(cluster.Spec.Shoot.Raw, nil, shoot)
+	_, _, err := gardenDecoder.Decode(cluster.Spec.Shoot.Raw, nil, shoot)
 	return shoot, err
 }
 
@@ -100,9 +108,3 @@ func ShootIsFailed(shoot *gardenv1beta1.Shoot) bool {
 	lastOperation := shoot.Status.LastOperation
 	return lastOperation != nil && lastOperation.State == gardencorev1alpha1.LastOperationStateFailed && shoot.Generation == shoot.Status.ObservedGeneration
 }
-
-func newGardenDecoder() (runtime.Decoder, error) {
-	scheme := runtime.NewScheme()
-	decoder := serializer.NewCodecFactory(scheme).UniversalDecoder()
-	return decoder, gardenv1beta1.AddToScheme(scheme)
-}
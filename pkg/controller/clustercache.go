@@ -0,0 +1,161 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"sync"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	toolscache "k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// ClusterCache caches the decoded resources of Gardener's Cluster extension resources. The entries are keyed by the
+// name of the Cluster resource (which equals the namespace of the Shoot in the Seed) and are only served as long as
+// the resource version of the Cluster resource did not change. The decoded resources are shared between all callers
+// and must be treated as read-only, callers which want to modify them have to copy them first.
+type ClusterCache struct {
+	lock    sync.RWMutex
+	entries map[string]clusterCacheEntry
+}
+
+type clusterCacheEntry struct {
+	resourceVersion string
+	cluster         *Cluster
+}
+
+// NewClusterCache creates a new, empty ClusterCache.
+func NewClusterCache() *ClusterCache {
+	return &ClusterCache{entries: make(map[string]clusterCacheEntry)}
+}
+
+// DefaultClusterCache is the ClusterCache used by GetCluster.
+var DefaultClusterCache = NewClusterCache()
+
+// Get reads Gardener's Cluster extension resource in the given namespace and returns its decoded resources. The
+// resources are only decoded if they are not yet cached for the current resource version of the Cluster resource.
+// The returned Cluster is shared and must not be modified.
+func (c *ClusterCache) Get(ctx context.Context, cl client.Client, namespace string) (*Cluster, error) {
+	cluster := &extensionsv1alpha1.Cluster{}
+	if err := cl.Get(ctx, kutil.Key(namespace), cluster); err != nil {
+		return nil, err
+	}
+
+	return c.Decode(cluster)
+}
+
+// Decode returns the decoded resources of the given Cluster resource. The resources are only decoded if they are not
+// yet cached for the resource version of the given Cluster resource. The returned Cluster is shared and must not be
+// modified.
+func (c *ClusterCache) Decode(cluster *extensionsv1alpha1.Cluster) (*Cluster, error) {
+	return c.decode(cluster, true)
+}
+
+// decodeWithoutStoring returns the decoded resources of the given Cluster resource like Decode, but does not replace
+// the cached resources if they are for another resource version. It is meant for outdated Cluster resources, e.g. the
+// old objects of update events, which must not evict the resources of the current one.
+func (c *ClusterCache) decodeWithoutStoring(cluster *extensionsv1alpha1.Cluster) (*Cluster, error) {
+	return c.decode(cluster, false)
+}
+
+func (c *ClusterCache) decode(cluster *extensionsv1alpha1.Cluster, store bool) (*Cluster, error) {
+	resourceVersion := cluster.ResourceVersion
+
+	if resourceVersion != "" {
+		c.lock.RLock()
+		entry, ok := c.entries[cluster.Name]
+		c.lock.RUnlock()
+
+		if ok && entry.resourceVersion == resourceVersion {
+			return entry.cluster, nil
+		}
+	}
+
+	decoded, err := DecodeCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if store && resourceVersion != "" {
+		c.lock.Lock()
+		c.entries[cluster.Name] = clusterCacheEntry{resourceVersion, decoded}
+		c.lock.Unlock()
+	}
+
+	return decoded, nil
+}
+
+// Delete removes the cached resources of the Cluster resource with the given name.
+func (c *ClusterCache) Delete(name string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.entries, name)
+}
+
+// Len returns the number of cached Cluster resources.
+func (c *ClusterCache) Len() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return len(c.entries)
+}
+
+// AddToManager registers an event handler on the manager's Cluster informer that keeps the cache up to date: Added
+// and updated Cluster resources are decoded eagerly, deleted ones are evicted.
+func (c *ClusterCache) AddToManager(mgr manager.Manager) error {
+	informer, err := mgr.GetCache().GetInformer(&extensionsv1alpha1.Cluster{})
+	if err != nil {
+		return err
+	}
+
+	informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc: c.update,
+		UpdateFunc: func(_, newObj interface{}) {
+			c.update(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if cluster, ok := obj.(*extensionsv1alpha1.Cluster); ok {
+				c.Delete(cluster.Name)
+			}
+		},
+	})
+	return nil
+}
+
+func (c *ClusterCache) update(obj interface{}) {
+	cluster, ok := obj.(*extensionsv1alpha1.Cluster)
+	if !ok {
+		return
+	}
+
+	if _, err := c.Decode(cluster); err != nil {
+		// Drop a stale entry, GetCluster will report the decoding error to its caller.
+		c.Delete(cluster.Name)
+	}
+}
+
+// AddClusterCacheToManager adds the DefaultClusterCache to the given manager.
+func AddClusterCacheToManager(mgr manager.Manager) error {
+	return DefaultClusterCache.AddToManager(mgr)
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("ClusterCache", func() {
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+
+		cache *ClusterCache
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+
+		cache = NewClusterCache()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#Decode", func() {
+		It("should only decode the resources once per resource version", func() {
+			cluster := newCluster("shoot--foo--bar", "1", "foo")
+
+			decoded, err := cache.Decode(cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded.Shoot.Name).To(Equal("foo"))
+			Expect(cache.Len()).To(Equal(1))
+
+			cluster.Spec.Shoot = encodeGardenObject(newShoot("bar"))
+
+			decoded, err = cache.Decode(cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded.Shoot.Name).To(Equal("foo"))
+
+			cluster.ResourceVersion = "2"
+
+			decoded, err = cache.Decode(cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded.Shoot.Name).To(Equal("bar"))
+			Expect(cache.Len()).To(Equal(1))
+		})
+
+		It("should not cache resources without resource version", func() {
+			decoded, err := cache.Decode(newCluster("shoot--foo--bar", "", "foo"))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded.Shoot.Name).To(Equal("foo"))
+			Expect(cache.Len()).To(BeZero())
+		})
+
+		It("should return the shared cached resources", func() {
+			cluster := newCluster("shoot--foo--bar", "1", "foo")
+
+			decoded, err := cache.Decode(cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			decodedAgain, err := cache.Decode(cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decodedAgain).To(BeIdenticalTo(decoded))
+		})
+
+		It("should fail if the resources cannot be decoded", func() {
+			cluster := newCluster("shoot--foo--bar", "1", "foo")
+			cluster.Spec.Shoot = runtime.RawExtension{Raw: []byte("{")}
+
+			_, err := cache.Decode(cluster)
+			Expect(err).To(HaveOccurred())
+			Expect(cache.Len()).To(BeZero())
+		})
+	})
+
+	Describe("#decodeWithoutStoring", func() {
+		It("should serve the cached resources of the same resource version", func() {
+			cluster := newCluster("shoot--foo--bar", "1", "foo")
+
+			decoded, err := cache.Decode(cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			decodedAgain, err := cache.decodeWithoutStoring(cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decodedAgain).To(BeIdenticalTo(decoded))
+		})
+
+		It("should not replace the cached resources of another resource version", func() {
+			decoded, err := cache.Decode(newCluster("shoot--foo--bar", "2", "bar"))
+			Expect(err).NotTo(HaveOccurred())
+
+			oldDecoded, err := cache.decodeWithoutStoring(newCluster("shoot--foo--bar", "1", "foo"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(oldDecoded.Shoot.Name).To(Equal("foo"))
+
+			decodedAgain, err := cache.Decode(newCluster("shoot--foo--bar", "2", "bar"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decodedAgain).To(BeIdenticalTo(decoded))
+		})
+
+		It("should not store the resources of unknown Cluster resources", func() {
+			_, err := cache.decodeWithoutStoring(newCluster("shoot--foo--bar", "1", "foo"))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cache.Len()).To(BeZero())
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should evict the cached resources", func() {
+			_, err := cache.Decode(newCluster("shoot--foo--bar", "1", "foo"))
+			Expect(err).NotTo(HaveOccurred())
+
+			cache.Delete("shoot--foo--bar")
+
+			Expect(cache.Len()).To(BeZero())
+		})
+	})
+
+	Describe("#Get", func() {
+		It("should read the Cluster resource and return its decoded resources", func() {
+			var (
+				ctx       = context.TODO()
+				namespace = "shoot--foo--bar"
+				cluster   = newCluster(namespace, "1", "foo")
+			)
+
+			c.EXPECT().
+				Get(ctx, kutil.Key(namespace), gomock.AssignableToTypeOf(&extensionsv1alpha1.Cluster{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, actual *extensionsv1alpha1.Cluster) error {
+					*actual = *cluster
+					return nil
+				})
+
+			decoded, err := cache.Get(ctx, c, namespace)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded.Shoot.Name).To(Equal("foo"))
+		})
+	})
+})
+
+func newShoot(name string) *gardenv1beta1.Shoot {
+	return &gardenv1beta1.Shoot{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gardenv1beta1.SchemeGroupVersion.String(),
+			Kind:       "Shoot",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+}
+
+func newCluster(name, resourceVersion, shootName string) *extensionsv1alpha1.Cluster {
+	return &extensionsv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			ResourceVersion: resourceVersion,
+		},
+		Spec: extensionsv1alpha1.ClusterSpec{
+			CloudProfile: encodeGardenObject(&gardenv1beta1.CloudProfile{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: gardenv1beta1.SchemeGroupVersion.String(),
+					Kind:       "CloudProfile",
+				},
+			}),
+			Seed: encodeGardenObject(&gardenv1beta1.Seed{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: gardenv1beta1.SchemeGroupVersion.String(),
+					Kind:       "Seed",
+				},
+			}),
+			Shoot: encodeGardenObject(newShoot(shootName)),
+		},
+	}
+}
+
+func encodeGardenObject(obj runtime.Object) runtime.RawExtension {
+	data, _ := json.Marshal(obj)
+	return runtime.RawExtension{Raw: data}
+}
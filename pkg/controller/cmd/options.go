@@ -34,6 +34,10 @@ const (
 	// LeaderElectionNamespaceFlag is the name of the command line flag to specify the leader election namespace.
 	LeaderElectionNamespaceFlag = "leader-election-namespace"
 
+	// MetricsBindAddressFlag is the name of the command line flag to specify the TCP address that the controller
+	// should bind to for serving prometheus metrics.
+	MetricsBindAddressFlag = "metrics-bind-address"
+
 	// MaxConcurrentReconcilesFlag is the name of the command line flag to specify the maximum number of
 	// concurrent reconciliations a controller can do.
 	MaxConcurrentReconcilesFlag = "max-concurrent-reconciles"
@@ -142,6 +146,8 @@ type ManagerOptions struct {
 	LeaderElectionID string
 	// LeaderElectionNamespace is the namespace to do leader election in.
 	LeaderElectionNamespace string
+	// MetricsBindAddress is the TCP address that the controller should bind to for serving prometheus metrics.
+	MetricsBindAddress string
 
 	config *ManagerConfig
 }
@@ -151,11 +157,12 @@ func (m *ManagerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&m.LeaderElection, LeaderElectionFlag, m.LeaderElection, "Whether to use leader election or not when running this controller manager.")
 	fs.StringVar(&m.LeaderElectionID, LeaderElectionIDFlag, m.LeaderElectionID, "The leader election id to use.")
 	fs.StringVar(&m.LeaderElectionNamespace, LeaderElectionNamespaceFlag, m.LeaderElectionNamespace, "The namespace to do leader election in.")
+	fs.StringVar(&m.MetricsBindAddress, MetricsBindAddressFlag, m.MetricsBindAddress, "The TCP address that the controller should bind to for serving prometheus metrics. Use '0' to disable serving metrics.")
 }
 
 // Complete implements Completer.Complete.
 func (m *ManagerOptions) Complete() error {
-	m.config = &ManagerConfig{m.LeaderElection, m.LeaderElectionID, m.LeaderElectionNamespace}
+	m.config = &ManagerConfig{m.LeaderElection, m.LeaderElectionID, m.LeaderElectionNamespace, m.MetricsBindAddress}
 	return nil
 }
 
@@ -172,6 +179,8 @@ type ManagerConfig struct {
 	LeaderElectionID string
 	// LeaderElectionNamespace is the namespace to do leader election in.
 	LeaderElectionNamespace string
+	// MetricsBindAddress is the TCP address that the controller should bind to for serving prometheus metrics.
+	MetricsBindAddress string
 }
 
 // Apply sets the values of this ManagerConfig in the given manager.Options.
@@ -179,6 +188,7 @@ func (c *ManagerConfig) Apply(opts *manager.Options) {
 	opts.LeaderElection = c.LeaderElection
 	opts.LeaderElectionID = c.LeaderElectionID
 	opts.LeaderElectionNamespace = c.LeaderElectionNamespace
+	opts.MetricsBindAddress = c.MetricsBindAddress
 }
 
 // Options initializes empty manager.Options, applies the set values and returns it.
@@ -200,12 +200,14 @@ var _ = Describe("Options", func() {
 			name                    = "foo"
 			leaderElectionID        = "id"
 			leaderElectionNamespace = "namespace"
+			metricsBindAddress      = ":8080"
 		)
 		command := test.NewCommandBuilder(name).
 			Flags(
 				test.BoolFlag(LeaderElectionFlag, true),
 				test.StringFlag(LeaderElectionIDFlag, leaderElectionID),
 				test.StringFlag(LeaderElectionNamespaceFlag, leaderElectionNamespace),
+				test.StringFlag(MetricsBindAddressFlag, metricsBindAddress),
 			).
 			Command().
 			Slice()
@@ -222,6 +224,7 @@ var _ = Describe("Options", func() {
 					LeaderElection:          true,
 					LeaderElectionID:        leaderElectionID,
 					LeaderElectionNamespace: leaderElectionNamespace,
+					MetricsBindAddress:      metricsBindAddress,
 				}))
 			})
 		})
@@ -251,6 +254,7 @@ var _ = Describe("Options", func() {
 					LeaderElection:          true,
 					LeaderElectionID:        leaderElectionID,
 					LeaderElectionNamespace: leaderElectionNamespace,
+					MetricsBindAddress:      metricsBindAddress,
 				}))
 			})
 		})
@@ -498,6 +502,7 @@ var _ = Describe("Options", func() {
 		const (
 			leaderElectionID        = "id"
 			leaderElectionNamespace = "namespace"
+			metricsBindAddress      = ":8080"
 		)
 
 		Describe("#Apply", func() {
@@ -506,6 +511,7 @@ var _ = Describe("Options", func() {
 					LeaderElection:          true,
 					LeaderElectionID:        leaderElectionID,
 					LeaderElectionNamespace: leaderElectionNamespace,
+					MetricsBindAddress:      metricsBindAddress,
 				}
 
 				opts := manager.Options{}
@@ -515,6 +521,7 @@ var _ = Describe("Options", func() {
 					LeaderElection:          true,
 					LeaderElectionID:        leaderElectionID,
 					LeaderElectionNamespace: leaderElectionNamespace,
+					MetricsBindAddress:      metricsBindAddress,
 				}))
 			})
 		})
@@ -525,6 +532,7 @@ var _ = Describe("Options", func() {
 					LeaderElection:          true,
 					LeaderElectionID:        leaderElectionID,
 					LeaderElectionNamespace: leaderElectionNamespace,
+					MetricsBindAddress:      metricsBindAddress,
 				}
 
 				opts := cfg.Options()
@@ -533,6 +541,7 @@ var _ = Describe("Options", func() {
 					LeaderElection:          true,
 					LeaderElectionID:        leaderElectionID,
 					LeaderElectionNamespace: leaderElectionNamespace,
+					MetricsBindAddress:      metricsBindAddress,
 				}))
 			})
 		})
@@ -18,6 +18,7 @@ import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/metrics"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -61,6 +62,7 @@ func DefaultPredicates(mgr manager.Manager) []predicate.Predicate {
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
 	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
+	extensionsmetrics.AddObjectsInErrorStateKind("ControlPlane", mgr.GetClient(), &extensionsv1alpha1.ControlPlaneList{})
 	return add(mgr, args.Type, args.ControllerOptions, args.Predicates)
 }
 
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -104,6 +105,11 @@ func (r *reconciler) reconcile(ctx context.Context, cp *extensionsv1alpha1.Contr
 		return reconcile.Result{}, err
 	}
 
+	secretChecksum, err := extensionscontroller.GetReferencedSecretChecksum(ctx, r.client, cp)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	operationType := gardencorev1alpha1helper.ComputeOperationType(cp.ObjectMeta, cp.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, cp, operationType, "Reconciling the controlplane"); err != nil {
 		return reconcile.Result{}, err
@@ -111,7 +117,9 @@ func (r *reconciler) reconcile(ctx context.Context, cp *extensionsv1alpha1.Contr
 
 	r.logger.Info("Starting the reconciliation of controlplane", "controlplane", cp.Name)
 	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneReconciliation, "Reconciling the controlplane")
+	startTime := time.Now()
 	requeue, err := r.actuator.Reconcile(ctx, cp, cluster)
+	extensionsmetrics.RecordOperation("ControlPlane", cp.Spec.Type, operationType, startTime, err)
 	if err != nil {
 		msg := "Error reconciling controlplane"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), cp, operationType, msg)
@@ -125,6 +133,9 @@ func (r *reconciler) reconcile(ctx context.Context, cp *extensionsv1alpha1.Contr
 	if err := r.updateStatusSuccess(ctx, cp, operationType, msg); err != nil {
 		return reconcile.Result{}, err
 	}
+	if err := extensionscontroller.EnsureSecretChecksumAnnotation(ctx, r.client, cp, secretChecksum); err != nil {
+		return reconcile.Result{}, err
+	}
 
 	if requeue {
 		return reconcile.Result{RequeueAfter: RequeueAfter}, nil
@@ -150,7 +161,10 @@ func (r *reconciler) delete(ctx context.Context, cp *extensionsv1alpha1.ControlP
 
 	r.logger.Info("Starting the deletion of controlplane", "controlplane", cp.Name)
 	r.recorder.Event(cp, corev1.EventTypeNormal, EventControlPlaneDeletion, "Deleting the cp")
-	if err := r.actuator.Delete(r.ctx, cp, cluster); err != nil {
+	startTime := time.Now()
+	err = r.actuator.Delete(r.ctx, cp, cluster)
+	extensionsmetrics.RecordOperation("ControlPlane", cp.Spec.Type, operationType, startTime, err)
+	if err != nil {
 		msg := "Error deleting controlplane"
 		r.recorder.Eventf(cp, corev1.EventTypeWarning, EventControlPlaneDeletion, "%s: %+v", msg, err)
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), cp, operationType, msg)
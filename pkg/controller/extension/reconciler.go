@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/metrics"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -70,6 +71,7 @@ type AddArgs struct {
 // Add adds an Extension controller to the given manager using the given AddArgs.
 func Add(mgr manager.Manager, args AddArgs) error {
 	args.ControllerOptions.Reconciler = NewReconciler(args)
+	extensionsmetrics.AddObjectsInErrorStateKind("Extension", mgr.GetClient(), &extensionsv1alpha1.ExtensionList{})
 	return add(mgr, args)
 }
 
@@ -185,7 +187,10 @@ func (r *reconciler) reconcile(ctx context.Context, ex *extensionsv1alpha1.Exten
 		return reconcile.Result{}, err
 	}
 
-	if err := r.actuator.Reconcile(ctx, ex); err != nil {
+	startTime := time.Now()
+	err := r.actuator.Reconcile(ctx, ex)
+	extensionsmetrics.RecordOperation("Extension", ex.Spec.Type, operationType, startTime, err)
+	if err != nil {
 		msg := "Unable to reconcile Extension resource"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), ex, operationType, msg)
 		r.logger.Error(err, msg, "extension", ex.Name, "namespace", ex.Namespace)
@@ -217,7 +222,10 @@ func (r *reconciler) delete(ctx context.Context, ex *extensionsv1alpha1.Extensio
 		return reconcile.Result{}, err
 	}
 
-	if err := r.actuator.Delete(ctx, ex); err != nil {
+	startTime := time.Now()
+	err = r.actuator.Delete(ctx, ex)
+	extensionsmetrics.RecordOperation("Extension", ex.Spec.Type, operationType, startTime, err)
+	if err != nil {
 		msg := "Error deleting Extension resource"
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), ex, operationType, msg)
 		r.logger.Error(err, msg, "extension", ex.Name, "namespace", ex.Namespace)
@@ -33,6 +33,19 @@ type Actuator interface {
 	Delete(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error
 }
 
+// Drift describes the difference between the desired and the actual state of an Infrastructure.
+type Drift struct {
+	// Summary is a human-readable summary of the changes that are necessary to heal the drift.
+	Summary string
+}
+
+// DriftDetector detects drifts of Infrastructure resources, i.e., changes that have been made to the
+// infrastructure outside of the control of the actuator.
+type DriftDetector interface {
+	// DetectDrift returns the drift of the given Infrastructure, or nil if there is none.
+	DetectDrift(context.Context, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) (*Drift, error)
+}
+
 type operationAnnotationWrapper struct {
 	Actuator
 	client client.Client
@@ -17,6 +17,7 @@ package infrastructure
 import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/metrics"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -49,6 +50,23 @@ type AddArgs struct {
 	Predicates []predicate.Predicate
 	// WatchBuilder defines additional watches on controllers that should be set up.
 	WatchBuilder extensionscontroller.WatchBuilder
+	// DriftDetection are the arguments for the periodic drift detection.
+	// If unset, no drift detection is performed.
+	DriftDetection *DriftDetectionArgs
+}
+
+// DriftDetectionArgs are arguments for the periodic drift detection of an infrastructure controller.
+type DriftDetectionArgs struct {
+	// DriftDetector detects the drifts of the infrastructures.
+	DriftDetector DriftDetector
+	// Type is the type of the infrastructures handled by the controller.
+	Type string
+	// Config is the drift detection configuration.
+	Config DriftDetectionConfig
+}
+
+func (a *DriftDetectionArgs) enabled() bool {
+	return a != nil && a.Config.ResyncPeriod > 0
 }
 
 // DefaultPredicates returns the default predicates for an infrastructure reconciler.
@@ -75,7 +93,8 @@ func DefaultPredicates(client client.Client, typeName string, ignoreOperationAnn
 // Add creates a new Infrastructure Controller and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
+	args.ControllerOptions.Reconciler = NewReconcilerWithDriftDetection(mgr, args.Actuator, args.DriftDetection)
+	extensionsmetrics.AddObjectsInErrorStateKind("Infrastructure", mgr.GetClient(), &extensionsv1alpha1.InfrastructureList{})
 	return add(mgr, args)
 }
 
@@ -95,6 +114,14 @@ func add(mgr manager.Manager, args AddArgs) error {
 		return err
 	}
 
+	// Drift detection is driven by requeueing the reconciled infrastructures. As the default predicates filter
+	// infrastructures that are already reconciled, all existing infrastructures are enqueued once on creation.
+	if args.DriftDetection.enabled() {
+		if err := ctrl.Watch(&source.Kind{Type: &extensionsv1alpha1.Infrastructure{}}, &handler.EnqueueRequestForObject{}, DriftDetectionPredicates(args.DriftDetection.Type)...); err != nil {
+			return err
+		}
+	}
+
 	// Add additional watches to the controller besides the standard one.
 	err = args.WatchBuilder.AddToController(ctrl)
 	if err != nil {
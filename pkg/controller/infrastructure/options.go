@@ -15,6 +15,8 @@
 package infrastructure
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
 )
 
@@ -22,6 +24,13 @@ const (
 	// IgnoreOperationAnnotationFlag is the name of the command line flag to specify whether the operation annotation
 	// is ignored or not.
 	IgnoreOperationAnnotationFlag = "ignore-operation-annotation"
+
+	// DriftDetectionResyncPeriodFlag is the name of the command line flag to specify the period in which
+	// infrastructures are checked for drifts.
+	DriftDetectionResyncPeriodFlag = "drift-detection-resync-period"
+	// DriftDetectionAutoHealFlag is the name of the command line flag to specify whether detected drifts are
+	// healed automatically.
+	DriftDetectionAutoHealFlag = "drift-detection-auto-heal"
 )
 
 // ReconcilerOptions are command line options that can be set for controller.Options.
@@ -58,3 +67,43 @@ type ReconcilerConfig struct {
 func (c *ReconcilerConfig) Apply(ignore *bool) {
 	*ignore = c.IgnoreOperationAnnotation
 }
+
+// DriftDetectionOptions are command line options for the periodic drift detection of infrastructures.
+type DriftDetectionOptions struct {
+	// ResyncPeriod is the period in which infrastructures are checked for drifts.
+	ResyncPeriod time.Duration
+	// AutoHeal defines whether detected drifts are healed by reconciling the infrastructure.
+	AutoHeal bool
+
+	config *DriftDetectionConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (d *DriftDetectionOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&d.ResyncPeriod, DriftDetectionResyncPeriodFlag, d.ResyncPeriod, "Period in which infrastructures are checked for drifts. Zero disables the drift detection.")
+	fs.BoolVar(&d.AutoHeal, DriftDetectionAutoHealFlag, d.AutoHeal, "Whether to heal detected drifts by reconciling the infrastructure.")
+}
+
+// Complete implements Completer.Complete.
+func (d *DriftDetectionOptions) Complete() error {
+	d.config = &DriftDetectionConfig{d.ResyncPeriod, d.AutoHeal}
+	return nil
+}
+
+// Completed returns the completed DriftDetectionConfig. Only call this if `Complete` was successful.
+func (d *DriftDetectionOptions) Completed() *DriftDetectionConfig {
+	return d.config
+}
+
+// DriftDetectionConfig is a completed drift detection configuration.
+type DriftDetectionConfig struct {
+	// ResyncPeriod is the period in which infrastructures are checked for drifts.
+	ResyncPeriod time.Duration
+	// AutoHeal defines whether detected drifts are healed by reconciling the infrastructure.
+	AutoHeal bool
+}
+
+// Apply sets the values of this DriftDetectionConfig in the given DriftDetectionConfig.
+func (d *DriftDetectionConfig) Apply(config *DriftDetectionConfig) {
+	*config = *d
+}
@@ -15,6 +15,8 @@
 package infrastructure
 
 import (
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -48,6 +50,36 @@ func OperationAnnotationPredicate() predicate.Predicate {
 	}
 }
 
+// DriftDetectionPredicates returns the predicates for starting the periodic drift detection of infrastructures
+// of the given type, i.e., they only let pass create events.
+func DriftDetectionPredicates(typeName string) []predicate.Predicate {
+	return []predicate.Predicate{
+		extensionscontroller.TypePredicate(typeName),
+		predicate.Funcs{
+			UpdateFunc: func(event event.UpdateEvent) bool {
+				return false
+			},
+			DeleteFunc: func(event event.DeleteEvent) bool {
+				return false
+			},
+			GenericFunc: func(event event.GenericEvent) bool {
+				return false
+			},
+		},
+	}
+}
+
+// isReconciled checks whether the given infrastructure has been successfully reconciled and whether
+// no further reconciliation has been requested.
+func isReconciled(infrastructure *extensionsv1alpha1.Infrastructure) bool {
+	return infrastructure.DeletionTimestamp == nil &&
+		infrastructure.Generation == infrastructure.Status.ObservedGeneration &&
+		infrastructure.Status.LastOperation != nil &&
+		infrastructure.Status.LastOperation.Type != gardencorev1alpha1.LastOperationTypeDelete &&
+		infrastructure.Status.LastOperation.State == gardencorev1alpha1.LastOperationStateSucceeded &&
+		!kutil.HasMetaDataAnnotation(&infrastructure.ObjectMeta, gardencorev1alpha1.GardenerOperation, gardencorev1alpha1.GardenerOperationReconcile)
+}
+
 func mayReconcile(infrastructure *extensionsv1alpha1.Infrastructure) bool {
 	return infrastructure.DeletionTimestamp != nil ||
 		infrastructure.Generation != infrastructure.Status.ObservedGeneration ||
@@ -17,8 +17,10 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -45,11 +47,14 @@ const (
 	EventInfrastructureReconciliation string = "InfrastructureReconciliation"
 	// EventInfrastructureDeleton an event reason to describe infrastructure deletion.
 	EventInfrastructureDeleton string = "InfrastructureDeleton"
+	// EventInfrastructureDrift an event reason to describe infrastructure drifts.
+	EventInfrastructureDrift string = "InfrastructureDrift"
 )
 
 type reconciler struct {
-	logger   logr.Logger
-	actuator Actuator
+	logger         logr.Logger
+	actuator       Actuator
+	driftDetection *DriftDetectionArgs
 
 	ctx      context.Context
 	client   client.Client
@@ -59,14 +64,27 @@ type reconciler struct {
 // NewReconciler creates a new reconcile.Reconciler that reconciles
 // infrastructure resources of Gardener's `extensions.gardener.cloud` API group.
 func NewReconciler(mgr manager.Manager, actuator Actuator) reconcile.Reconciler {
+	return NewReconcilerWithDriftDetection(mgr, actuator, nil)
+}
+
+// NewReconcilerWithDriftDetection creates a new reconcile.Reconciler that reconciles
+// infrastructure resources of Gardener's `extensions.gardener.cloud` API group and that
+// periodically checks reconciled infrastructures for drifts as configured by the given arguments.
+func NewReconcilerWithDriftDetection(mgr manager.Manager, actuator Actuator, driftDetection *DriftDetectionArgs) reconcile.Reconciler {
 	return &reconciler{
-		logger:   log.Log.WithName(ControllerName),
-		actuator: actuator,
-		recorder: mgr.GetRecorder(ControllerName),
+		logger:         log.Log.WithName(ControllerName),
+		actuator:       actuator,
+		driftDetection: driftDetection,
+		recorder:       mgr.GetRecorder(ControllerName),
 	}
 }
 
 func (r *reconciler) InjectFunc(f inject.Func) error {
+	if r.driftDetection.enabled() {
+		if err := f(r.driftDetection.DriftDetector); err != nil {
+			return err
+		}
+	}
 	return f(r.actuator)
 }
 
@@ -97,6 +115,9 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 	if infrastructure.DeletionTimestamp != nil {
 		return r.delete(r.ctx, infrastructure, cluster)
 	}
+	if r.driftDetection.enabled() && isReconciled(infrastructure) && !r.referencedSecretChanged(r.ctx, infrastructure) {
+		return r.detectDrift(r.ctx, infrastructure, cluster)
+	}
 	return r.reconcile(r.ctx, infrastructure, cluster)
 }
 
@@ -105,6 +126,11 @@ func (r *reconciler) reconcile(ctx context.Context, infrastructure *extensionsv1
 		return reconcile.Result{}, err
 	}
 
+	secretChecksum, err := extensionscontroller.GetReferencedSecretChecksum(ctx, r.client, infrastructure)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	operationType := gardencorev1alpha1helper.ComputeOperationType(infrastructure.ObjectMeta, infrastructure.Status.LastOperation)
 	if err := r.updateStatusProcessing(ctx, infrastructure, operationType, "Reconciling the infrastructure"); err != nil {
 		return reconcile.Result{}, err
@@ -112,7 +138,10 @@ func (r *reconciler) reconcile(ctx context.Context, infrastructure *extensionsv1
 
 	r.logger.Info("Starting the reconciliation of infrastructure", "infrastructure", infrastructure.Name)
 	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureReconciliation, "Reconciling the infrastructure")
-	if err := r.actuator.Reconcile(ctx, infrastructure, cluster); err != nil {
+	startTime := time.Now()
+	err = r.actuator.Reconcile(ctx, infrastructure, cluster)
+	extensionsmetrics.RecordOperation("Infrastructure", infrastructure.Spec.Type, operationType, startTime, err)
+	if err != nil {
 		msg := "Error reconciling infrastructure"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), infrastructure, operationType, msg))
 		r.logger.Error(err, msg, "infrastructure", infrastructure.Name)
@@ -125,7 +154,13 @@ func (r *reconciler) reconcile(ctx context.Context, infrastructure *extensionsv1
 	if err := r.updateStatusSuccess(ctx, infrastructure, operationType, msg); err != nil {
 		return reconcile.Result{}, err
 	}
+	if err := extensionscontroller.EnsureSecretChecksumAnnotation(ctx, r.client, infrastructure, secretChecksum); err != nil {
+		return reconcile.Result{}, err
+	}
 
+	if r.driftDetection.enabled() {
+		return reconcile.Result{RequeueAfter: r.driftDetection.Config.ResyncPeriod}, nil
+	}
 	return reconcile.Result{}, nil
 }
 
@@ -147,7 +182,10 @@ func (r *reconciler) delete(ctx context.Context, infrastructure *extensionsv1alp
 
 	r.logger.Info("Starting the deletion of infrastructure", "infrastructure", infrastructure.Name)
 	r.recorder.Event(infrastructure, corev1.EventTypeNormal, EventInfrastructureDeleton, "Deleting the infrastructure")
-	if err := r.actuator.Delete(r.ctx, infrastructure, cluster); err != nil {
+	startTime := time.Now()
+	err = r.actuator.Delete(r.ctx, infrastructure, cluster)
+	extensionsmetrics.RecordOperation("Infrastructure", infrastructure.Spec.Type, operationType, startTime, err)
+	if err != nil {
 		msg := "Error deleting infrastructure"
 		r.recorder.Eventf(infrastructure, corev1.EventTypeWarning, EventInfrastructureDeleton, "%s: %+v", msg, err)
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), infrastructure, operationType, msg))
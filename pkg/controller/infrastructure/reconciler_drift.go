@@ -0,0 +1,111 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/retry"
+
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// ConditionTypeDrifted is the type of the condition that indicates whether an infrastructure has drifted
+	// from its desired state.
+	ConditionTypeDrifted gardencorev1alpha1.ConditionType = "InfrastructureDrifted"
+
+	// maxDriftDetectionTimeout is the maximum time a single drift detection may take.
+	maxDriftDetectionTimeout = 5 * time.Minute
+)
+
+// driftDetectionTimeout returns the time a single drift detection may take for the given resync period. It is kept
+// well below the resync period so that a hanging detection (e.g. a 'terraform plan' Pod which cannot be scheduled)
+// does not block the worker of the reconciler until the next detection is due.
+func driftDetectionTimeout(resyncPeriod time.Duration) time.Duration {
+	if timeout := resyncPeriod / 4; timeout < maxDriftDetectionTimeout {
+		return timeout
+	}
+	return maxDriftDetectionTimeout
+}
+
+func (r *reconciler) detectDrift(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (reconcile.Result, error) {
+	result := reconcile.Result{RequeueAfter: r.driftDetection.Config.ResyncPeriod}
+
+	r.logger.Info("Detecting drift of infrastructure", "infrastructure", infrastructure.Name)
+	detectCtx, cancel := context.WithTimeout(ctx, driftDetectionTimeout(r.driftDetection.Config.ResyncPeriod))
+	defer cancel()
+	drift, err := r.driftDetection.DriftDetector.DetectDrift(detectCtx, infrastructure, cluster)
+	if err != nil {
+		r.logger.Error(err, "Error detecting drift of infrastructure", "infrastructure", infrastructure.Name)
+		return result, r.updateDriftCondition(ctx, infrastructure, gardencorev1alpha1.ConditionUnknown, "DriftDetectionFailed", fmt.Sprintf("Could not detect drift of infrastructure: %v", err))
+	}
+
+	if drift == nil {
+		return result, r.updateDriftCondition(ctx, infrastructure, gardencorev1alpha1.ConditionFalse, "InfrastructureInSync", "The infrastructure is in sync with its desired state.")
+	}
+
+	msg := fmt.Sprintf("The infrastructure has drifted from its desired state: %s", drift.Summary)
+	r.logger.Info(msg, "infrastructure", infrastructure.Name)
+	r.recorder.Event(infrastructure, corev1.EventTypeWarning, EventInfrastructureDrift, msg)
+	if err := r.updateDriftCondition(ctx, infrastructure, gardencorev1alpha1.ConditionTrue, "InfrastructureDrifted", msg); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !r.driftDetection.Config.AutoHeal {
+		return result, nil
+	}
+
+	r.logger.Info("Healing drift of infrastructure", "infrastructure", infrastructure.Name)
+	if result, err := r.reconcile(ctx, infrastructure, cluster); err != nil {
+		return result, err
+	}
+
+	return result, r.updateDriftCondition(ctx, infrastructure, gardencorev1alpha1.ConditionFalse, "InfrastructureDriftHealed", "The infrastructure drift has been healed.")
+}
+
+func (r *reconciler) updateDriftCondition(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, status gardencorev1alpha1.ConditionStatus, reason, message string) error {
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, infrastructure, func() error {
+		condition := gardencorev1alpha1helper.GetCondition(infrastructure.Status.Conditions, ConditionTypeDrifted)
+		if condition == nil {
+			initialCondition := gardencorev1alpha1helper.InitCondition(ConditionTypeDrifted)
+			condition = &initialCondition
+		}
+
+		infrastructure.Status.Conditions = gardencorev1alpha1helper.MergeConditions(infrastructure.Status.Conditions, gardencorev1alpha1helper.UpdatedCondition(*condition, status, reason, message))
+		return nil
+	})
+}
+
+// referencedSecretChanged returns true if the data of the secret referenced by the given infrastructure changed since
+// its last successful reconciliation. In this case, the infrastructure is reconciled instead of checked for drifts.
+// Errors are treated as change so that they are surfaced by the reconciliation.
+func (r *reconciler) referencedSecretChanged(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) bool {
+	checksum, err := extensionscontroller.GetReferencedSecretChecksum(ctx, r.client, infrastructure)
+	if err != nil {
+		return true
+	}
+	changed, err := extensionscontroller.SecretChecksumChanged(infrastructure, checksum)
+	return err != nil || changed
+}
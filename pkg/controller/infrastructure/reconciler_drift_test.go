@@ -0,0 +1,33 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Drift", func() {
+	DescribeTable("#driftDetectionTimeout",
+		func(resyncPeriod, expected time.Duration) {
+			Expect(driftDetectionTimeout(resyncPeriod)).To(Equal(expected))
+		},
+		Entry("should use a quarter of short resync periods", 8*time.Minute, 2*time.Minute),
+		Entry("should cap the timeout for long resync periods", 6*time.Hour, maxDriftDetectionTimeout),
+	)
+})
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -113,3 +114,78 @@ func (m *clusterToObjectMapper) Map(obj handler.MapObject) []reconcile.Request {
 func ClusterToObjectMapper(client client.Client, newObjListFunc func() runtime.Object, predicates []predicate.Predicate) handler.Mapper {
 	return &clusterToObjectMapper{client, newObjListFunc, predicates}
 }
+
+type secretToObjectMapper struct {
+	client         client.Client
+	newObjListFunc func() runtime.Object
+	predicates     []predicate.Predicate
+	clusterScoped  bool
+}
+
+func (m *secretToObjectMapper) Map(obj handler.MapObject) []reconcile.Request {
+	ctx := context.TODO()
+
+	if obj.Object == nil {
+		return nil
+	}
+
+	secret, ok := obj.Object.(*corev1.Secret)
+	if !ok {
+		return nil
+	}
+
+	// Namespaced objects can only reference secrets in their own namespace.
+	listOpts := client.InNamespace(secret.Namespace)
+	if m.clusterScoped {
+		listOpts = &client.ListOptions{}
+	}
+
+	objList := m.newObjListFunc()
+	if err := m.client.List(ctx, listOpts, objList); err != nil {
+		return nil
+	}
+
+	checksum := ComputeSecretChecksum(secret)
+
+	var requests []reconcile.Request
+	utilruntime.HandleError(meta.EachListItem(objList, func(obj runtime.Object) error {
+		ref := SecretReferenceOf(obj)
+		if ref == nil || ref.Name != secret.Name || ref.Namespace != secret.Namespace {
+			return nil
+		}
+
+		changed, err := SecretChecksumChanged(obj, checksum)
+		if err != nil {
+			return err
+		}
+		if !changed || !EvalGenericPredicate(obj, m.predicates...) {
+			return nil
+		}
+
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return err
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: accessor.GetNamespace(),
+				Name:      accessor.GetName(),
+			},
+		})
+		return nil
+	}))
+	return requests
+}
+
+// SecretToObjectMapper returns a mapper that returns requests for objects whose referenced secrets have been
+// modified. Objects that have already been reconciled with the current data of the secret (see
+// SecretChecksumAnnotation) are skipped.
+func SecretToObjectMapper(client client.Client, newObjListFunc func() runtime.Object, predicates []predicate.Predicate) handler.Mapper {
+	return &secretToObjectMapper{client, newObjListFunc, predicates, false}
+}
+
+// ClusterScopedSecretToObjectMapper is like SecretToObjectMapper but for cluster-scoped objects, i.e., the objects
+// are listed across all namespaces.
+func ClusterScopedSecretToObjectMapper(client client.Client, newObjListFunc func() runtime.Object, predicates []predicate.Predicate) handler.Mapper {
+	return &secretToObjectMapper{client, newObjListFunc, predicates, true}
+}
@@ -27,6 +27,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
 	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -296,4 +297,111 @@ var _ = Describe("Controller Mapper", func() {
 			Expect(result).To(BeNil())
 		})
 	})
+
+	Describe("#SecretToObjectMapper", func() {
+		var (
+			namespace = "shoot"
+			secret    *corev1.Secret
+
+			newObjListFunc = func() runtime.Object { return &extensionsv1alpha1.WorkerList{} }
+			newWorker      = func(name, secretName string, annotations map[string]string) extensionsv1alpha1.Worker {
+				return extensionsv1alpha1.Worker{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        name,
+						Namespace:   namespace,
+						Annotations: annotations,
+					},
+					Spec: extensionsv1alpha1.WorkerSpec{
+						SecretRef: corev1.SecretReference{
+							Name:      secretName,
+							Namespace: namespace,
+						},
+					},
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cloudprovider",
+					Namespace: namespace,
+				},
+				Data: map[string][]byte{
+					"accessKeyID": []byte("foo"),
+				},
+			}
+		})
+
+		It("should only find the objects that reference the secret and were reconciled with other data", func() {
+			mapper := SecretToObjectMapper(c, newObjListFunc, nil)
+
+			c.EXPECT().
+				List(
+					gomock.AssignableToTypeOf(context.TODO()),
+					gomock.Eq(client.InNamespace(namespace)),
+					gomock.AssignableToTypeOf(&extensionsv1alpha1.WorkerList{}),
+				).
+				DoAndReturn(func(_ context.Context, _ *client.ListOptions, actual *extensionsv1alpha1.WorkerList) error {
+					*actual = extensionsv1alpha1.WorkerList{
+						Items: []extensionsv1alpha1.Worker{
+							newWorker("outdated", secret.Name, map[string]string{SecretChecksumAnnotation: "old"}),
+							newWorker("new", secret.Name, nil),
+							newWorker("up-to-date", secret.Name, map[string]string{SecretChecksumAnnotation: ComputeSecretChecksum(secret)}),
+							newWorker("other", "other-secret", nil),
+						},
+					}
+					return nil
+				})
+
+			result := mapper.Map(handler.MapObject{Object: secret})
+
+			Expect(result).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "outdated", Namespace: namespace}},
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "new", Namespace: namespace}},
+			))
+		})
+
+		It("should list cluster-scoped objects across all namespaces", func() {
+			mapper := ClusterScopedSecretToObjectMapper(c, func() runtime.Object { return &extensionsv1alpha1.BackupBucketList{} }, nil)
+
+			c.EXPECT().
+				List(
+					gomock.AssignableToTypeOf(context.TODO()),
+					gomock.Eq(&client.ListOptions{}),
+					gomock.AssignableToTypeOf(&extensionsv1alpha1.BackupBucketList{}),
+				).
+				DoAndReturn(func(_ context.Context, _ *client.ListOptions, actual *extensionsv1alpha1.BackupBucketList) error {
+					*actual = extensionsv1alpha1.BackupBucketList{
+						Items: []extensionsv1alpha1.BackupBucket{
+							{
+								ObjectMeta: metav1.ObjectMeta{Name: "bucket"},
+								Spec: extensionsv1alpha1.BackupBucketSpec{
+									SecretRef: corev1.SecretReference{
+										Name:      secret.Name,
+										Namespace: namespace,
+									},
+								},
+							},
+						},
+					}
+					return nil
+				})
+
+			result := mapper.Map(handler.MapObject{Object: secret})
+
+			Expect(result).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "bucket"}},
+			))
+		})
+
+		It("should find no objects because the passed object is no secret", func() {
+			mapper := SecretToObjectMapper(c, newObjListFunc, nil)
+			result := mapper.Map(handler.MapObject{
+				Object: &extensionsv1alpha1.Infrastructure{},
+			})
+
+			Expect(result).To(BeNil())
+		})
+	})
 })
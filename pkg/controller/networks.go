@@ -0,0 +1,164 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+)
+
+// ShootNetworks contains the networks and zones of a Shoot.
+type ShootNetworks struct {
+	// Pods is the CIDR of the pod network.
+	Pods *gardencorev1alpha1.CIDR
+	// Services is the CIDR of the service network.
+	Services *gardencorev1alpha1.CIDR
+	// Nodes is the CIDR of the node network.
+	Nodes *gardencorev1alpha1.CIDR
+	// Zones are the availability zones of the Shoot.
+	Zones []string
+}
+
+// ShootNetworksAccessor returns the networks and zones of the given cloud specification of a Shoot. The second return
+// value is false if the cloud specification does not contain a configuration for the provider of the accessor.
+type ShootNetworksAccessor func(cloud *gardenv1beta1.Cloud) (*ShootNetworks, bool)
+
+// ShootNetworksRegistry maps provider types to the accessors for the networks and zones of Shoots of these providers.
+type ShootNetworksRegistry struct {
+	lock      sync.RWMutex
+	accessors map[string]ShootNetworksAccessor
+}
+
+// NewShootNetworksRegistry creates a new, empty ShootNetworksRegistry.
+func NewShootNetworksRegistry() *ShootNetworksRegistry {
+	return &ShootNetworksRegistry{accessors: make(map[string]ShootNetworksAccessor)}
+}
+
+// DefaultShootNetworksRegistry is the registry the provider controllers register their accessors with. It is used by
+// the package level functions like GetPodNetwork.
+var DefaultShootNetworksRegistry = NewShootNetworksRegistry()
+
+// Register registers the given accessor for the given provider type. It panics if an accessor has already been
+// registered for the provider type.
+func (r *ShootNetworksRegistry) Register(providerType string, accessor ShootNetworksAccessor) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.accessors[providerType]; ok {
+		panic(fmt.Sprintf("shoot networks accessor for provider type %q is already registered", providerType))
+	}
+	r.accessors[providerType] = accessor
+}
+
+// ProviderTypes returns the sorted list of provider types for which accessors are registered.
+func (r *ShootNetworksRegistry) ProviderTypes() []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	providerTypes := make([]string, 0, len(r.accessors))
+	for providerType := range r.accessors {
+		providerTypes = append(providerTypes, providerType)
+	}
+	sort.Strings(providerTypes)
+	return providerTypes
+}
+
+// GetForProvider returns the networks and zones of the given Shoot using the accessor registered for the given
+// provider type. It returns an error if no accessor is registered for the provider type or if the Shoot does not
+// belong to the provider.
+func (r *ShootNetworksRegistry) GetForProvider(providerType string, shoot *gardenv1beta1.Shoot) (*ShootNetworks, error) {
+	r.lock.RLock()
+	accessor, ok := r.accessors[providerType]
+	r.lock.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("no shoot networks accessor registered for provider type %q", providerType)
+	}
+
+	networks, ok := accessor(&shoot.Spec.Cloud)
+	if !ok {
+		return nil, fmt.Errorf("shoot %s/%s does not contain a configuration for provider type %q", shoot.Namespace, shoot.Name, providerType)
+	}
+	return networks, nil
+}
+
+// Get returns the networks and zones of the given Shoot using the first registered accessor (ordered by provider
+// type) which recognizes the cloud specification of the Shoot. It returns an error if no accessor recognizes it.
+func (r *ShootNetworksRegistry) Get(shoot *gardenv1beta1.Shoot) (*ShootNetworks, error) {
+	for _, providerType := range r.ProviderTypes() {
+		if networks, err := r.GetForProvider(providerType, shoot); err == nil {
+			return networks, nil
+		}
+	}
+	return nil, fmt.Errorf("could not determine the networks of shoot %s/%s: unknown provider (registered provider types: %v)", shoot.Namespace, shoot.Name, r.ProviderTypes())
+}
+
+// RegisterShootNetworksAccessor registers the given accessor for the given provider type with the
+// DefaultShootNetworksRegistry.
+func RegisterShootNetworksAccessor(providerType string, accessor ShootNetworksAccessor) {
+	DefaultShootNetworksRegistry.Register(providerType, accessor)
+}
+
+// GetShootNetworks returns the networks and zones of the given Shoot.
+func GetShootNetworks(shoot *gardenv1beta1.Shoot) (*ShootNetworks, error) {
+	return DefaultShootNetworksRegistry.Get(shoot)
+}
+
+// GetPodNetwork returns the pod network CIDR of the given Shoot.
+func GetPodNetwork(shoot *gardenv1beta1.Shoot) (gardencorev1alpha1.CIDR, error) {
+	networks, err := GetShootNetworks(shoot)
+	if err != nil {
+		return "", err
+	}
+	return cidrOrError(networks.Pods, "pod", shoot)
+}
+
+// GetServiceNetwork returns the service network CIDR of the given Shoot.
+func GetServiceNetwork(shoot *gardenv1beta1.Shoot) (gardencorev1alpha1.CIDR, error) {
+	networks, err := GetShootNetworks(shoot)
+	if err != nil {
+		return "", err
+	}
+	return cidrOrError(networks.Services, "service", shoot)
+}
+
+// GetNodeNetwork returns the node network CIDR of the given Shoot.
+func GetNodeNetwork(shoot *gardenv1beta1.Shoot) (gardencorev1alpha1.CIDR, error) {
+	networks, err := GetShootNetworks(shoot)
+	if err != nil {
+		return "", err
+	}
+	return cidrOrError(networks.Nodes, "node", shoot)
+}
+
+// GetZones returns the availability zones of the given Shoot.
+func GetZones(shoot *gardenv1beta1.Shoot) ([]string, error) {
+	networks, err := GetShootNetworks(shoot)
+	if err != nil {
+		return nil, err
+	}
+	return networks.Zones, nil
+}
+
+func cidrOrError(cidr *gardencorev1alpha1.CIDR, network string, shoot *gardenv1beta1.Shoot) (gardencorev1alpha1.CIDR, error) {
+	if cidr == nil {
+		return "", fmt.Errorf("%s network of shoot %s/%s is not set", network, shoot.Namespace, shoot.Name)
+	}
+	return *cidr, nil
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Networks", func() {
+	var (
+		podCIDR     = gardencorev1alpha1.CIDR("100.96.0.0/11")
+		serviceCIDR = gardencorev1alpha1.CIDR("100.64.0.0/13")
+		nodeCIDR    = gardencorev1alpha1.CIDR("10.250.0.0/16")
+		zones       = []string{"zone-a", "zone-b"}
+
+		awsAccessor = func(cloud *gardenv1beta1.Cloud) (*ShootNetworks, bool) {
+			if cloud.AWS == nil {
+				return nil, false
+			}
+			return &ShootNetworks{
+				Pods:     cloud.AWS.Networks.Pods,
+				Services: cloud.AWS.Networks.Services,
+				Nodes:    cloud.AWS.Networks.Nodes,
+				Zones:    cloud.AWS.Zones,
+			}, true
+		}
+		gcpAccessor = func(cloud *gardenv1beta1.Cloud) (*ShootNetworks, bool) {
+			if cloud.GCP == nil {
+				return nil, false
+			}
+			return &ShootNetworks{Pods: cloud.GCP.Networks.Pods}, true
+		}
+
+		registry *ShootNetworksRegistry
+		awsShoot *gardenv1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		registry = NewShootNetworksRegistry()
+		registry.Register("aws", awsAccessor)
+		registry.Register("gcp", gcpAccessor)
+
+		awsShoot = &gardenv1beta1.Shoot{
+			Spec: gardenv1beta1.ShootSpec{
+				Cloud: gardenv1beta1.Cloud{
+					AWS: &gardenv1beta1.AWSCloud{
+						Networks: gardenv1beta1.AWSNetworks{
+							K8SNetworks: gardencorev1alpha1.K8SNetworks{
+								Pods:     &podCIDR,
+								Services: &serviceCIDR,
+								Nodes:    &nodeCIDR,
+							},
+						},
+						Zones: zones,
+					},
+				},
+			},
+		}
+	})
+
+	Describe("ShootNetworksRegistry", func() {
+		It("should panic if an accessor is registered twice for the same provider type", func() {
+			Expect(func() { registry.Register("aws", awsAccessor) }).To(Panic())
+		})
+
+		It("should return the sorted provider types", func() {
+			Expect(registry.ProviderTypes()).To(Equal([]string{"aws", "gcp"}))
+		})
+
+		Describe("#GetForProvider", func() {
+			It("should return the networks of the shoot", func() {
+				networks, err := registry.GetForProvider("aws", awsShoot)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(networks).To(Equal(&ShootNetworks{
+					Pods:     &podCIDR,
+					Services: &serviceCIDR,
+					Nodes:    &nodeCIDR,
+					Zones:    zones,
+				}))
+			})
+
+			It("should fail for unknown provider types", func() {
+				_, err := registry.GetForProvider("foo", awsShoot)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should fail if the shoot does not belong to the provider", func() {
+				_, err := registry.GetForProvider("gcp", awsShoot)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Describe("#Get", func() {
+			It("should return the networks of the shoot", func() {
+				networks, err := registry.Get(awsShoot)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(networks.Pods).To(Equal(&podCIDR))
+			})
+
+			It("should fail if no accessor recognizes the shoot", func() {
+				_, err := registry.Get(&gardenv1beta1.Shoot{
+					Spec: gardenv1beta1.ShootSpec{
+						Cloud: gardenv1beta1.Cloud{
+							Packet: &gardenv1beta1.PacketCloud{},
+						},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("default registry", func() {
+		var oldRegistry *ShootNetworksRegistry
+
+		BeforeEach(func() {
+			oldRegistry = DefaultShootNetworksRegistry
+			DefaultShootNetworksRegistry = registry
+		})
+
+		AfterEach(func() {
+			DefaultShootNetworksRegistry = oldRegistry
+		})
+
+		It("should return the networks and zones of the shoot", func() {
+			Expect(GetPodNetwork(awsShoot)).To(Equal(podCIDR))
+			Expect(GetServiceNetwork(awsShoot)).To(Equal(serviceCIDR))
+			Expect(GetNodeNetwork(awsShoot)).To(Equal(nodeCIDR))
+			Expect(GetZones(awsShoot)).To(Equal(zones))
+		})
+
+		It("should fail if a network is not set", func() {
+			awsShoot.Spec.Cloud.AWS.Networks.Nodes = nil
+
+			_, err := GetNodeNetwork(awsShoot)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for shoots of unknown providers", func() {
+			DefaultShootNetworksRegistry = NewShootNetworksRegistry()
+
+			_, err := GetPodNetwork(awsShoot)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -17,6 +17,7 @@ package operatingsystemconfig
 import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/metrics"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -54,6 +55,7 @@ type AddArgs struct {
 // Add adds an operatingsystemconfig controller to the given manager using the given AddArgs.
 func Add(mgr manager.Manager, args AddArgs) error {
 	args.ControllerOptions.Reconciler = NewReconciler(args.Actuator)
+	extensionsmetrics.AddObjectsInErrorStateKind("OperatingSystemConfig", mgr.GetClient(), &extensionsv1alpha1.OperatingSystemConfigList{})
 	return add(mgr, args.ControllerOptions, args.Predicates)
 }
 
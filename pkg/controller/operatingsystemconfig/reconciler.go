@@ -17,10 +17,13 @@ package operatingsystemconfig
 import (
 	"context"
 	"fmt"
+	"time"
+
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -110,7 +113,9 @@ func (r *reconciler) reconcile(ctx context.Context, osc *extensionsv1alpha1.Oper
 	}
 
 	r.logger.Info("Starting the reconciliation of operating system config", "osc", osc.Name)
+	startTime := time.Now()
 	userData, command, units, err := r.actuator.Reconcile(ctx, osc)
+	extensionsmetrics.RecordOperation("OperatingSystemConfig", osc.Spec.Type, operationType, startTime, err)
 	if err != nil {
 		msg := "Error reconciling operating system config"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), osc, operationType, msg))
@@ -170,7 +175,10 @@ func (r *reconciler) delete(ctx context.Context, osc *extensionsv1alpha1.Operati
 	}
 
 	r.logger.Info("Starting the deletion of operating system config", "osc", osc.Name)
-	if err := r.actuator.Delete(ctx, osc); err != nil {
+	startTime := time.Now()
+	err = r.actuator.Delete(ctx, osc)
+	extensionsmetrics.RecordOperation("OperatingSystemConfig", osc.Spec.Type, operationType, startTime, err)
+	if err != nil {
 		msg := "Error deleting operating system config"
 		utilruntime.HandleError(r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), osc, operationType, msg))
 		r.logger.Error(err, msg, "osc", osc.Name)
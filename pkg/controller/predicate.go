@@ -78,9 +78,10 @@ func ShootFailedPredicate(c client.Client) predicate.Predicate {
 	}
 }
 
-// CloudProfileGenerationUpdatePredicate is a predicate for generation updates of cloud profiles.
+// CloudProfileGenerationUpdatePredicate is a predicate for generation updates of cloud profiles. Changes of the
+// annotations are considered as well as providers may read configuration (e.g. versioned machine images) from them.
 func CloudProfileGenerationUpdatePredicate() predicate.Predicate {
-	log := PredicateLog.WithName("cloudprofile-failed")
+	log := PredicateLog.WithName("cloudprofile-generation-update")
 
 	generationChanged := func(log logr.Logger, objectNew, objectOld runtime.Object) bool {
 		newCluster, ok := objectNew.(*extensionsv1alpha1.Cluster)
@@ -92,19 +93,14 @@ func CloudProfileGenerationUpdatePredicate() predicate.Predicate {
 			return false
 		}
 
-		newProfile, err := CloudProfileFromCluster(newCluster)
+		oldDecoded, newDecoded, err := decodeClusters(oldCluster, newCluster)
 		if err != nil {
 			log.Info("Could not retrieve cloud profile from corresponding cluster", "error", err.Error())
 			return false
 		}
 
-		oldProfile, err := CloudProfileFromCluster(oldCluster)
-		if err != nil {
-			log.Info("Could not retrieve cloud profile from corresponding cluster", "error", err.Error())
-			return false
-		}
-
-		return !equality.Semantic.DeepEqual(oldProfile.Spec, newProfile.Spec)
+		return !equality.Semantic.DeepEqual(oldDecoded.CloudProfile.Spec, newDecoded.CloudProfile.Spec) ||
+			!equality.Semantic.DeepEqual(oldDecoded.CloudProfile.Annotations, newDecoded.CloudProfile.Annotations)
 	}
 
 	return predicate.Funcs{
@@ -128,19 +124,13 @@ func ShootGenerationUpdatedPredicate() predicate.Predicate {
 			return false
 		}
 
-		newShoot, err := ShootFromCluster(newCluster)
-		if err != nil {
-			log.Info("Could not retrieve shoot from corresponding cluster", "error", err.Error())
-			return false
-		}
-
-		oldShoot, err := ShootFromCluster(oldCluster)
+		oldDecoded, newDecoded, err := decodeClusters(oldCluster, newCluster)
 		if err != nil {
 			log.Info("Could not retrieve shoot from corresponding cluster", "error", err.Error())
 			return false
 		}
 
-		return oldShoot.Generation != newShoot.Generation
+		return oldDecoded.Shoot.Generation != newDecoded.Shoot.Generation
 	}
 
 	return predicate.Funcs{
@@ -150,6 +140,20 @@ func ShootGenerationUpdatedPredicate() predicate.Predicate {
 	}
 }
 
+// decodeClusters decodes the given old and new Cluster resources via the DefaultClusterCache. Only the resources of
+// the new one are stored so that the outdated ones do not evict them.
+func decodeClusters(oldCluster, newCluster *extensionsv1alpha1.Cluster) (*Cluster, *Cluster, error) {
+	newDecoded, err := DefaultClusterCache.Decode(newCluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	oldDecoded, err := DefaultClusterCache.decodeWithoutStoring(oldCluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	return oldDecoded, newDecoded, nil
+}
+
 var generationChangedPredicate = predicate.Funcs{
 	UpdateFunc: func(e event.UpdateEvent) bool {
 		return e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration()
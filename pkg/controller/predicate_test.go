@@ -150,20 +150,8 @@ var _ = Describe("Predicate", func() {
 			newCloudProfile.Spec.AWS.Constraints.MachineTypes = []v1beta1.MachineType{v1beta1.MachineType{Name: newMachine}}
 
 			updateEvent := event.UpdateEvent{
-				ObjectNew: &v1alpha1.Cluster{
-					Spec: v1alpha1.ClusterSpec{
-						CloudProfile: runtime.RawExtension{
-							Raw: encode(newCloudProfile),
-						},
-					},
-				},
-				ObjectOld: &v1alpha1.Cluster{
-					Spec: v1alpha1.ClusterSpec{
-						CloudProfile: runtime.RawExtension{
-							Raw: encode(oldCloudProfile),
-						},
-					},
-				},
+				ObjectNew: newCluster(newCloudProfile, nil),
+				ObjectOld: newCluster(oldCloudProfile, nil),
 			}
 
 			Expect(controller.CloudProfileGenerationUpdatePredicate().Update(updateEvent)).To(conditionMatcher)
@@ -172,6 +160,57 @@ var _ = Describe("Predicate", func() {
 		Entry("generation update", "machineFoo", "machineBar", BeTrue()),
 	)
 
+	It("#CloudProfileGenerationUpdatePredicate should detect machine image mapping changes", func() {
+		oldCloudProfile := &v1beta1.CloudProfile{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "CloudProfile",
+				APIVersion: "garden.sapcloud.io/v1beta1",
+			},
+			Spec: v1beta1.CloudProfileSpec{
+				AWS: &v1beta1.AWSProfile{
+					Constraints: v1beta1.AWSConstraints{
+						MachineImages: []v1beta1.AWSMachineImageMapping{
+							{
+								Name:    "coreos",
+								Regions: []v1beta1.AWSRegionalMachineImage{{Name: "eu-west-1", AMI: "ami-1"}},
+							},
+						},
+					},
+				},
+			},
+		}
+		newCloudProfile := oldCloudProfile.DeepCopy()
+		newCloudProfile.Spec.AWS.Constraints.MachineImages[0].Regions[0].AMI = "ami-2"
+
+		updateEvent := event.UpdateEvent{
+			ObjectNew: newCluster(newCloudProfile, nil),
+			ObjectOld: newCluster(oldCloudProfile, nil),
+		}
+
+		Expect(controller.CloudProfileGenerationUpdatePredicate().Update(updateEvent)).To(BeTrue())
+	})
+
+	It("#CloudProfileGenerationUpdatePredicate should detect annotation changes", func() {
+		oldCloudProfile := &v1beta1.CloudProfile{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "CloudProfile",
+				APIVersion: "garden.sapcloud.io/v1beta1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{"aws.provider.extensions.gardener.cloud/machine-images": `[{"name":"coreos","version":"1"}]`},
+			},
+		}
+		newCloudProfile := oldCloudProfile.DeepCopy()
+		newCloudProfile.Annotations["aws.provider.extensions.gardener.cloud/machine-images"] = `[{"name":"coreos","version":"2"}]`
+
+		updateEvent := event.UpdateEvent{
+			ObjectNew: newCluster(newCloudProfile, nil),
+			ObjectOld: newCluster(oldCloudProfile, nil),
+		}
+
+		Expect(controller.CloudProfileGenerationUpdatePredicate().Update(updateEvent)).To(BeTrue())
+	})
+
 	DescribeTable("#ShootGenerationUpdatedPredicate",
 		func(oldGeneration, newGeneration int64, conditionMatcher types.GomegaMatcher) {
 			oldShoot := &v1beta1.Shoot{
@@ -187,20 +226,8 @@ var _ = Describe("Predicate", func() {
 			newShoot.Generation = newGeneration
 
 			updateEvent := event.UpdateEvent{
-				ObjectNew: &v1alpha1.Cluster{
-					Spec: v1alpha1.ClusterSpec{
-						Shoot: runtime.RawExtension{
-							Raw: encode(newShoot),
-						},
-					},
-				},
-				ObjectOld: &v1alpha1.Cluster{
-					Spec: v1alpha1.ClusterSpec{
-						Shoot: runtime.RawExtension{
-							Raw: encode(oldShoot),
-						},
-					},
-				},
+				ObjectNew: newCluster(nil, newShoot),
+				ObjectOld: newCluster(nil, oldShoot),
 			}
 
 			Expect(controller.ShootGenerationUpdatedPredicate().Update(updateEvent)).To(conditionMatcher)
@@ -208,6 +235,39 @@ var _ = Describe("Predicate", func() {
 		Entry("no update", int64(1), int64(1), BeFalse()),
 		Entry("generation update", int64(1), int64(2), BeTrue()),
 	)
+
+	It("#ShootGenerationUpdatedPredicate should decode the clusters via the cluster cache", func() {
+		oldShoot := &v1beta1.Shoot{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Shoot",
+				APIVersion: "garden.sapcloud.io/v1beta1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Generation: 1,
+			},
+		}
+		newShoot := oldShoot.DeepCopy()
+		newShoot.Generation = 2
+
+		oldCluster := newCluster(nil, oldShoot)
+		oldCluster.Name, oldCluster.ResourceVersion = "shoot--foo--bar", "1"
+		updatedCluster := newCluster(nil, newShoot)
+		updatedCluster.Name, updatedCluster.ResourceVersion = "shoot--foo--bar", "2"
+		defer controller.DefaultClusterCache.Delete(updatedCluster.Name)
+
+		updateEvent := event.UpdateEvent{
+			ObjectNew: updatedCluster,
+			ObjectOld: oldCluster,
+		}
+
+		Expect(controller.ShootGenerationUpdatedPredicate().Update(updateEvent)).To(BeTrue())
+
+		// The cache keeps the resources of the new cluster, hence they are served even if the raw shoot changes.
+		updatedCluster.Spec.Shoot = runtime.RawExtension{Raw: encode(oldShoot)}
+		decoded, err := controller.DefaultClusterCache.Decode(updatedCluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decoded.Shoot.Generation).To(Equal(int64(2)))
+	})
 })
 
 type extensionDummy struct {
@@ -219,6 +279,26 @@ func (e *extensionDummy) GetExtensionType() string {
 	return e.extensionType
 }
 
+// newCluster returns a Cluster resource containing the given cloud profile and shoot. Empty resources are used for the
+// ones which are not given.
+func newCluster(cloudProfile *v1beta1.CloudProfile, shoot *v1beta1.Shoot) *v1alpha1.Cluster {
+	if cloudProfile == nil {
+		cloudProfile = &v1beta1.CloudProfile{TypeMeta: metav1.TypeMeta{Kind: "CloudProfile", APIVersion: "garden.sapcloud.io/v1beta1"}}
+	}
+	if shoot == nil {
+		shoot = &v1beta1.Shoot{TypeMeta: metav1.TypeMeta{Kind: "Shoot", APIVersion: "garden.sapcloud.io/v1beta1"}}
+	}
+	seed := &v1beta1.Seed{TypeMeta: metav1.TypeMeta{Kind: "Seed", APIVersion: "garden.sapcloud.io/v1beta1"}}
+
+	return &v1alpha1.Cluster{
+		Spec: v1alpha1.ClusterSpec{
+			CloudProfile: runtime.RawExtension{Raw: encode(cloudProfile)},
+			Seed:         runtime.RawExtension{Raw: encode(seed)},
+			Shoot:        runtime.RawExtension{Raw: encode(shoot)},
+		},
+	}
+}
+
 func encode(obj runtime.Object) []byte {
 	data, _ := json.Marshal(obj)
 	return data
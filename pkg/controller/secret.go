@@ -0,0 +1,103 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SecretChecksumAnnotation is the annotation on extension resources that holds the checksum of the data of the
+// referenced secret the resource has last been reconciled with successfully.
+const SecretChecksumAnnotation = "extensions.gardener.cloud/secret-checksum"
+
+// SecretReferenceOf returns the reference to the secret of the given extension resource, or nil if the
+// resource does not reference a secret.
+func SecretReferenceOf(obj runtime.Object) *corev1.SecretReference {
+	switch x := obj.(type) {
+	case *extensionsv1alpha1.Infrastructure:
+		return &x.Spec.SecretRef
+	case *extensionsv1alpha1.Worker:
+		return &x.Spec.SecretRef
+	case *extensionsv1alpha1.ControlPlane:
+		return &x.Spec.SecretRef
+	case *extensionsv1alpha1.BackupBucket:
+		return &x.Spec.SecretRef
+	case *extensionsv1alpha1.BackupEntry:
+		return &x.Spec.SecretRef
+	}
+	return nil
+}
+
+// ComputeSecretChecksum computes the checksum of the data of the given secret.
+func ComputeSecretChecksum(secret *corev1.Secret) string {
+	return util.ComputeChecksum(secret.Data)
+}
+
+// GetReferencedSecretChecksum reads the secret referenced by the given extension resource and returns the checksum
+// of its data.
+func GetReferencedSecretChecksum(ctx context.Context, c client.Client, obj runtime.Object) (string, error) {
+	ref := SecretReferenceOf(obj)
+	if ref == nil {
+		return "", fmt.Errorf("object of type %T does not reference a secret", obj)
+	}
+
+	secret, err := GetSecretByReference(ctx, c, ref)
+	if err != nil {
+		return "", err
+	}
+	return ComputeSecretChecksum(secret), nil
+}
+
+// SecretChecksumChanged returns true if the given checksum differs from the one recorded on the given extension
+// resource.
+func SecretChecksumChanged(obj runtime.Object, checksum string) (bool, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return false, err
+	}
+	return accessor.GetAnnotations()[SecretChecksumAnnotation] != checksum, nil
+}
+
+// EnsureSecretChecksumAnnotation records the given checksum of the referenced secret on the given extension resource.
+// It only issues an update if the recorded checksum differs.
+func EnsureSecretChecksumAnnotation(ctx context.Context, c client.Client, obj runtime.Object, checksum string) error {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	annotations := accessor.GetAnnotations()
+	if annotations[SecretChecksumAnnotation] == checksum {
+		return nil
+	}
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[SecretChecksumAnnotation] = checksum
+	accessor.SetAnnotations(annotations)
+
+	return c.Update(ctx, obj)
+}
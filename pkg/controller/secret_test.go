@@ -0,0 +1,116 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Secret", func() {
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+
+		ctx    = context.TODO()
+		secret *corev1.Secret
+		infra  *extensionsv1alpha1.Infrastructure
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudprovider", Namespace: "shoot"},
+			Data:       map[string][]byte{"accessKeyID": []byte("foo")},
+		}
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot"},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				SecretRef: corev1.SecretReference{Name: secret.Name, Namespace: secret.Namespace},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#SecretReferenceOf", func() {
+		It("should return the secret reference of extension resources", func() {
+			Expect(controller.SecretReferenceOf(infra)).To(Equal(&infra.Spec.SecretRef))
+		})
+
+		It("should return nil for resources without secret reference", func() {
+			Expect(controller.SecretReferenceOf(&extensionsv1alpha1.Network{})).To(BeNil())
+		})
+	})
+
+	Describe("#ComputeSecretChecksum", func() {
+		It("should only depend on the data of the secret", func() {
+			checksum := controller.ComputeSecretChecksum(secret)
+
+			secret.ResourceVersion = "2"
+			Expect(controller.ComputeSecretChecksum(secret)).To(Equal(checksum))
+
+			secret.Data["accessKeyID"] = []byte("bar")
+			Expect(controller.ComputeSecretChecksum(secret)).NotTo(Equal(checksum))
+		})
+	})
+
+	Describe("#GetReferencedSecretChecksum", func() {
+		It("should return the checksum of the referenced secret", func() {
+			c.EXPECT().
+				Get(ctx, kutil.Key(secret.Namespace, secret.Name), gomock.AssignableToTypeOf(&corev1.Secret{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, actual *corev1.Secret) error {
+					*actual = *secret
+					return nil
+				})
+
+			checksum, err := controller.GetReferencedSecretChecksum(ctx, c, infra)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checksum).To(Equal(controller.ComputeSecretChecksum(secret)))
+		})
+	})
+
+	Describe("#EnsureSecretChecksumAnnotation", func() {
+		It("should record the checksum", func() {
+			c.EXPECT().Update(ctx, infra)
+
+			Expect(controller.EnsureSecretChecksumAnnotation(ctx, c, infra, "abc")).To(Succeed())
+			Expect(infra.Annotations).To(HaveKeyWithValue(controller.SecretChecksumAnnotation, "abc"))
+			Expect(controller.SecretChecksumChanged(infra, "abc")).To(BeFalse())
+			Expect(controller.SecretChecksumChanged(infra, "def")).To(BeTrue())
+		})
+
+		It("should not update the resource if the checksum is already recorded", func() {
+			infra.Annotations = map[string]string{controller.SecretChecksumAnnotation: "abc"}
+
+			Expect(controller.EnsureSecretChecksumAnnotation(ctx, c, infra, "abc")).To(Succeed())
+		})
+	})
+})
@@ -15,31 +15,9 @@
 package controller
 
 import (
-	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 )
 
-// GetPodNetwork returns the pod network CIDR of the given Shoot.
-func GetPodNetwork(shoot *gardenv1beta1.Shoot) gardencorev1alpha1.CIDR {
-	cloud := shoot.Spec.Cloud
-	switch {
-	case cloud.AWS != nil:
-		return *cloud.AWS.Networks.K8SNetworks.Pods
-	case cloud.Azure != nil:
-		return *cloud.Azure.Networks.K8SNetworks.Pods
-	case cloud.GCP != nil:
-		return *cloud.GCP.Networks.K8SNetworks.Pods
-	case cloud.OpenStack != nil:
-		return *cloud.OpenStack.Networks.K8SNetworks.Pods
-	case cloud.Alicloud != nil:
-		return *cloud.Alicloud.Networks.K8SNetworks.Pods
-	case cloud.Packet != nil:
-		return *cloud.Packet.Networks.K8SNetworks.Pods
-	default:
-		return ""
-	}
-}
-
 // IsHibernated returns true if the shoot is hibernated, or false otherwise.
 func IsHibernated(shoot *gardenv1beta1.Shoot) bool {
 	return shoot.Spec.Hibernation != nil && shoot.Spec.Hibernation.Enabled
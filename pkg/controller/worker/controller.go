@@ -17,6 +17,7 @@ package worker
 import (
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/metrics"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -60,6 +61,7 @@ func DefaultPredicates(client client.Client, typeName string) []predicate.Predic
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
 	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
+	extensionsmetrics.AddObjectsInErrorStateKind("Worker", mgr.GetClient(), &extensionsv1alpha1.WorkerList{})
 	return add(mgr, args.ControllerOptions, args.Predicates)
 }
 
@@ -16,6 +16,8 @@ package genericactuator
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
@@ -23,19 +25,26 @@ import (
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardenerkubernetes "github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
 )
 
+// annotationClusterAutoscalerReplicas is the annotation on the cluster-autoscaler deployment which remembers its
+// number of replicas while it is paused.
+const annotationClusterAutoscalerReplicas = "worker.extensions.gardener.cloud/cluster-autoscaler-replicas"
+
 type genericActuator struct {
 	logger logr.Logger
 
@@ -44,6 +53,7 @@ type genericActuator struct {
 	mcmSeedChart    util.Chart
 	mcmShootChart   util.Chart
 	imageVector     imagevector.ImageVector
+	deletionOptions worker.DeletionOptions
 
 	client            client.Client
 	clientset         kubernetes.Interface
@@ -54,7 +64,7 @@ type genericActuator struct {
 // NewActuator creates a new Actuator that reconciles
 // Worker resources of Gardener's `extensions.gardener.cloud` API group.
 // It provides a default implementation that allows easier integration of providers.
-func NewActuator(logger logr.Logger, delegateFactory DelegateFactory, mcmName string, mcmSeedChart, mcmShootChart util.Chart, imageVector imagevector.ImageVector) worker.Actuator {
+func NewActuator(logger logr.Logger, delegateFactory DelegateFactory, mcmName string, mcmSeedChart, mcmShootChart util.Chart, imageVector imagevector.ImageVector, deletionOptions worker.DeletionOptions) worker.Actuator {
 	return &genericActuator{
 		logger: logger.WithName("worker-actuator"),
 
@@ -63,6 +73,7 @@ func NewActuator(logger logr.Logger, delegateFactory DelegateFactory, mcmName st
 		mcmSeedChart:    mcmSeedChart,
 		mcmShootChart:   mcmShootChart,
 		imageVector:     imageVector,
+		deletionOptions: deletionOptions,
 	}
 }
 
@@ -96,37 +107,74 @@ func (a *genericActuator) InjectConfig(config *rest.Config) error {
 	return nil
 }
 
-func (a *genericActuator) cleanupMachineDeployments(ctx context.Context, existingMachineDeployments *machinev1alpha1.MachineDeploymentList, wantedMachineDeployments worker.MachineDeployments) error {
-	for _, existingMachineDeployment := range existingMachineDeployments.Items {
-		if !wantedMachineDeployments.HasDeployment(existingMachineDeployment.Name) {
-			if err := a.client.Delete(ctx, &existingMachineDeployment); err != nil {
-				return err
-			}
-		}
+// deleteClusterAutoscaler deletes the cluster-autoscaler deployment in the given namespace and waits until it is gone.
+func (a *genericActuator) deleteClusterAutoscaler(ctx context.Context, namespace string) error {
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: gardencorev1alpha1.DeploymentNameClusterAutoscaler}}
+	if err := a.client.Delete(ctx, deployment); err != nil && !apierrors.IsNotFound(err) {
+		return err
 	}
-	return nil
+	return util.WaitUntilResourceDeleted(ctx, a.client, deployment, 5*time.Second)
 }
 
-func (a *genericActuator) listMachineClassNames(ctx context.Context, namespace string, machineClassList runtime.Object) (sets.String, error) {
-	if err := a.client.List(ctx, &client.ListOptions{Namespace: namespace}, machineClassList); err != nil {
-		return nil, err
+// pauseClusterAutoscaler scales the cluster-autoscaler deployment in the given namespace down to zero. Contrary to
+// deleting it, its object and configuration are kept. The current number of replicas is remembered in an annotation
+// so that the cluster-autoscaler can be resumed later.
+func (a *genericActuator) pauseClusterAutoscaler(ctx context.Context, namespace string) error {
+	deployment := &appsv1.Deployment{}
+	if err := a.client.Get(ctx, kutil.Key(namespace, gardencorev1alpha1.DeploymentNameClusterAutoscaler), deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
 	}
 
-	classNames := sets.NewString()
+	if _, ok := deployment.Annotations[annotationClusterAutoscalerReplicas]; ok {
+		return nil
+	}
 
-	if err := meta.EachListItem(machineClassList, func(machineClass runtime.Object) error {
-		accessor, err := meta.Accessor(machineClass)
-		if err != nil {
-			return err
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	metav1.SetMetaDataAnnotation(&deployment.ObjectMeta, annotationClusterAutoscalerReplicas, strconv.Itoa(int(replicas)))
+	return util.ScaleDeployment(ctx, a.client, deployment, 0)
+}
+
+// resumeClusterAutoscaler scales a previously paused cluster-autoscaler deployment in the given namespace back to its
+// remembered number of replicas. It does nothing if the cluster-autoscaler has not been paused.
+func (a *genericActuator) resumeClusterAutoscaler(ctx context.Context, namespace string) error {
+	deployment := &appsv1.Deployment{}
+	if err := a.client.Get(ctx, kutil.Key(namespace, gardencorev1alpha1.DeploymentNameClusterAutoscaler), deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
 		}
+		return err
+	}
 
-		classNames.Insert(accessor.GetName())
+	value, ok := deployment.Annotations[annotationClusterAutoscalerReplicas]
+	if !ok {
 		return nil
-	}); err != nil {
-		return nil, err
 	}
 
-	return classNames, nil
+	replicas, err := strconv.Atoi(value)
+	if err != nil {
+		return errors.Wrapf(err, "could not parse the remembered replicas of the cluster-autoscaler")
+	}
+
+	delete(deployment.Annotations, annotationClusterAutoscalerReplicas)
+	return util.ScaleDeployment(ctx, a.client, deployment, int32(replicas))
+}
+
+func (a *genericActuator) cleanupMachineDeployments(ctx context.Context, existingMachineDeployments *machinev1alpha1.MachineDeploymentList, wantedMachineDeployments worker.MachineDeployments) error {
+	for _, existingMachineDeployment := range existingMachineDeployments.Items {
+		if !wantedMachineDeployments.HasDeployment(existingMachineDeployment.Name) {
+			if err := a.client.Delete(ctx, &existingMachineDeployment); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 func (a *genericActuator) cleanupMachineClasses(ctx context.Context, namespace string, machineClassList runtime.Object, wantedMachineDeployments worker.MachineDeployments) error {
@@ -22,6 +22,8 @@ import (
 	"time"
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -62,6 +64,24 @@ func (a *genericActuator) Delete(ctx context.Context, worker *extensionsv1alpha1
 		return errors.Wrapf(err, "could not apply machine-controller-manager shoot chart")
 	}
 
+	// If graceful deletion is enabled the machines are drained by the machine-controller-manager before they get
+	// deleted. Only those machines which still exist after the drain timeout has expired are deleted forcefully.
+	if a.deletionOptions.Graceful {
+		if deadline := drainDeadline(worker, a.deletionOptions.DrainTimeout); time.Now().Before(deadline) {
+			done, err := a.drainMachines(ctx, worker, deadline)
+			if err != nil {
+				return err
+			}
+			if !done {
+				return &controllererror.RequeueAfterError{
+					RequeueAfter: machineDeploymentsRolloutRequeueInterval,
+				}
+			}
+		} else {
+			a.logger.Info("Drain timeout expired, deleting remaining machines forcefully", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		}
+	}
+
 	// Mark all existing machines to become forcefully deleted.
 	a.logger.Info("Deleting all machines", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
 	if err := a.markAllMachinesForcefulDeletion(ctx, worker.Namespace); err != nil {
@@ -90,7 +110,7 @@ func (a *genericActuator) Delete(ctx context.Context, worker *extensionsv1alpha1
 	}
 
 	// Wait until all machine resources have been properly deleted.
-	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Minute)
+	timeoutCtx, cancel := context.WithTimeout(ctx, machineResourcesDeletionTimeout(worker))
 	defer cancel()
 
 	if err := a.waitUntilMachineResourcesDeleted(timeoutCtx, worker, workerDelegate); err != nil {
@@ -113,6 +133,72 @@ func (a *genericActuator) Delete(ctx context.Context, worker *extensionsv1alpha1
 	return nil
 }
 
+// machineResourcesDeletionTimeout returns the maximum duration to wait for the deletion of all machine resources of
+// the given worker. It is at least 30 minutes, but longer if the worker requests a longer machine drain timeout.
+func machineResourcesDeletionTimeout(workerObj *extensionsv1alpha1.Worker) time.Duration {
+	timeout := 30 * time.Minute
+
+	lifecycle, err := worker.MachineLifecycleFromAnnotations(workerObj.Annotations)
+	if err != nil {
+		return timeout
+	}
+	if drainTimeout := lifecycle.GetDrainTimeout() + 10*time.Minute; drainTimeout > timeout {
+		return drainTimeout
+	}
+	return timeout
+}
+
+// drainDeadline returns the point in time until which the machines of the given worker may be drained.
+func drainDeadline(worker *extensionsv1alpha1.Worker, drainTimeout time.Duration) time.Time {
+	if worker.DeletionTimestamp == nil {
+		return time.Now().Add(drainTimeout)
+	}
+	return worker.DeletionTimestamp.Add(drainTimeout)
+}
+
+// drainMachines scales all machine deployments of the given worker to zero so that the machine-controller-manager
+// drains and deletes their machines. The cluster-autoscaler is removed beforehand so that it does not scale them up
+// again. It returns true if no machines are left, otherwise it reports the progress in the worker's last operation.
+func (a *genericActuator) drainMachines(ctx context.Context, worker *extensionsv1alpha1.Worker, deadline time.Time) (bool, error) {
+	if err := a.deleteClusterAutoscaler(ctx, worker.Namespace); err != nil {
+		return false, errors.Wrapf(err, "deleting the cluster-autoscaler failed")
+	}
+
+	existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := a.client.List(ctx, client.InNamespace(worker.Namespace), existingMachineDeployments); err != nil {
+		return false, err
+	}
+
+	var numScaledDown int32
+	for _, existingMachineDeployment := range existingMachineDeployments.Items {
+		machineDeployment := existingMachineDeployment.DeepCopy()
+		if machineDeployment.Spec.Replicas != 0 {
+			machineDeployment.Spec.Replicas = 0
+			if err := a.client.Update(ctx, machineDeployment); err != nil {
+				return false, errors.Wrapf(err, "scaling down machine deployment %s failed", machineDeployment.Name)
+			}
+		}
+		if machineDeployment.Status.Replicas == 0 {
+			numScaledDown++
+		}
+	}
+
+	existingMachines := &machinev1alpha1.MachineList{}
+	if err := a.client.List(ctx, client.InNamespace(worker.Namespace), existingMachines); err != nil {
+		return false, err
+	}
+	if len(existingMachines.Items) == 0 {
+		return true, nil
+	}
+
+	description := fmt.Sprintf("Waiting until %d machines have been drained and deleted (remaining machines are deleted forcefully after %s)", len(existingMachines.Items), deadline.UTC().Format(time.RFC3339))
+	a.logger.Info(description, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	if err := a.updateWorkerStatusProgress(ctx, worker, rolloutProgress(numScaledDown, int32(len(existingMachineDeployments.Items))), description); err != nil {
+		return false, errors.Wrapf(err, "failed to update the progress in the Worker resource")
+	}
+	return false, nil
+}
+
 // Mark all existing machines to become forcefully deleted.
 func (a *genericActuator) markAllMachinesForcefulDeletion(ctx context.Context, namespace string) error {
 	// Mark all existing machines to become forcefully deleted.
@@ -159,8 +245,8 @@ func (a *genericActuator) markMachineForcefulDeletion(ctx context.Context, machi
 	return a.client.Update(ctx, machine)
 }
 
-// waitUntilMachineResourcesDeleted waits for a maximum of 30 minutes until all machine resources have been properly
-// deleted by the machine-controller-manager. It polls the status every 5 seconds.
+// waitUntilMachineResourcesDeleted waits until all machine resources have been properly deleted by the
+// machine-controller-manager or the given context is cancelled. It polls the status every 5 seconds.
 // TODO: Parallelise this?
 func (a *genericActuator) waitUntilMachineResourcesDeleted(ctx context.Context, worker *extensionsv1alpha1.Worker, workerDelegate WorkerDelegate) error {
 	var (
@@ -0,0 +1,146 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/mock/go-logr/logr"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ActuatorDelete", func() {
+	const namespace = "shoot--foo--bar"
+
+	Describe("#drainDeadline", func() {
+		const drainTimeout = 2 * time.Hour
+
+		It("should compute the deadline from now if the worker is not being deleted", func() {
+			worker := &extensionsv1alpha1.Worker{}
+
+			Expect(drainDeadline(worker, drainTimeout)).To(BeTemporally("~", time.Now().Add(drainTimeout), time.Second))
+		})
+
+		It("should compute the deadline from the deletion timestamp of the worker", func() {
+			deletionTimestamp := metav1.NewTime(time.Now().Add(-time.Hour))
+			worker := &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &deletionTimestamp}}
+
+			Expect(drainDeadline(worker, drainTimeout)).To(Equal(deletionTimestamp.Add(drainTimeout)))
+		})
+	})
+
+	Describe("#drainMachines", func() {
+		var (
+			ctrl *gomock.Controller
+			ctx  context.Context
+
+			s        *runtime.Scheme
+			worker   *extensionsv1alpha1.Worker
+			deadline time.Time
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			ctx = context.TODO()
+
+			s = runtime.NewScheme()
+			Expect(scheme.AddToScheme(s)).To(Succeed())
+			Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+			Expect(machinev1alpha1.AddToScheme(s)).To(Succeed())
+
+			worker = &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "worker"}}
+			deadline = time.Now().Add(time.Hour)
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		newActuator := func(c client.Client) *genericActuator {
+			logger := logr.NewMockLogger(ctrl)
+			logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			return &genericActuator{logger: logger, client: c}
+		}
+
+		machineDeployment := func(name string, replicas, statusReplicas int32) *machinev1alpha1.MachineDeployment {
+			return &machinev1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+				Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: replicas},
+				Status:     machinev1alpha1.MachineDeploymentStatus{Replicas: statusReplicas},
+			}
+		}
+
+		It("should delete the cluster-autoscaler and scale all machine deployments to zero", func() {
+			c := fake.NewFakeClientWithScheme(s,
+				worker,
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: gardencorev1alpha1.DeploymentNameClusterAutoscaler}},
+				machineDeployment("pool-a", 2, 2),
+				machineDeployment("pool-b", 0, 0),
+				&machinev1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "pool-a-1"}},
+				&machinev1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "pool-a-2"}},
+			)
+
+			done, err := newActuator(c).drainMachines(ctx, worker, deadline)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeFalse())
+
+			err = c.Get(ctx, kutil.Key(namespace, gardencorev1alpha1.DeploymentNameClusterAutoscaler), &appsv1.Deployment{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+			for _, name := range []string{"pool-a", "pool-b"} {
+				actual := &machinev1alpha1.MachineDeployment{}
+				Expect(c.Get(ctx, kutil.Key(namespace, name), actual)).To(Succeed())
+				Expect(actual.Spec.Replicas).To(BeZero())
+			}
+
+			actual := &extensionsv1alpha1.Worker{}
+			Expect(c.Get(ctx, kutil.Key(namespace, "worker"), actual)).To(Succeed())
+			Expect(actual.Status.LastOperation).NotTo(BeNil())
+			Expect(actual.Status.LastOperation.State).To(Equal(gardencorev1alpha1.LastOperationStateProcessing))
+			Expect(actual.Status.LastOperation.Progress).To(Equal(50))
+			Expect(actual.Status.LastOperation.Description).To(ContainSubstring("Waiting until 2 machines have been drained and deleted"))
+		})
+
+		It("should report that the drain is done if no machines are left", func() {
+			c := fake.NewFakeClientWithScheme(s,
+				worker,
+				machineDeployment("pool-a", 2, 0),
+			)
+
+			done, err := newActuator(c).drainMachines(ctx, worker, deadline)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeTrue())
+
+			actual := &machinev1alpha1.MachineDeployment{}
+			Expect(c.Get(ctx, kutil.Key(namespace, "pool-a"), actual)).To(Succeed())
+			Expect(actual.Spec.Replicas).To(BeZero())
+		})
+	})
+})
@@ -17,10 +17,12 @@ package genericactuator
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
 	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	"github.com/pkg/errors"
@@ -34,87 +36,108 @@ import (
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// machineDeploymentsRolloutRequeueInterval is the interval after which the Worker is requeued while the machine
+	// deployments are still being rolled out.
+	machineDeploymentsRolloutRequeueInterval = 15 * time.Second
+)
+
 func (a *genericActuator) Reconcile(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *controller.Cluster) error {
 	workerDelegate, err := a.delegateFactory.WorkerDelegate(ctx, worker, cluster)
 	if err != nil {
 		return errors.Wrapf(err, "could not instantiate actuator context")
 	}
 
-	// Deploy the machine-controller-manager into the cluster.
-	a.logger.Info("Deploying the machine-controller-manager", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-	if err := a.deployMachineControllerManager(ctx, worker, cluster, workerDelegate); err != nil {
-		return err
-	}
-
 	// Generate the desired machine deployments.
 	wantedMachineDeployments, err := workerDelegate.GenerateMachineDeployments(ctx)
 	if err != nil {
 		return errors.Wrapf(err, "failed to generate the machine deployments")
 	}
+	if err := applyMachineLifecycleDefaults(worker, wantedMachineDeployments); err != nil {
+		return err
+	}
 
-	// Get list of existing machine class names and list of used machine class secrets.
-	existingMachineClassNames, err := a.listMachineClassNames(ctx, worker.Namespace, workerDelegate.MachineClassList())
-	if err != nil {
+	// Deploy the machine-controller-manager into the cluster.
+	a.logger.Info("Deploying the machine-controller-manager", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+	if err := a.deployMachineControllerManager(ctx, worker, cluster, workerDelegate, wantedMachineDeployments); err != nil {
 		return err
 	}
 
-	// During the time a rolling update happens we do not want the cluster autoscaler to interfer, hence it
-	// is removed for now.
-	clusterAutoscalerRequired := extensionsv1alpha1helper.ClusterAutoscalerRequired(worker.Spec.Pools)
-	if clusterAutoscalerRequired {
-		rollingUpdate := false
-		// Check whether new machine classes have been computed (resulting in a rolling update of the nodes).
-		for _, machineDeployment := range wantedMachineDeployments {
-			if !existingMachineClassNames.Has(machineDeployment.ClassName) {
-				rollingUpdate = true
-				break
-			}
-		}
+	// Get the list of all existing machine deployments.
+	existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := a.client.List(ctx, &client.ListOptions{Namespace: worker.Namespace}, existingMachineDeployments); err != nil {
+		return err
+	}
 
-		// When the Shoot gets hibernated we want to remove the cluster auto scaler so that it does not interfer
-		// with Gardeners modifications on the machine deployment's replicas fields.
-		if controller.IsHibernated(cluster.Shoot) || rollingUpdate {
-			deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: worker.Namespace, Name: gardencorev1alpha1.DeploymentNameClusterAutoscaler}}
-			if err := a.client.Delete(ctx, deployment); err != nil && !apierrors.IsNotFound(err) {
-				return err
-			}
-			if err := util.WaitUntilResourceDeleted(ctx, a.client, deployment, 5*time.Second); err != nil {
-				return err
-			}
+	// When the Shoot gets hibernated we want to pause the cluster autoscaler so that it does not interfere with
+	// Gardeners modifications on the machine deployment's replicas fields. It is not deleted so that its object and
+	// configuration are kept, and it is resumed as soon as the Shoot is awake again.
+	clusterAutoscalerRequired := extensionsv1alpha1helper.ClusterAutoscalerRequired(worker.Spec.Pools)
+	if clusterAutoscalerRequired && controller.IsHibernated(cluster.Shoot) {
+		if err := a.pauseClusterAutoscaler(ctx, worker.Namespace); err != nil {
+			return errors.Wrapf(err, "failed to pause the cluster autoscaler")
 		}
 	}
 
+	// Machine deployments which get new machine classes are rolled out. Until their rollout has finished they are
+	// reported as not autoscalable while the cluster autoscaler keeps scaling all other machine deployments.
+	changedMachineDeployments := machineDeploymentsWithChangedClass(existingMachineDeployments, wantedMachineDeployments)
+
 	// Deploy generated machine classes.
 	a.logger.Info("Deploying the machine classes", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
 	if err := workerDelegate.DeployMachineClasses(ctx); err != nil {
 		return errors.Wrapf(err, "failed to deploy the machine classes")
 	}
 
-	// Get the list of all existing machine deployments.
-	existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
-	if err := a.client.List(ctx, &client.ListOptions{Namespace: worker.Namespace}, existingMachineDeployments); err != nil {
-		return err
-	}
-
 	// Generate machine deployment configuration based on previously computed list of deployments and deploy them.
 	a.logger.Info("Deploying the machine deployments", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
 	if err := a.deployMachineDeployments(ctx, cluster, worker, existingMachineDeployments, wantedMachineDeployments, workerDelegate.MachineClassKind(), clusterAutoscalerRequired); err != nil {
 		return errors.Wrapf(err, "failed to generate the machine deployment config")
 	}
 
-	// Wait until all generated machine deployments are healthy/available.
-	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Minute)
-	defer cancel()
+	// Check whether all generated machine deployments are healthy/available. If the rollout is still in progress
+	// we report the progress and requeue instead of blocking until it has completed.
+	rollout, err := a.checkMachineDeploymentsRollout(ctx, cluster, worker, wantedMachineDeployments, changedMachineDeployments)
+	if err != nil {
+		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Failed while checking whether all machine deployments are ready: '%s'", err.Error()))
+	}
+	if !rollout.done {
+		now := time.Now()
+		rolloutStart, err := rolloutStartTime(worker, now)
+		if err != nil {
+			return err
+		}
+
+		// The rollout fails if it has not finished within the time derived from the machine lifecycle settings.
+		rolloutTimeout := wantedMachineDeployments.RolloutTimeout()
+		if machineDeploymentsRolloutTimedOut(rolloutStart, now, rolloutTimeout) {
+			// Forget the rollout so that the next reconciliation starts a new one instead of failing immediately.
+			if err := a.updateWorkerStatusRolloutStatus(ctx, worker, nil); err != nil {
+				return errors.Wrapf(err, "failed to reset the rollout in the Worker resource")
+			}
+			return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Failed while waiting for all machine deployments to be ready: rollout did not finish within %s: %s", rolloutTimeout, rollout.description))
+		}
 
-	if err := a.waitUntilMachineDeploymentsAvailable(timeoutCtx, cluster, worker, wantedMachineDeployments); err != nil {
-		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Failed while waiting for all machine deployments to be ready: '%s'", err.Error()))
+		a.logger.Info(rollout.description, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+		if err := a.updateWorkerStatusRollout(ctx, worker, wantedMachineDeployments, rollout, rolloutStart); err != nil {
+			return errors.Wrapf(err, "failed to update the progress in the Worker resource")
+		}
+		return &controllererror.RequeueAfterError{
+			RequeueAfter: machineDeploymentsRolloutRequeueInterval,
+		}
+	}
+
+	// Resume the cluster autoscaler as the Shoot is awake and all of its machines are available.
+	if !controller.IsHibernated(cluster.Shoot) {
+		if err := a.resumeClusterAutoscaler(ctx, worker.Namespace); err != nil {
+			return errors.Wrapf(err, "failed to resume the cluster autoscaler")
+		}
 	}
 
 	// Delete all old machine deployments (i.e. those which were not previously computed but exist in the cluster).
@@ -200,9 +223,11 @@ func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster
 		}
 
 		if err := controller.CreateOrUpdate(ctx, a.client, machineDeployment, func() error {
+			setNodeTemplateAnnotations(machineDeployment, deployment)
+
 			machineDeployment.Spec = machinev1alpha1.MachineDeploymentSpec{
 				Replicas:        int32(replicas),
-				MinReadySeconds: 500,
+				MinReadySeconds: deployment.Lifecycle.GetMinReadySeconds(),
 				Strategy: machinev1alpha1.MachineDeploymentStrategy{
 					Type: machinev1alpha1.RollingUpdateMachineDeploymentStrategyType,
 					RollingUpdate: &machinev1alpha1.RollingUpdateMachineDeployment{
@@ -244,82 +269,207 @@ func (a *genericActuator) deployMachineDeployments(ctx context.Context, cluster
 	return nil
 }
 
-// waitUntilMachineDeploymentsAvailable waits for a maximum of 30 minutes until all the desired <machineDeployments>
-// were marked as healthy/available by the machine-controller-manager. It polls the status every 5 seconds.
-func (a *genericActuator) waitUntilMachineDeploymentsAvailable(ctx context.Context, cluster *controller.Cluster, worker *extensionsv1alpha1.Worker, wantedMachineDeployments worker.MachineDeployments) error {
-	return wait.PollUntil(5*time.Second, func() (bool, error) {
-		var numHealthyDeployments, numUpdated, numDesired, numberOfAwakeMachines int32
+// machineDeploymentsRollout describes the state of the rollout of the wanted machine deployments.
+type machineDeploymentsRollout struct {
+	// done is true if all wanted machine deployments are available (or, if the shoot is hibernated, if all
+	// machines have been removed).
+	done bool
+	// progress is the approximated progress of the rollout in percent.
+	progress int
+	// description is a human-readable description of the current state of the rollout.
+	description string
+	// rollingMachineDeployments maps the names of the machine deployments which are still rolled out, i.e. which got
+	// new machine classes or whose machines are not all up-to-date yet, to their current number of replicas.
+	rollingMachineDeployments map[string]int32
+}
 
-		// Get the list of all existing machine deployments
-		existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
-		if err := a.client.List(ctx, &client.ListOptions{Namespace: worker.Namespace}, existingMachineDeployments); err != nil {
-			return false, err
-		}
+// checkMachineDeploymentsRollout checks whether all the desired <machineDeployments> were marked as healthy/available
+// by the machine-controller-manager. It does not wait but reports the current progress of the rollout.
+// <changedMachineDeployments> are the names of the machine deployments which have just got new machine classes.
+func (a *genericActuator) checkMachineDeploymentsRollout(ctx context.Context, cluster *controller.Cluster, worker *extensionsv1alpha1.Worker, wantedMachineDeployments worker.MachineDeployments, changedMachineDeployments sets.String) (*machineDeploymentsRollout, error) {
+	var numHealthyDeployments, numUpdated, numDesired, numberOfAwakeMachines, numberOfHibernatedDeployments int32
+	rollingMachineDeployments := map[string]int32{}
 
-		// Collect the numbers of ready and desired replicas.
-		for _, existingMachineDeployment := range existingMachineDeployments.Items {
-			// If the shoot get hibernated we want to wait until all machine deployments have been deleted entirely.
-			if controller.IsHibernated(cluster.Shoot) {
-				numberOfAwakeMachines += existingMachineDeployment.Status.Replicas
-				continue
-			}
+	// Get the list of all existing machine deployments
+	existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := a.client.List(ctx, &client.ListOptions{Namespace: worker.Namespace}, existingMachineDeployments); err != nil {
+		return nil, err
+	}
 
-			// If the Shoot is not hibernated we want to wait until all machine deployments have been as many ready
-			// replicas as desired (specified in the .spec.replicas). However, if we see any error in the status of
-			// the deployment then we return it.
-			for _, failedMachine := range existingMachineDeployment.Status.FailedMachines {
-				return false, fmt.Errorf("Machine %s failed: %s", failedMachine.Name, failedMachine.LastOperation.Description)
+	// Collect the numbers of ready and desired replicas.
+	for _, existingMachineDeployment := range existingMachineDeployments.Items {
+		// If the shoot get hibernated we want to wait until all machine deployments have been deleted entirely.
+		if controller.IsHibernated(cluster.Shoot) {
+			numberOfAwakeMachines += existingMachineDeployment.Status.Replicas
+			if existingMachineDeployment.Status.Replicas == 0 {
+				numberOfHibernatedDeployments++
 			}
+			continue
+		}
 
-			// If the Shoot is not hibernated we want to wait until all machine deployments have been as many ready
-			// replicas as desired (specified in the .spec.replicas).
-			for _, machineDeployment := range wantedMachineDeployments {
-				if machineDeployment.Name == existingMachineDeployment.Name {
-					if health.CheckMachineDeployment(&existingMachineDeployment) == nil {
-						numHealthyDeployments++
-					}
-					numDesired += existingMachineDeployment.Spec.Replicas
-					numUpdated += existingMachineDeployment.Status.UpdatedReplicas
-				}
-			}
+		// If the Shoot is not hibernated we want to wait until all machine deployments have been as many ready
+		// replicas as desired (specified in the .spec.replicas). However, if we see any error in the status of
+		// the deployment then we return it.
+		for _, failedMachine := range existingMachineDeployment.Status.FailedMachines {
+			return nil, fmt.Errorf("Machine %s failed: %s", failedMachine.Name, failedMachine.LastOperation.Description)
 		}
 
-		switch {
-		case !controller.IsHibernated(cluster.Shoot):
-			a.logger.Info(fmt.Sprintf("Waiting until all desired machines are ready (%d/%d machine objects up-to-date, %d/%d machinedeployments available)...", numUpdated, numDesired, numHealthyDeployments, len(wantedMachineDeployments)), "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-			if numUpdated >= numDesired && int(numHealthyDeployments) == len(wantedMachineDeployments) {
-				return true, nil
-			}
-		default:
-			if numberOfAwakeMachines == 0 {
-				return true, nil
+		// If the Shoot is not hibernated we want to wait until all machine deployments have been as many ready
+		// replicas as desired (specified in the .spec.replicas).
+		for _, machineDeployment := range wantedMachineDeployments {
+			if machineDeployment.Name == existingMachineDeployment.Name {
+				healthy := health.CheckMachineDeployment(&existingMachineDeployment) == nil
+				if healthy {
+					numHealthyDeployments++
+				}
+				if changedMachineDeployments.Has(existingMachineDeployment.Name) || existingMachineDeployment.Status.UpdatedReplicas < existingMachineDeployment.Spec.Replicas {
+					rollingMachineDeployments[existingMachineDeployment.Name] = existingMachineDeployment.Spec.Replicas
+				}
+				numDesired += existingMachineDeployment.Spec.Replicas
+				numUpdated += existingMachineDeployment.Status.UpdatedReplicas
 			}
-			a.logger.Info(fmt.Sprintf("Waiting until all machines have been hibernated (%d still awake)...", numberOfAwakeMachines), "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
 		}
+	}
+
+	if controller.IsHibernated(cluster.Shoot) {
+		return &machineDeploymentsRollout{
+			done:        numberOfAwakeMachines == 0,
+			progress:    rolloutProgress(numberOfHibernatedDeployments, int32(len(existingMachineDeployments.Items))),
+			description: fmt.Sprintf("Waiting until all machines have been hibernated (%d still awake)", numberOfAwakeMachines),
+		}, nil
+	}
+
+	return &machineDeploymentsRollout{
+		done:        numUpdated >= numDesired && int(numHealthyDeployments) == len(wantedMachineDeployments),
+		progress:    rolloutProgress(minInt32(numUpdated, numDesired)+numHealthyDeployments, numDesired+int32(len(wantedMachineDeployments))),
+		description: fmt.Sprintf("Waiting until all desired machines are ready (%d/%d machine objects up-to-date, %d/%d machinedeployments available)", numUpdated, numDesired, numHealthyDeployments, len(wantedMachineDeployments)),
 
-		return false, nil
-	}, ctx.Done())
+		rollingMachineDeployments: rollingMachineDeployments,
+	}, nil
 }
 
-func (a *genericActuator) updateWorkerStatus(ctx context.Context, worker *extensionsv1alpha1.Worker, machineDeployments worker.MachineDeployments) error {
-	var statusMachineDeployments []extensionsv1alpha1.MachineDeployment
+func (a *genericActuator) updateWorkerStatusProgress(ctx context.Context, worker *extensionsv1alpha1.Worker, progress int, description string) error {
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, worker, func() error {
+		lastOperationType := gardencorev1alpha1helper.ComputeOperationType(worker.ObjectMeta, worker.Status.LastOperation)
+		worker.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, progress, description)
+		return nil
+	})
+}
 
-	for _, machineDeployment := range machineDeployments {
-		statusMachineDeployments = append(statusMachineDeployments, extensionsv1alpha1.MachineDeployment{
-			Name:    machineDeployment.Name,
-			Minimum: machineDeployment.Minimum,
-			Maximum: machineDeployment.Maximum,
+// updateWorkerStatusRollout reports the progress of the given rollout in the Worker status and records its start
+// time in the typed Worker status. Machine deployments which are still rolled out are marked as not autoscalable.
+func (a *genericActuator) updateWorkerStatusRollout(ctx context.Context, workerObj *extensionsv1alpha1.Worker, machineDeployments worker.MachineDeployments, rollout *machineDeploymentsRollout, rolloutStart time.Time) error {
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, workerObj, func() error {
+		lastOperationType := gardencorev1alpha1helper.ComputeOperationType(workerObj.ObjectMeta, workerObj.Status.LastOperation)
+		workerObj.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, rollout.progress, rollout.description)
+		if len(rollout.rollingMachineDeployments) > 0 {
+			workerObj.Status.MachineDeployments = statusMachineDeployments(machineDeployments, rollout.rollingMachineDeployments)
+		}
+		return setRolloutStatus(workerObj, &worker.RolloutStatus{
+			Generation: workerObj.Generation,
+			StartTime:  metav1.NewTime(rolloutStart),
 		})
+	})
+}
+
+// updateWorkerStatusRolloutStatus replaces the rollout recorded in the typed Worker status with the given one.
+func (a *genericActuator) updateWorkerStatusRolloutStatus(ctx context.Context, workerObj *extensionsv1alpha1.Worker, rolloutStatus *worker.RolloutStatus) error {
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, workerObj, func() error {
+		return setRolloutStatus(workerObj, rolloutStatus)
+	})
+}
+
+func (a *genericActuator) updateWorkerStatus(ctx context.Context, workerObj *extensionsv1alpha1.Worker, machineDeployments worker.MachineDeployments) error {
+	// Determine the machine classes which are still referenced by machine deployments, only their machine images
+	// are actually in use.
+	existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := a.client.List(ctx, &client.ListOptions{Namespace: workerObj.Namespace}, existingMachineDeployments); err != nil {
+		return err
+	}
+	usedClassNames := sets.NewString()
+	for _, existingMachineDeployment := range existingMachineDeployments.Items {
+		usedClassNames.Insert(existingMachineDeployment.Spec.Template.Spec.Class.Name)
 	}
 
-	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, worker, func() error {
-		worker.Status.MachineDeployments = statusMachineDeployments
+	state, err := worker.NewWorkerStatus(machineDeployments, usedClassNames).Encode()
+	if err != nil {
+		return err
+	}
+
+	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, workerObj, func() error {
+		workerObj.Status.MachineDeployments = statusMachineDeployments(machineDeployments, nil)
+		workerObj.Status.State = state
 		return nil
 	})
 }
 
 // Helper functions
 
+// rolloutStartTime returns the time the rollout of the current generation of the given Worker has been started. If
+// no rollout of this generation has been recorded yet then a new rollout starts at <now>.
+func rolloutStartTime(workerObj *extensionsv1alpha1.Worker, now time.Time) (time.Time, error) {
+	status, err := worker.WorkerStatusFromWorker(workerObj)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "could not decode the status of the Worker")
+	}
+
+	if startTime := status.RolloutStartTime(workerObj.Generation); startTime != nil {
+		return startTime.Time, nil
+	}
+	return now, nil
+}
+
+// setRolloutStatus records the given rollout in the typed status of the given Worker. The other information of the
+// typed status is kept.
+func setRolloutStatus(workerObj *extensionsv1alpha1.Worker, rolloutStatus *worker.RolloutStatus) error {
+	status, err := worker.WorkerStatusFromWorker(workerObj)
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		status = worker.NewWorkerStatus(nil, nil)
+	}
+
+	status.Rollout = rolloutStatus
+	state, err := status.Encode()
+	if err != nil {
+		return err
+	}
+
+	workerObj.Status.State = state
+	return nil
+}
+
+// machineDeploymentsRolloutTimedOut returns true if the rollout started at <rolloutStart> has been running for more
+// than <timeout> at <now>.
+func machineDeploymentsRolloutTimedOut(rolloutStart, now time.Time, timeout time.Duration) bool {
+	return now.Sub(rolloutStart) > timeout
+}
+
+// rolloutProgress computes the progress in percent for the given number of finished out of total items. As the
+// rollout is still in progress the result is always between 1 and 99.
+func rolloutProgress(finished, total int32) int {
+	if total <= 0 {
+		return 1
+	}
+
+	progress := int(100 * finished / total)
+	switch {
+	case progress < 1:
+		return 1
+	case progress > 99:
+		return 99
+	}
+	return progress
+}
+
+func minInt32(a, b int32) int32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
 func shootIsAwake(isHibernated bool, existingMachineDeployments *machinev1alpha1.MachineDeploymentList) bool {
 	if isHibernated {
 		return false
@@ -342,6 +492,35 @@ func getDeploymentSpecReplicas(existingMachineDeployments *machinev1alpha1.Machi
 	return -1
 }
 
+// applyMachineLifecycleDefaults applies the machine lifecycle settings from the annotations of the given Worker to
+// all <machineDeployments> which do not specify them on their own (e.g. in the provider config of their pool).
+func applyMachineLifecycleDefaults(workerObj *extensionsv1alpha1.Worker, machineDeployments worker.MachineDeployments) error {
+	defaults, err := worker.MachineLifecycleFromAnnotations(workerObj.Annotations)
+	if err != nil {
+		return err
+	}
+
+	for i := range machineDeployments {
+		machineDeployments[i].Lifecycle = machineDeployments[i].Lifecycle.WithDefaults(defaults)
+	}
+	return nil
+}
+
+// setNodeTemplateAnnotations replaces the node template annotations of the given machine deployment object with
+// those computed for the wanted <deployment>. The cluster-autoscaler uses them to scale up machine deployments
+// which have no nodes yet.
+func setNodeTemplateAnnotations(machineDeployment *machinev1alpha1.MachineDeployment, deployment worker.MachineDeployment) {
+	for key := range machineDeployment.Annotations {
+		if strings.HasPrefix(key, worker.NodeTemplateAnnotationPrefix) {
+			delete(machineDeployment.Annotations, key)
+		}
+	}
+
+	for key, value := range worker.NodeTemplateAnnotations(deployment) {
+		metav1.SetMetaDataAnnotation(&machineDeployment.ObjectMeta, key, value)
+	}
+}
+
 func getExistingMachineDeployment(existingMachineDeployments *machinev1alpha1.MachineDeploymentList, name string) *machinev1alpha1.MachineDeployment {
 	for _, machineDeployment := range existingMachineDeployments.Items {
 		if machineDeployment.Name == name {
@@ -350,3 +529,38 @@ func getExistingMachineDeployment(existingMachineDeployments *machinev1alpha1.Ma
 	}
 	return nil
 }
+
+// machineDeploymentsWithChangedClass returns the names of the existing machine deployments which get new machine
+// classes, i.e. which are about to be rolled out.
+func machineDeploymentsWithChangedClass(existingMachineDeployments *machinev1alpha1.MachineDeploymentList, wantedMachineDeployments worker.MachineDeployments) sets.String {
+	out := sets.NewString()
+	for _, deployment := range wantedMachineDeployments {
+		if existingMachineDeployment := getExistingMachineDeployment(existingMachineDeployments, deployment.Name); existingMachineDeployment != nil && existingMachineDeployment.Spec.Template.Spec.Class.Name != deployment.ClassName {
+			out.Insert(deployment.Name)
+		}
+	}
+	return out
+}
+
+// statusMachineDeployments computes the machine deployments for the Worker status which are used to configure the
+// cluster autoscaler. Machine deployments which are still rolled out are reported with their current number of
+// replicas as minimum and maximum so that the cluster autoscaler is not configured to scale them once it is resumed
+// before their rollout has finished.
+func statusMachineDeployments(machineDeployments worker.MachineDeployments, rollingMachineDeployments map[string]int32) []extensionsv1alpha1.MachineDeployment {
+	var out []extensionsv1alpha1.MachineDeployment
+
+	for _, machineDeployment := range machineDeployments {
+		minimum, maximum := machineDeployment.Minimum, machineDeployment.Maximum
+		if replicas, ok := rollingMachineDeployments[machineDeployment.Name]; ok {
+			minimum, maximum = int(replicas), int(replicas)
+		}
+
+		out = append(out, extensionsv1alpha1.MachineDeployment{
+			Name:    machineDeployment.Name,
+			Minimum: minimum,
+			Maximum: maximum,
+		})
+	}
+
+	return out
+}
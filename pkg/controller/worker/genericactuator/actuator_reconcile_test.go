@@ -0,0 +1,353 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ActuatorReconcile", func() {
+	Describe("#rolloutProgress", func() {
+		It("should compute the progress in percent", func() {
+			Expect(rolloutProgress(1, 4)).To(Equal(25))
+		})
+
+		It("should never report less than 1 percent", func() {
+			Expect(rolloutProgress(0, 4)).To(Equal(1))
+			Expect(rolloutProgress(0, 0)).To(Equal(1))
+		})
+
+		It("should never report more than 99 percent", func() {
+			Expect(rolloutProgress(4, 4)).To(Equal(99))
+		})
+	})
+
+	Describe("#machineDeploymentsRolloutTimedOut", func() {
+		var (
+			now     = time.Now()
+			timeout = 30 * time.Minute
+		)
+
+		It("should return false if the rollout has been started recently", func() {
+			Expect(machineDeploymentsRolloutTimedOut(now.Add(-time.Minute), now, timeout)).To(BeFalse())
+		})
+
+		It("should return true if the rollout has been started before the timeout", func() {
+			Expect(machineDeploymentsRolloutTimedOut(now.Add(-timeout-time.Minute), now, timeout)).To(BeTrue())
+		})
+	})
+
+	Describe("#rolloutStartTime", func() {
+		var (
+			now       = time.Now()
+			startTime = metav1.NewTime(now.Add(-time.Hour).Truncate(time.Second))
+			workerObj *extensionsv1alpha1.Worker
+		)
+
+		BeforeEach(func() {
+			workerObj = &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Generation: 2}}
+		})
+
+		It("should start a new rollout if none has been recorded", func() {
+			Expect(rolloutStartTime(workerObj, now)).To(Equal(now))
+		})
+
+		It("should return the start of the recorded rollout of the current generation", func() {
+			Expect(setRolloutStatus(workerObj, &worker.RolloutStatus{Generation: 2, StartTime: startTime})).To(Succeed())
+
+			Expect(rolloutStartTime(workerObj, now)).To(BeTemporally("==", startTime.Time))
+		})
+
+		It("should start a new rollout if the recorded one belongs to a previous generation", func() {
+			Expect(setRolloutStatus(workerObj, &worker.RolloutStatus{Generation: 1, StartTime: startTime})).To(Succeed())
+
+			Expect(rolloutStartTime(workerObj, now)).To(Equal(now))
+		})
+	})
+
+	Describe("#setRolloutStatus", func() {
+		It("should keep the other information of the typed Worker status", func() {
+			state, err := worker.NewWorkerStatus(worker.MachineDeployments{{Name: "pool-a", ClassName: "class-a"}}, nil).Encode()
+			Expect(err).NotTo(HaveOccurred())
+			workerObj := &extensionsv1alpha1.Worker{Status: extensionsv1alpha1.WorkerStatus{DefaultStatus: extensionsv1alpha1.DefaultStatus{State: state}}}
+
+			Expect(setRolloutStatus(workerObj, &worker.RolloutStatus{Generation: 1})).To(Succeed())
+
+			status, err := worker.WorkerStatusFromWorker(workerObj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.MachineDeployments).To(Equal([]worker.MachineDeploymentStatus{{Name: "pool-a", ClassName: "class-a"}}))
+			Expect(status.Rollout).To(Equal(&worker.RolloutStatus{Generation: 1}))
+		})
+	})
+
+	Describe("#checkMachineDeploymentsRollout", func() {
+		var (
+			ctrl *gomock.Controller
+			c    *mockclient.MockClient
+			ctx  context.Context
+
+			namespace = "shoot--foo--bar"
+			workerObj *extensionsv1alpha1.Worker
+			cluster   *controller.Cluster
+			actuator  *genericActuator
+
+			wantedMachineDeployments = worker.MachineDeployments{{Name: "pool-a"}, {Name: "pool-b"}}
+
+			available = machinev1alpha1.MachineDeploymentCondition{
+				Type:   machinev1alpha1.MachineDeploymentAvailable,
+				Status: machinev1alpha1.ConditionTrue,
+			}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			c = mockclient.NewMockClient(ctrl)
+			ctx = context.TODO()
+
+			workerObj = &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Namespace: namespace}}
+			cluster = &controller.Cluster{Shoot: &gardenv1beta1.Shoot{}}
+			actuator = &genericActuator{client: c}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		expectMachineDeployments := func(machineDeployments ...machinev1alpha1.MachineDeployment) {
+			c.EXPECT().List(ctx, &client.ListOptions{Namespace: namespace}, gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{})).
+				SetArg(2, machinev1alpha1.MachineDeploymentList{Items: machineDeployments})
+		}
+
+		machineDeployment := func(name string, replicas, updatedReplicas int32, conditions ...machinev1alpha1.MachineDeploymentCondition) machinev1alpha1.MachineDeployment {
+			return machinev1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: replicas},
+				Status: machinev1alpha1.MachineDeploymentStatus{
+					Replicas:        replicas,
+					UpdatedReplicas: updatedReplicas,
+					Conditions:      conditions,
+				},
+			}
+		}
+
+		It("should report the rollout as done if all machine deployments are available and updated", func() {
+			expectMachineDeployments(
+				machineDeployment("pool-a", 2, 2, available),
+				machineDeployment("pool-b", 1, 1, available),
+			)
+
+			rollout, err := actuator.checkMachineDeploymentsRollout(ctx, cluster, workerObj, wantedMachineDeployments, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rollout.done).To(BeTrue())
+			Expect(rollout.rollingMachineDeployments).To(BeEmpty())
+		})
+
+		It("should report the progress and the rolling machine deployments if the rollout is not done", func() {
+			expectMachineDeployments(
+				machineDeployment("pool-a", 2, 2, available),
+				machineDeployment("pool-b", 4, 1),
+			)
+
+			rollout, err := actuator.checkMachineDeploymentsRollout(ctx, cluster, workerObj, wantedMachineDeployments, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rollout.done).To(BeFalse())
+			// (3 updated machines + 1 available machine deployment) / (6 desired machines + 2 machine deployments)
+			Expect(rollout.progress).To(Equal(50))
+			Expect(rollout.rollingMachineDeployments).To(Equal(map[string]int32{"pool-b": 4}))
+		})
+
+		It("should not report unavailable machine deployments whose machines are up-to-date as rolling", func() {
+			expectMachineDeployments(
+				machineDeployment("pool-a", 2, 2, available),
+				machineDeployment("pool-b", 3, 3),
+			)
+
+			rollout, err := actuator.checkMachineDeploymentsRollout(ctx, cluster, workerObj, wantedMachineDeployments, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rollout.done).To(BeFalse())
+			Expect(rollout.rollingMachineDeployments).To(BeEmpty())
+		})
+
+		It("should report machine deployments which got new machine classes as rolling", func() {
+			expectMachineDeployments(
+				machineDeployment("pool-a", 2, 2, available),
+				machineDeployment("pool-b", 1, 1, available),
+			)
+
+			rollout, err := actuator.checkMachineDeploymentsRollout(ctx, cluster, workerObj, wantedMachineDeployments, sets.NewString("pool-a"))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rollout.rollingMachineDeployments).To(Equal(map[string]int32{"pool-a": 2}))
+		})
+
+		It("should return an error if a machine failed", func() {
+			md := machineDeployment("pool-a", 1, 0)
+			md.Status.FailedMachines = []*machinev1alpha1.MachineSummary{{
+				Name:          "machine",
+				LastOperation: machinev1alpha1.LastOperation{Description: "quota exceeded"},
+			}}
+			expectMachineDeployments(md)
+
+			_, err := actuator.checkMachineDeploymentsRollout(ctx, cluster, workerObj, wantedMachineDeployments, nil)
+
+			Expect(err).To(MatchError("Machine machine failed: quota exceeded"))
+		})
+
+		It("should wait until all machines are gone if the shoot is hibernated", func() {
+			cluster.Shoot.Spec.Hibernation = &gardenv1beta1.Hibernation{Enabled: true}
+			expectMachineDeployments(
+				machineDeployment("pool-a", 0, 0),
+				machineDeployment("pool-b", 3, 3, available),
+			)
+
+			rollout, err := actuator.checkMachineDeploymentsRollout(ctx, cluster, workerObj, wantedMachineDeployments, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rollout.done).To(BeFalse())
+			Expect(rollout.progress).To(Equal(50))
+			Expect(rollout.description).To(ContainSubstring("3 still awake"))
+		})
+
+		It("should report the rollout as done if the shoot is hibernated and all machines are gone", func() {
+			cluster.Shoot.Spec.Hibernation = &gardenv1beta1.Hibernation{Enabled: true}
+			expectMachineDeployments(
+				machineDeployment("pool-a", 0, 0),
+				machineDeployment("pool-b", 0, 0),
+			)
+
+			rollout, err := actuator.checkMachineDeploymentsRollout(ctx, cluster, workerObj, wantedMachineDeployments, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rollout.done).To(BeTrue())
+		})
+	})
+
+	Describe("#machineDeploymentsWithChangedClass", func() {
+		var wantedMachineDeployments = worker.MachineDeployments{
+			{Name: "pool-a", ClassName: "pool-a-new"},
+			{Name: "pool-b", ClassName: "pool-b"},
+		}
+
+		existingMachineDeployments := func(classNames map[string]string) *machinev1alpha1.MachineDeploymentList {
+			list := &machinev1alpha1.MachineDeploymentList{}
+			for name, className := range classNames {
+				md := machinev1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: name}}
+				md.Spec.Template.Spec.Class.Name = className
+				list.Items = append(list.Items, md)
+			}
+			return list
+		}
+
+		It("should return the machine deployments which get new machine classes", func() {
+			existing := existingMachineDeployments(map[string]string{"pool-a": "pool-a-old", "pool-b": "pool-b"})
+
+			Expect(machineDeploymentsWithChangedClass(existing, wantedMachineDeployments)).To(Equal(sets.NewString("pool-a")))
+		})
+
+		It("should not return machine deployments which are newly created", func() {
+			existing := existingMachineDeployments(map[string]string{"pool-b": "pool-b"})
+
+			Expect(machineDeploymentsWithChangedClass(existing, wantedMachineDeployments)).To(BeEmpty())
+		})
+
+		It("should not return machine deployments whose machine classes are unchanged", func() {
+			existing := existingMachineDeployments(map[string]string{"pool-a": "pool-a-new", "pool-b": "pool-b"})
+
+			Expect(machineDeploymentsWithChangedClass(existing, wantedMachineDeployments)).To(BeEmpty())
+		})
+	})
+
+	Describe("#statusMachineDeployments", func() {
+		It("should freeze the machine deployments which are still rolled out", func() {
+			machineDeployments := worker.MachineDeployments{
+				{Name: "pool-a", Minimum: 1, Maximum: 5},
+				{Name: "pool-b", Minimum: 2, Maximum: 3},
+			}
+
+			Expect(statusMachineDeployments(machineDeployments, map[string]int32{"pool-a": 4})).To(Equal([]extensionsv1alpha1.MachineDeployment{
+				{Name: "pool-a", Minimum: 4, Maximum: 4},
+				{Name: "pool-b", Minimum: 2, Maximum: 3},
+			}))
+		})
+	})
+
+	Describe("#updateWorkerStatus", func() {
+		const namespace = "shoot--foo--bar"
+
+		var (
+			ctx = context.TODO()
+
+			machineImage1 = worker.MachineImage{Pool: "pool-a", Name: "coreos", Version: "2023.5.0", ID: "ami-1234"}
+			machineImage2 = worker.MachineImage{Pool: "pool-b", Name: "coreos", Version: "2135.6.0", ID: "ami-5678"}
+		)
+
+		machineDeployment := func(name, className string) *machinev1alpha1.MachineDeployment {
+			md := &machinev1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+			md.Spec.Template.Spec.Class.Name = className
+			return md
+		}
+
+		It("should persist the machine images of the machine classes referenced by machine deployments", func() {
+			s := runtime.NewScheme()
+			Expect(scheme.AddToScheme(s)).To(Succeed())
+			Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+			Expect(machinev1alpha1.AddToScheme(s)).To(Succeed())
+
+			workerObj := &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "worker"}}
+			c := fake.NewFakeClientWithScheme(s,
+				workerObj,
+				machineDeployment("pool-a", "pool-a-abcde"),
+			)
+			actuator := &genericActuator{client: c}
+
+			Expect(actuator.updateWorkerStatus(ctx, workerObj, worker.MachineDeployments{
+				{Name: "pool-a", ClassName: "pool-a-abcde", MachineImage: machineImage1},
+				{Name: "pool-b", ClassName: "pool-b-fghij", MachineImage: machineImage2},
+			})).To(Succeed())
+
+			actual := &extensionsv1alpha1.Worker{}
+			Expect(c.Get(ctx, kutil.Key(namespace, "worker"), actual)).To(Succeed())
+			status, err := worker.WorkerStatusFromWorker(actual)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.MachineImages).To(Equal([]worker.MachineImage{machineImage1}))
+			Expect(status.MachineDeployments).To(Equal([]worker.MachineDeploymentStatus{
+				{Name: "pool-a", ClassName: "pool-a-abcde"},
+				{Name: "pool-b", ClassName: "pool-b-fghij"},
+			}))
+		})
+	})
+})
@@ -0,0 +1,120 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Actuator", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx = context.TODO()
+
+		s *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		s = runtime.NewScheme()
+		Expect(scheme.AddToScheme(s)).To(Succeed())
+	})
+
+	clusterAutoscaler := func(replicas int32, annotations map[string]string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   namespace,
+				Name:        gardencorev1alpha1.DeploymentNameClusterAutoscaler,
+				Annotations: annotations,
+			},
+			Spec: appsv1.DeploymentSpec{Replicas: &replicas},
+		}
+	}
+
+	getClusterAutoscaler := func(c client.Client) *appsv1.Deployment {
+		deployment := &appsv1.Deployment{}
+		Expect(c.Get(ctx, kutil.Key(namespace, gardencorev1alpha1.DeploymentNameClusterAutoscaler), deployment)).To(Succeed())
+		return deployment
+	}
+
+	Describe("#pauseClusterAutoscaler", func() {
+		It("should scale the cluster-autoscaler to zero and remember its replicas", func() {
+			c := fake.NewFakeClientWithScheme(s, clusterAutoscaler(2, nil))
+			actuator := &genericActuator{client: c}
+
+			Expect(actuator.pauseClusterAutoscaler(ctx, namespace)).To(Succeed())
+
+			deployment := getClusterAutoscaler(c)
+			Expect(*deployment.Spec.Replicas).To(BeZero())
+			Expect(deployment.Annotations).To(HaveKeyWithValue(annotationClusterAutoscalerReplicas, "2"))
+		})
+
+		It("should keep the remembered replicas if the cluster-autoscaler is already paused", func() {
+			c := fake.NewFakeClientWithScheme(s, clusterAutoscaler(0, map[string]string{annotationClusterAutoscalerReplicas: "2"}))
+			actuator := &genericActuator{client: c}
+
+			Expect(actuator.pauseClusterAutoscaler(ctx, namespace)).To(Succeed())
+
+			deployment := getClusterAutoscaler(c)
+			Expect(*deployment.Spec.Replicas).To(BeZero())
+			Expect(deployment.Annotations).To(HaveKeyWithValue(annotationClusterAutoscalerReplicas, "2"))
+		})
+
+		It("should do nothing if the cluster-autoscaler does not exist", func() {
+			actuator := &genericActuator{client: fake.NewFakeClientWithScheme(s)}
+
+			Expect(actuator.pauseClusterAutoscaler(ctx, namespace)).To(Succeed())
+		})
+	})
+
+	Describe("#resumeClusterAutoscaler", func() {
+		It("should scale the cluster-autoscaler back to its remembered replicas", func() {
+			c := fake.NewFakeClientWithScheme(s, clusterAutoscaler(0, map[string]string{annotationClusterAutoscalerReplicas: "2"}))
+			actuator := &genericActuator{client: c}
+
+			Expect(actuator.resumeClusterAutoscaler(ctx, namespace)).To(Succeed())
+
+			deployment := getClusterAutoscaler(c)
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(2)))
+			Expect(deployment.Annotations).NotTo(HaveKey(annotationClusterAutoscalerReplicas))
+		})
+
+		It("should do nothing if the cluster-autoscaler has not been paused", func() {
+			c := fake.NewFakeClientWithScheme(s, clusterAutoscaler(1, nil))
+			actuator := &genericActuator{client: c}
+
+			Expect(actuator.resumeClusterAutoscaler(ctx, namespace)).To(Succeed())
+
+			Expect(*getClusterAutoscaler(c).Spec.Replicas).To(Equal(int32(1)))
+		})
+
+		It("should do nothing if the cluster-autoscaler does not exist", func() {
+			actuator := &genericActuator{client: fake.NewFakeClientWithScheme(s)}
+
+			Expect(actuator.resumeClusterAutoscaler(ctx, namespace)).To(Succeed())
+		})
+	})
+})
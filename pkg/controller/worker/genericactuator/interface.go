@@ -42,6 +42,8 @@ type WorkerDelegate interface {
 	DeployMachineClasses(context.Context) error
 
 	// GenerateMachineDeployments generates the configuration for the desired machine deployments.
+	// The machine deployments must contain the machine image (name, version and provider specific image ID) which is
+	// used by their machine class.
 	GenerateMachineDeployments(context.Context) (worker.MachineDeployments, error)
 }
 
@@ -20,6 +20,7 @@ import (
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -33,12 +34,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func (a *genericActuator) deployMachineControllerManager(ctx context.Context, workerObj *extensionsv1alpha1.Worker, cluster *controller.Cluster, workerDelegate WorkerDelegate) error {
+func (a *genericActuator) deployMachineControllerManager(ctx context.Context, workerObj *extensionsv1alpha1.Worker, cluster *controller.Cluster, workerDelegate WorkerDelegate, machineDeployments worker.MachineDeployments) error {
 	mcmValues, err := workerDelegate.GetMachineControllerManagerChartValues(ctx)
 	if err != nil {
 		return err
 	}
 
+	// Inject the machine timeouts computed from the lifecycle settings of the machine deployments.
+	for key, value := range worker.MachineControllerManagerTimeouts(machineDeployments) {
+		mcmValues[key] = value
+	}
+
 	// Generate MCM kubeconfig and inject its checksum into the MCM values.
 	mcmKubeconfigSecret, err := createKubeconfigForMachineControllerManager(ctx, a.client, workerObj.Namespace, a.mcmName)
 	if err != nil {
@@ -0,0 +1,58 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// LabelInterruptible is the label on nodes which run on interruptible capacity, e.g. preemptible VMs (GCP).
+	// AWS spot instances and Azure low-priority VMs are not supported as the vendored machine-controller-manager
+	// cannot create them.
+	LabelInterruptible = "worker.gardener.cloud/interruptible"
+	// TaintKeyInterruptible is the key of the taint on nodes which run on interruptible capacity. Its effect is
+	// `PreferNoSchedule` so that workloads have to opt in by tolerating it, but are not blocked if there is no
+	// other capacity.
+	TaintKeyInterruptible = "worker.gardener.cloud/interruptible"
+)
+
+// InterruptibleLabels returns a copy of the given labels of a worker pool which additionally marks its nodes as
+// running on interruptible capacity.
+func InterruptibleLabels(labels map[string]string) map[string]string {
+	out := make(map[string]string, len(labels)+1)
+	for key, value := range labels {
+		out[key] = value
+	}
+	out[LabelInterruptible] = "true"
+	return out
+}
+
+// InterruptibleTaints returns a copy of the given taints of a worker pool which additionally contains the taint for
+// nodes running on interruptible capacity, unless a taint with the same key is already configured.
+func InterruptibleTaints(taints []corev1.Taint) []corev1.Taint {
+	out := append(make([]corev1.Taint, 0, len(taints)+1), taints...)
+	for _, taint := range taints {
+		if taint.Key == TaintKeyInterruptible {
+			return out
+		}
+	}
+
+	return append(out, corev1.Taint{
+		Key:    TaintKeyInterruptible,
+		Value:  "true",
+		Effect: corev1.TaintEffectPreferNoSchedule,
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	corev1 "k8s.io/api/core/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Interruptible", func() {
+	var interruptibleTaint = corev1.Taint{
+		Key:    worker.TaintKeyInterruptible,
+		Value:  "true",
+		Effect: corev1.TaintEffectPreferNoSchedule,
+	}
+
+	Describe("#InterruptibleLabels", func() {
+		It("should add the label without modifying the given labels", func() {
+			labels := map[string]string{"foo": "bar"}
+
+			Expect(worker.InterruptibleLabels(labels)).To(Equal(map[string]string{
+				"foo":                     "bar",
+				worker.LabelInterruptible: "true",
+			}))
+			Expect(labels).To(Equal(map[string]string{"foo": "bar"}))
+		})
+
+		It("should add the label to empty labels", func() {
+			Expect(worker.InterruptibleLabels(nil)).To(Equal(map[string]string{worker.LabelInterruptible: "true"}))
+		})
+	})
+
+	Describe("#InterruptibleTaints", func() {
+		It("should add the taint without modifying the given taints", func() {
+			taints := []corev1.Taint{{Key: "foo", Value: "bar", Effect: corev1.TaintEffectNoSchedule}}
+
+			Expect(worker.InterruptibleTaints(taints)).To(Equal([]corev1.Taint{taints[0], interruptibleTaint}))
+			Expect(taints).To(HaveLen(1))
+		})
+
+		It("should keep an already configured taint with the same key", func() {
+			taints := []corev1.Taint{{Key: worker.TaintKeyInterruptible, Value: "true", Effect: corev1.TaintEffectNoSchedule}}
+
+			Expect(worker.InterruptibleTaints(taints)).To(Equal(taints))
+		})
+	})
+})
@@ -0,0 +1,181 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// AnnotationMinReadySeconds is the annotation on a Worker containing the default minimum number of seconds a
+	// new machine must be ready before it is considered available.
+	AnnotationMinReadySeconds = "worker.extensions.gardener.cloud/min-ready-seconds"
+	// AnnotationMachineDrainTimeout is the annotation on a Worker containing the default timeout for draining a machine.
+	AnnotationMachineDrainTimeout = "worker.extensions.gardener.cloud/machine-drain-timeout"
+	// AnnotationMachineHealthTimeout is the annotation on a Worker containing the default timeout after which an
+	// unhealthy machine is replaced.
+	AnnotationMachineHealthTimeout = "worker.extensions.gardener.cloud/machine-health-timeout"
+	// AnnotationMachineCreationTimeout is the annotation on a Worker containing the default timeout for creating a
+	// machine and for its node to join the cluster.
+	AnnotationMachineCreationTimeout = "worker.extensions.gardener.cloud/machine-creation-timeout"
+
+	// DefaultMinReadySeconds is the default minimum number of seconds a new machine must be ready.
+	DefaultMinReadySeconds int32 = 500
+	// DefaultMachineDrainTimeout is the default timeout for draining a machine.
+	DefaultMachineDrainTimeout = 20 * time.Minute
+	// DefaultMachineHealthTimeout is the default timeout after which an unhealthy machine is replaced.
+	DefaultMachineHealthTimeout = 10 * time.Minute
+	// DefaultMachineCreationTimeout is the default timeout for creating a machine.
+	DefaultMachineCreationTimeout = 20 * time.Minute
+)
+
+// MachineLifecycle contains the settings for the lifecycle of the machines of a machine deployment. Unset values are
+// defaulted, see the Get* methods.
+type MachineLifecycle struct {
+	// MinReadySeconds is the minimum number of seconds a new machine must be ready before it is considered available.
+	MinReadySeconds *int32
+	// DrainTimeout is the timeout for draining a machine before it is deleted.
+	DrainTimeout *time.Duration
+	// HealthTimeout is the timeout after which an unhealthy machine is replaced.
+	HealthTimeout *time.Duration
+	// CreationTimeout is the timeout for creating a machine and for its node to join the cluster.
+	CreationTimeout *time.Duration
+}
+
+// MachineLifecycleFromAnnotations reads the machine lifecycle settings from the given annotations of a Worker.
+func MachineLifecycleFromAnnotations(annotations map[string]string) (MachineLifecycle, error) {
+	var lifecycle MachineLifecycle
+
+	if value, ok := annotations[AnnotationMinReadySeconds]; ok {
+		minReadySeconds, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || minReadySeconds < 0 {
+			return MachineLifecycle{}, fmt.Errorf("annotation %s must be a non-negative integer, got %q", AnnotationMinReadySeconds, value)
+		}
+		v := int32(minReadySeconds)
+		lifecycle.MinReadySeconds = &v
+	}
+
+	for key, target := range map[string]**time.Duration{
+		AnnotationMachineDrainTimeout:    &lifecycle.DrainTimeout,
+		AnnotationMachineHealthTimeout:   &lifecycle.HealthTimeout,
+		AnnotationMachineCreationTimeout: &lifecycle.CreationTimeout,
+	} {
+		value, ok := annotations[key]
+		if !ok {
+			continue
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration <= 0 {
+			return MachineLifecycle{}, fmt.Errorf("annotation %s must be a positive duration, got %q", key, value)
+		}
+		*target = &duration
+	}
+
+	return lifecycle, nil
+}
+
+// WithDefaults returns a copy of this MachineLifecycle where all unset values are taken from the given defaults.
+func (l MachineLifecycle) WithDefaults(defaults MachineLifecycle) MachineLifecycle {
+	if l.MinReadySeconds == nil {
+		l.MinReadySeconds = defaults.MinReadySeconds
+	}
+	if l.DrainTimeout == nil {
+		l.DrainTimeout = defaults.DrainTimeout
+	}
+	if l.HealthTimeout == nil {
+		l.HealthTimeout = defaults.HealthTimeout
+	}
+	if l.CreationTimeout == nil {
+		l.CreationTimeout = defaults.CreationTimeout
+	}
+	return l
+}
+
+// GetMinReadySeconds returns the minimum number of seconds a new machine must be ready, or the default.
+func (l MachineLifecycle) GetMinReadySeconds() int32 {
+	if l.MinReadySeconds == nil {
+		return DefaultMinReadySeconds
+	}
+	return *l.MinReadySeconds
+}
+
+// GetDrainTimeout returns the timeout for draining a machine, or the default.
+func (l MachineLifecycle) GetDrainTimeout() time.Duration {
+	return durationOrDefault(l.DrainTimeout, DefaultMachineDrainTimeout)
+}
+
+// GetHealthTimeout returns the timeout after which an unhealthy machine is replaced, or the default.
+func (l MachineLifecycle) GetHealthTimeout() time.Duration {
+	return durationOrDefault(l.HealthTimeout, DefaultMachineHealthTimeout)
+}
+
+// GetCreationTimeout returns the timeout for creating a machine, or the default.
+func (l MachineLifecycle) GetCreationTimeout() time.Duration {
+	return durationOrDefault(l.CreationTimeout, DefaultMachineCreationTimeout)
+}
+
+// MachineControllerManagerTimeouts computes the chart values for the timeouts of the machine-controller-manager.
+// The machine-controller-manager only supports timeouts for all of its machines, hence the maximum timeouts of
+// all given machine deployments are used.
+func MachineControllerManagerTimeouts(machineDeployments MachineDeployments) map[string]interface{} {
+	var (
+		drainTimeout    = DefaultMachineDrainTimeout
+		healthTimeout   = DefaultMachineHealthTimeout
+		creationTimeout = DefaultMachineCreationTimeout
+	)
+
+	if len(machineDeployments) > 0 {
+		drainTimeout, healthTimeout, creationTimeout = 0, 0, 0
+	}
+	for _, machineDeployment := range machineDeployments {
+		drainTimeout = maxDuration(drainTimeout, machineDeployment.Lifecycle.GetDrainTimeout())
+		healthTimeout = maxDuration(healthTimeout, machineDeployment.Lifecycle.GetHealthTimeout())
+		creationTimeout = maxDuration(creationTimeout, machineDeployment.Lifecycle.GetCreationTimeout())
+	}
+
+	return map[string]interface{}{
+		"machineDrainTimeout":    drainTimeout.String(),
+		"machineHealthTimeout":   healthTimeout.String(),
+		"machineCreationTimeout": creationTimeout.String(),
+	}
+}
+
+// RolloutTimeout computes the maximum duration of a rollout of the machine deployments. Replacing a machine requires
+// a new machine to be created and to be ready for the minimum number of seconds before the old machine is drained,
+// hence the longest sum of these settings of all machine deployments is used.
+func (m MachineDeployments) RolloutTimeout() time.Duration {
+	var timeout time.Duration
+	for _, machineDeployment := range m {
+		lifecycle := machineDeployment.Lifecycle
+		timeout = maxDuration(timeout, lifecycle.GetCreationTimeout()+time.Duration(lifecycle.GetMinReadySeconds())*time.Second+lifecycle.GetDrainTimeout())
+	}
+	return timeout
+}
+
+func durationOrDefault(duration *time.Duration, defaultDuration time.Duration) time.Duration {
+	if duration == nil {
+		return defaultDuration
+	}
+	return *duration
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MachineLifecycle", func() {
+	Describe("#MachineLifecycleFromAnnotations", func() {
+		It("should return empty settings if no annotations are set", func() {
+			lifecycle, err := worker.MachineLifecycleFromAnnotations(nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lifecycle).To(Equal(worker.MachineLifecycle{}))
+			Expect(lifecycle.GetMinReadySeconds()).To(Equal(worker.DefaultMinReadySeconds))
+			Expect(lifecycle.GetDrainTimeout()).To(Equal(worker.DefaultMachineDrainTimeout))
+			Expect(lifecycle.GetHealthTimeout()).To(Equal(worker.DefaultMachineHealthTimeout))
+			Expect(lifecycle.GetCreationTimeout()).To(Equal(worker.DefaultMachineCreationTimeout))
+		})
+
+		It("should read the settings from the annotations", func() {
+			lifecycle, err := worker.MachineLifecycleFromAnnotations(map[string]string{
+				worker.AnnotationMinReadySeconds:        "300",
+				worker.AnnotationMachineDrainTimeout:    "1h",
+				worker.AnnotationMachineHealthTimeout:   "15m",
+				worker.AnnotationMachineCreationTimeout: "45m",
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lifecycle.GetMinReadySeconds()).To(Equal(int32(300)))
+			Expect(lifecycle.GetDrainTimeout()).To(Equal(time.Hour))
+			Expect(lifecycle.GetHealthTimeout()).To(Equal(15 * time.Minute))
+			Expect(lifecycle.GetCreationTimeout()).To(Equal(45 * time.Minute))
+		})
+
+		It("should fail for invalid values", func() {
+			_, err := worker.MachineLifecycleFromAnnotations(map[string]string{worker.AnnotationMinReadySeconds: "-1"})
+			Expect(err).To(HaveOccurred())
+
+			_, err = worker.MachineLifecycleFromAnnotations(map[string]string{worker.AnnotationMachineDrainTimeout: "foo"})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#WithDefaults", func() {
+		It("should only fill unset values", func() {
+			var (
+				minReadySeconds = int32(100)
+				drainTimeout    = time.Hour
+				healthTimeout   = time.Minute
+			)
+
+			lifecycle := worker.MachineLifecycle{DrainTimeout: &drainTimeout}.WithDefaults(worker.MachineLifecycle{
+				MinReadySeconds: &minReadySeconds,
+				HealthTimeout:   &healthTimeout,
+			})
+
+			Expect(lifecycle).To(Equal(worker.MachineLifecycle{
+				MinReadySeconds: &minReadySeconds,
+				DrainTimeout:    &drainTimeout,
+				HealthTimeout:   &healthTimeout,
+			}))
+		})
+	})
+
+	Describe("#MachineControllerManagerTimeouts", func() {
+		It("should return the defaults if there are no machine deployments", func() {
+			Expect(worker.MachineControllerManagerTimeouts(nil)).To(Equal(map[string]interface{}{
+				"machineDrainTimeout":    "20m0s",
+				"machineHealthTimeout":   "10m0s",
+				"machineCreationTimeout": "20m0s",
+			}))
+		})
+
+		It("should return the maximum timeouts of all machine deployments", func() {
+			var (
+				drainTimeout    = 2 * time.Hour
+				creationTimeout = time.Hour
+			)
+
+			Expect(worker.MachineControllerManagerTimeouts(worker.MachineDeployments{
+				{Lifecycle: worker.MachineLifecycle{DrainTimeout: &drainTimeout}},
+				{Lifecycle: worker.MachineLifecycle{CreationTimeout: &creationTimeout}},
+			})).To(Equal(map[string]interface{}{
+				"machineDrainTimeout":    "2h0m0s",
+				"machineHealthTimeout":   "10m0s",
+				"machineCreationTimeout": "1h0m0s",
+			}))
+		})
+	})
+
+	Describe("#RolloutTimeout", func() {
+		It("should return the longest rollout timeout of all machine deployments", func() {
+			var (
+				minReadySeconds = int32(600)
+				creationTimeout = time.Hour
+			)
+
+			Expect(worker.MachineDeployments{
+				{},
+				{Lifecycle: worker.MachineLifecycle{MinReadySeconds: &minReadySeconds, CreationTimeout: &creationTimeout}},
+			}.RolloutTimeout()).To(Equal(time.Hour + 10*time.Minute + 20*time.Minute))
+		})
+
+		It("should use the default machine lifecycle settings", func() {
+			Expect(worker.MachineDeployments{{}}.RolloutTimeout()).To(Equal(20*time.Minute + 500*time.Second + 20*time.Minute))
+		})
+	})
+})
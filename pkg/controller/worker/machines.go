@@ -16,7 +16,6 @@ package worker
 
 import (
 	"fmt"
-	"math"
 	"regexp"
 	"strconv"
 
@@ -47,6 +46,9 @@ type MachineDeployment struct {
 	Labels         map[string]string
 	Annotations    map[string]string
 	Taints         []corev1.Taint
+	NodeTemplate   *NodeTemplate
+	MachineImage   MachineImage
+	Lifecycle      MachineLifecycle
 }
 
 // MachineDeployments is a list of machine deployments.
@@ -96,15 +98,10 @@ func MachineClassHash(machineClassSpec map[string]interface{}, version string) s
 // index of the current zone (<zoneIndex>) and the number of nodes which must be distributed
 // over the zones (<size>) and returns the number of nodes which should be placed in the zone
 // of index <zoneIndex>.
-// The distribution happens equally. In case of an uneven number <size>, the last zone will have
-// one more node than the others.
+// The distribution happens equally. In case of an uneven number <size>, the first zones will have
+// one more node than the others. Use a `ZoneDistribution` for weighted zones.
 func DistributeOverZones(zoneIndex, size, zoneSize int) int {
-	first := size / zoneSize
-	second := 0
-	if zoneIndex < (size % zoneSize) {
-		second = 1
-	}
-	return first + second
+	return evenZoneDistribution(zoneSize).Distribute(zoneIndex, size)
 }
 
 // DistributePercentOverZones distributes a given percentage value over zones in relation to
@@ -113,26 +110,7 @@ func DistributeOverZones(zoneIndex, size, zoneSize int) int {
 // the weight of a specific zone in relation to the other zones and adapt the given percentage
 // accordingly.
 func DistributePercentOverZones(zoneIndex int, percent string, zoneSize, total int) string {
-	percents, err := strconv.Atoi(percent[:len(percent)-1])
-	if err != nil {
-		panic(fmt.Sprintf("given value %q is not a percent value", percent))
-	}
-
-	var weightedPercents int
-	if total%zoneSize == 0 {
-		// Zones are evenly sized, we don't need to adapt the percentage per zone
-		weightedPercents = percents
-	} else {
-		// Zones are not evenly sized, we need to calculate the ratio of each zone
-		// and modify the percentage depending on that ratio.
-		zoneTotal := DistributeOverZones(zoneIndex, total, zoneSize)
-		absoluteTotalRatio := float64(total) / float64(zoneSize)
-		ratio := 100.0 / absoluteTotalRatio * float64(zoneTotal)
-		// Optimistic rounding up, this will cause an actual max surge / max unavailable percentage to be a bit higher.
-		weightedPercents = int(math.Ceil(ratio * float64(percents) / 100.0))
-	}
-
-	return fmt.Sprintf("%d%%", weightedPercents)
+	return evenZoneDistribution(zoneSize).DistributePositiveIntOrPercent(zoneIndex, intstr.FromString(percent), total).StrVal
 }
 
 // DistributePositiveIntOrPercent distributes a given int or percentage value over zones in relation to
@@ -141,10 +119,7 @@ func DistributePercentOverZones(zoneIndex int, percent string, zoneSize, total i
 // the weight of a specific zone in relation to the other zones and adapt the given percentage
 // accordingly.
 func DistributePositiveIntOrPercent(zoneIndex int, intOrPercent intstr.IntOrString, zoneSize int, total int) intstr.IntOrString {
-	if intOrPercent.Type == intstr.String {
-		return intstr.FromString(DistributePercentOverZones(zoneIndex, intOrPercent.StrVal, zoneSize, total))
-	}
-	return intstr.FromInt(DistributeOverZones(zoneIndex, int(intOrPercent.IntVal), zoneSize))
+	return evenZoneDistribution(zoneSize).DistributePositiveIntOrPercent(zoneIndex, intOrPercent, total)
 }
 
 // DiskSize extracts the numerical component of DiskSize strings, i.e. strings like "10Gi" and
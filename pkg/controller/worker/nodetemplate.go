@@ -0,0 +1,148 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// NodeTemplateAnnotationPrefix is the prefix of all annotations describing the node template of a machine deployment.
+	NodeTemplateAnnotationPrefix = "capacity.cluster-autoscaler.kubernetes.io/"
+	// NodeTemplateAnnotationCPU is the annotation containing the CPU capacity of the nodes of a machine deployment.
+	NodeTemplateAnnotationCPU = NodeTemplateAnnotationPrefix + "cpu"
+	// NodeTemplateAnnotationMemory is the annotation containing the memory capacity of the nodes of a machine deployment.
+	NodeTemplateAnnotationMemory = NodeTemplateAnnotationPrefix + "memory"
+	// NodeTemplateAnnotationGPU is the annotation containing the number of GPUs of the nodes of a machine deployment.
+	NodeTemplateAnnotationGPU = NodeTemplateAnnotationPrefix + "gpu-count"
+	// NodeTemplateAnnotationLabels is the annotation containing the labels of the nodes of a machine deployment.
+	NodeTemplateAnnotationLabels = NodeTemplateAnnotationPrefix + "labels"
+	// NodeTemplateAnnotationTaints is the annotation containing the taints of the nodes of a machine deployment.
+	NodeTemplateAnnotationTaints = NodeTemplateAnnotationPrefix + "taints"
+
+	// ResourceGPU is the name of the GPU resource in the capacity of a node template.
+	ResourceGPU corev1.ResourceName = "nvidia.com/gpu"
+
+	labelInstanceType = "beta.kubernetes.io/instance-type"
+	labelRegion       = "failure-domain.beta.kubernetes.io/region"
+	labelZone         = "failure-domain.beta.kubernetes.io/zone"
+)
+
+// NodeTemplate describes the nodes that are created for a machine deployment. It is published on the machine
+// deployment so that the cluster-autoscaler can scale up a machine deployment which currently has no nodes.
+type NodeTemplate struct {
+	// Capacity is the resource capacity of the nodes.
+	Capacity corev1.ResourceList
+	// InstanceType is the machine type of the nodes.
+	InstanceType string
+	// Region is the region of the nodes.
+	Region string
+	// Zone is the zone of the nodes. It is empty for providers without zones.
+	Zone string
+}
+
+// NodeTemplateForMachineType looks up the machine type with the given <name> in the given list of <machineTypes> and
+// returns the node template for it in the given <region> and <zone>. It returns nil if the machine type is unknown.
+func NodeTemplateForMachineType(machineTypes []gardenv1beta1.MachineType, name, region, zone string) *NodeTemplate {
+	for _, machineType := range machineTypes {
+		if machineType.Name != name {
+			continue
+		}
+
+		capacity := corev1.ResourceList{
+			corev1.ResourceCPU:    machineType.CPU,
+			corev1.ResourceMemory: machineType.Memory,
+		}
+		if !machineType.GPU.IsZero() {
+			capacity[ResourceGPU] = machineType.GPU
+		}
+
+		return &NodeTemplate{
+			Capacity:     capacity,
+			InstanceType: machineType.Name,
+			Region:       region,
+			Zone:         zone,
+		}
+	}
+	return nil
+}
+
+// NodeTemplateAnnotations computes the annotations describing the node template of the given machine deployment. It
+// returns nil if the machine deployment does not have a node template.
+func NodeTemplateAnnotations(deployment MachineDeployment) map[string]string {
+	if deployment.NodeTemplate == nil {
+		return nil
+	}
+
+	annotations := map[string]string{}
+
+	if cpu, ok := deployment.NodeTemplate.Capacity[corev1.ResourceCPU]; ok {
+		annotations[NodeTemplateAnnotationCPU] = cpu.String()
+	}
+	if memory, ok := deployment.NodeTemplate.Capacity[corev1.ResourceMemory]; ok {
+		annotations[NodeTemplateAnnotationMemory] = memory.String()
+	}
+	if gpu, ok := deployment.NodeTemplate.Capacity[ResourceGPU]; ok {
+		annotations[NodeTemplateAnnotationGPU] = gpu.String()
+	}
+
+	labels := make(map[string]string, len(deployment.Labels)+3)
+	for key, value := range deployment.Labels {
+		labels[key] = value
+	}
+	if deployment.NodeTemplate.InstanceType != "" {
+		labels[labelInstanceType] = deployment.NodeTemplate.InstanceType
+	}
+	if deployment.NodeTemplate.Region != "" {
+		labels[labelRegion] = deployment.NodeTemplate.Region
+	}
+	if deployment.NodeTemplate.Zone != "" {
+		labels[labelZone] = deployment.NodeTemplate.Zone
+	}
+	if len(labels) > 0 {
+		annotations[NodeTemplateAnnotationLabels] = joinSorted(labels, func(key, value string) string {
+			return fmt.Sprintf("%s=%s", key, value)
+		})
+	}
+
+	if len(deployment.Taints) > 0 {
+		taints := make([]string, 0, len(deployment.Taints))
+		for _, taint := range deployment.Taints {
+			taints = append(taints, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect))
+		}
+		annotations[NodeTemplateAnnotationTaints] = strings.Join(taints, ",")
+	}
+
+	return annotations
+}
+
+func joinSorted(m map[string]string, format func(key, value string) string) string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	out := make([]string, 0, len(keys))
+	for _, key := range keys {
+		out = append(out, format(key, m[key]))
+	}
+	return strings.Join(out, ",")
+}
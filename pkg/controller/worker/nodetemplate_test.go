@@ -0,0 +1,92 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("NodeTemplate", func() {
+	var machineTypes = []gardenv1beta1.MachineType{
+		{
+			Name:   "small",
+			CPU:    resource.MustParse("2"),
+			GPU:    resource.MustParse("0"),
+			Memory: resource.MustParse("8Gi"),
+		},
+		{
+			Name:   "gpu",
+			CPU:    resource.MustParse("8"),
+			GPU:    resource.MustParse("1"),
+			Memory: resource.MustParse("61Gi"),
+		},
+	}
+
+	Describe("#NodeTemplateForMachineType", func() {
+		It("should return nil for an unknown machine type", func() {
+			Expect(worker.NodeTemplateForMachineType(machineTypes, "foo", "region", "zone")).To(BeNil())
+		})
+
+		It("should return the node template without GPUs", func() {
+			Expect(worker.NodeTemplateForMachineType(machineTypes, "small", "region", "zone")).To(Equal(&worker.NodeTemplate{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("8Gi"),
+				},
+				InstanceType: "small",
+				Region:       "region",
+				Zone:         "zone",
+			}))
+		})
+
+		It("should return the node template with GPUs", func() {
+			nodeTemplate := worker.NodeTemplateForMachineType(machineTypes, "gpu", "region", "")
+
+			Expect(nodeTemplate.Capacity).To(HaveKeyWithValue(worker.ResourceGPU, resource.MustParse("1")))
+			Expect(nodeTemplate.Zone).To(BeEmpty())
+		})
+	})
+
+	Describe("#NodeTemplateAnnotations", func() {
+		It("should return nil if there is no node template", func() {
+			Expect(worker.NodeTemplateAnnotations(worker.MachineDeployment{})).To(BeNil())
+		})
+
+		It("should compute the annotations", func() {
+			deployment := worker.MachineDeployment{
+				Labels: map[string]string{"foo": "bar"},
+				Taints: []corev1.Taint{
+					{Key: "key1", Value: "value1", Effect: corev1.TaintEffectNoSchedule},
+					{Key: "key2", Value: "value2", Effect: corev1.TaintEffectNoExecute},
+				},
+				NodeTemplate: worker.NodeTemplateForMachineType(machineTypes, "gpu", "eu-west-1", "eu-west-1a"),
+			}
+
+			Expect(worker.NodeTemplateAnnotations(deployment)).To(Equal(map[string]string{
+				worker.NodeTemplateAnnotationCPU:    "8",
+				worker.NodeTemplateAnnotationMemory: "61Gi",
+				worker.NodeTemplateAnnotationGPU:    "1",
+				worker.NodeTemplateAnnotationLabels: "beta.kubernetes.io/instance-type=gpu,failure-domain.beta.kubernetes.io/region=eu-west-1,failure-domain.beta.kubernetes.io/zone=eu-west-1a,foo=bar",
+				worker.NodeTemplateAnnotationTaints: "key1=value1:NoSchedule,key2=value2:NoExecute",
+			}))
+		})
+	})
+})
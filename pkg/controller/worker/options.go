@@ -15,6 +15,9 @@
 package worker
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/spf13/pflag"
 )
 
@@ -22,12 +25,25 @@ const (
 	// DeployCRDsFlag is the name of the command line flag to specify whether the worker CRDs
 	// should be deployed or not.
 	DeployCRDsFlag = "deploy-crds"
+	// GracefulDeletionFlag is the name of the command line flag to specify whether the machines of a
+	// worker should be drained before they are deleted.
+	GracefulDeletionFlag = "graceful-deletion"
+	// DrainTimeoutFlag is the name of the command line flag to specify the maximum duration for draining
+	// the machines of a worker before they are deleted forcefully.
+	DrainTimeoutFlag = "drain-timeout"
+
+	// DefaultDrainTimeout is the default maximum duration for draining the machines of a worker.
+	DefaultDrainTimeout = 2 * time.Hour
 )
 
 // Options are command line options that can be set for controller.Options.
 type Options struct {
 	// DeployCRDs defines whether to ignore the operation annotation or not.
 	DeployCRDs bool
+	// GracefulDeletion defines whether the machines of a worker are drained before they are deleted.
+	GracefulDeletion bool
+	// DrainTimeout is the maximum duration for draining the machines of a worker.
+	DrainTimeout time.Duration
 
 	config *Config
 }
@@ -35,11 +51,27 @@ type Options struct {
 // AddFlags implements Flagger.AddFlags.
 func (c *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&c.DeployCRDs, DeployCRDsFlag, c.DeployCRDs, "Deploy the required worker CRDs.")
+	fs.BoolVar(&c.GracefulDeletion, GracefulDeletionFlag, c.GracefulDeletion, "Drain the machines of a worker before deleting them instead of deleting them forcefully.")
+	fs.DurationVar(&c.DrainTimeout, DrainTimeoutFlag, c.DrainTimeout, "Maximum duration for draining the machines of a worker before they are deleted forcefully (only used with graceful deletion).")
 }
 
 // Complete implements Completer.Complete.
 func (c *Options) Complete() error {
-	c.config = &Config{c.DeployCRDs}
+	drainTimeout := c.DrainTimeout
+	if drainTimeout == 0 {
+		drainTimeout = DefaultDrainTimeout
+	}
+	if drainTimeout < 0 {
+		return fmt.Errorf("--%s must not be negative", DrainTimeoutFlag)
+	}
+
+	c.config = &Config{
+		DeployCRDs: c.DeployCRDs,
+		Deletion: DeletionOptions{
+			Graceful:     c.GracefulDeletion,
+			DrainTimeout: drainTimeout,
+		},
+	}
 	return nil
 }
 
@@ -52,9 +84,26 @@ func (c *Options) Completed() *Config {
 type Config struct {
 	// DeployCRDs defines whether to ignore the operation annotation or not.
 	DeployCRDs bool
+	// Deletion are the options for deleting the machines of a worker.
+	Deletion DeletionOptions
 }
 
 // Apply sets the values of this Config in the given controller.Options.
 func (c *Config) Apply(ignore *bool) {
 	*ignore = c.DeployCRDs
 }
+
+// ApplyDeletion sets the deletion options of this Config in the given DeletionOptions.
+func (c *Config) ApplyDeletion(opts *DeletionOptions) {
+	*opts = c.Deletion
+}
+
+// DeletionOptions configure how the machines of a worker are deleted.
+type DeletionOptions struct {
+	// Graceful defines whether the machines are drained before they are deleted. If false, all machines are
+	// deleted forcefully right away.
+	Graceful bool
+	// DrainTimeout is the maximum duration (counted from the deletion of the worker) for draining the machines.
+	// Machines which still exist afterwards are deleted forcefully.
+	DrainTimeout time.Duration
+}
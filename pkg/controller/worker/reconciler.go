@@ -17,9 +17,12 @@ package worker
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	extensionsmetrics "github.com/gardener/gardener-extensions/pkg/metrics"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -102,7 +105,15 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		}
 
 		r.logger.Info("Starting the deletion of worker", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
-		if err := r.actuator.Delete(r.ctx, worker, cluster); err != nil {
+		startTime := time.Now()
+		err = r.actuator.Delete(r.ctx, worker, cluster)
+		extensionsmetrics.RecordOperation("Worker", worker.Spec.Type, operationType, startTime, err)
+		if err != nil {
+			if isInProgress(err) {
+				r.logger.Info("Deletion of worker is still in progress", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+				return extensionscontroller.ReconcileErr(err)
+			}
+
 			msg := "Error deleting worker"
 			utilruntime.HandleError(r.updateStatusError(r.ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
 			r.logger.Error(err, msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
@@ -129,12 +140,25 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
+	secretChecksum, err := extensionscontroller.GetReferencedSecretChecksum(r.ctx, r.client, worker)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	operationType := gardencorev1alpha1helper.ComputeOperationType(worker.ObjectMeta, worker.Status.LastOperation)
 	if err := r.updateStatusProcessing(r.ctx, worker, operationType, "Reconciling the worker"); err != nil {
 		return reconcile.Result{}, err
 	}
 
-	if err := r.actuator.Reconcile(r.ctx, worker, cluster); err != nil {
+	startTime := time.Now()
+	err = r.actuator.Reconcile(r.ctx, worker, cluster)
+	extensionsmetrics.RecordOperation("Worker", worker.Spec.Type, operationType, startTime, err)
+	if err != nil {
+		if isInProgress(err) {
+			r.logger.Info("Reconciliation of worker is still in progress", "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
+			return extensionscontroller.ReconcileErr(err)
+		}
+
 		msg := "Error reconciling worker"
 		utilruntime.HandleError(r.updateStatusError(r.ctx, extensionscontroller.ReconcileErrCauseOrErr(err), worker, operationType, msg))
 		r.logger.Error(err, msg, "worker", fmt.Sprintf("%s/%s", worker.Namespace, worker.Name))
@@ -146,13 +170,30 @@ func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 	if err := r.updateStatusSuccess(r.ctx, worker, operationType, msg); err != nil {
 		return reconcile.Result{}, err
 	}
+	if err := extensionscontroller.EnsureSecretChecksumAnnotation(r.ctx, r.client, worker, secretChecksum); err != nil {
+		return reconcile.Result{}, err
+	}
 
 	return reconcile.Result{}, nil
 }
 
+// isInProgress returns true if the given error is a RequeueAfterError without a cause, i.e., if the actuator
+// has not failed but wants to be called again later because the operation is still in progress. In this case
+// the actuator is responsible for reporting its progress in the Worker's last operation.
+func isInProgress(err error) bool {
+	requeueAfter, ok := err.(*controllererror.RequeueAfterError)
+	return ok && requeueAfter.Cause == nil
+}
+
 func (r *reconciler) updateStatusProcessing(ctx context.Context, worker *extensionsv1alpha1.Worker, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, worker, func() error {
-		worker.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
+		// Keep the progress reported by the actuator if the operation is still in progress (i.e., if this is a requeue
+		// of an unfinished reconciliation or deletion).
+		progress := 1
+		if lastOp := worker.Status.LastOperation; lastOp != nil && lastOp.Type == lastOperationType && lastOp.State == gardencorev1alpha1.LastOperationStateProcessing {
+			progress, description = lastOp.Progress, lastOp.Description
+		}
+		worker.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, progress, description)
 		return nil
 	})
 }
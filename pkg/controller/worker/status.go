@@ -0,0 +1,148 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"encoding/json"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// WorkerStatusAPIVersion is the API version of the typed Worker status.
+	WorkerStatusAPIVersion = "worker.extensions.gardener.cloud/v1alpha1"
+	// WorkerStatusKind is the kind of the typed Worker status.
+	WorkerStatusKind = "WorkerStatus"
+)
+
+// WorkerStatus contains information about the machine images and machine classes which are actually used by the
+// machines of a Worker. As the Worker resource does not offer a dedicated provider status field it is persisted
+// in the `.status.state` field of the Worker.
+type WorkerStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// MachineImages is a list of machine images which are used by the machine classes that are still referenced by
+	// machine deployments.
+	MachineImages []MachineImage `json:"machineImages,omitempty"`
+	// MachineDeployments is a list of machine deployments together with the machine classes they use.
+	MachineDeployments []MachineDeploymentStatus `json:"machineDeployments,omitempty"`
+	// Rollout is the rollout of the machine deployments which is currently in progress, if any.
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+}
+
+// RolloutStatus describes a rollout of the machine deployments of a Worker.
+type RolloutStatus struct {
+	// Generation is the generation of the Worker whose machine deployments are rolled out.
+	Generation int64 `json:"generation"`
+	// StartTime is the time the rollout has been started.
+	StartTime metav1.Time `json:"startTime"`
+}
+
+// MachineImage is a machine image which is used by the machine classes of a worker pool.
+type MachineImage struct {
+	// Pool is the name of the worker pool.
+	Pool string `json:"pool"`
+	// Name is the logical name of the machine image.
+	Name string `json:"name"`
+	// Version is the logical version of the machine image.
+	Version string `json:"version"`
+	// ID is the provider specific ID of the machine image, e.g. an AMI.
+	ID string `json:"id"`
+}
+
+// MachineDeploymentStatus is a machine deployment together with the machine class it uses.
+type MachineDeploymentStatus struct {
+	// Name is the name of the machine deployment.
+	Name string `json:"name"`
+	// ClassName is the name of the machine class used by the machine deployment.
+	ClassName string `json:"className"`
+}
+
+// NewWorkerStatus computes the typed Worker status for the given machine deployments. Only the machine images of
+// the machine classes in <usedClassNames>, i.e. those which are still referenced by machine deployments, are reported.
+func NewWorkerStatus(machineDeployments MachineDeployments, usedClassNames sets.String) *WorkerStatus {
+	status := &WorkerStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: WorkerStatusAPIVersion,
+			Kind:       WorkerStatusKind,
+		},
+	}
+
+	for _, machineDeployment := range machineDeployments {
+		status.MachineDeployments = append(status.MachineDeployments, MachineDeploymentStatus{
+			Name:      machineDeployment.Name,
+			ClassName: machineDeployment.ClassName,
+		})
+
+		if usedClassNames.Has(machineDeployment.ClassName) && !status.hasMachineImage(machineDeployment.MachineImage) {
+			status.MachineImages = append(status.MachineImages, machineDeployment.MachineImage)
+		}
+	}
+
+	return status
+}
+
+// Encode encodes the Worker status so that it can be stored in the `.status.state` field of a Worker.
+func (s *WorkerStatus) Encode() (string, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// UsesMachineImage checks whether the machine image with the given name and version is used by any worker pool.
+func (s *WorkerStatus) UsesMachineImage(name, version string) bool {
+	for _, machineImage := range s.MachineImages {
+		if machineImage.Name == name && machineImage.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *WorkerStatus) hasMachineImage(machineImage MachineImage) bool {
+	for _, m := range s.MachineImages {
+		if m == machineImage {
+			return true
+		}
+	}
+	return false
+}
+
+// RolloutStartTime returns the time the rollout of the given generation has been started, or nil if no rollout of
+// this generation is in progress.
+func (s *WorkerStatus) RolloutStartTime(generation int64) *metav1.Time {
+	if s == nil || s.Rollout == nil || s.Rollout.Generation != generation {
+		return nil
+	}
+	return &s.Rollout.StartTime
+}
+
+// WorkerStatusFromWorker decodes the typed Worker status from the `.status.state` field of the given Worker. It
+// returns nil if no status has been persisted yet.
+func WorkerStatusFromWorker(worker *extensionsv1alpha1.Worker) (*WorkerStatus, error) {
+	if len(worker.Status.State) == 0 {
+		return nil, nil
+	}
+
+	status := &WorkerStatus{}
+	if err := json.Unmarshal([]byte(worker.Status.State), status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
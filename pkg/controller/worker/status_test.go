@@ -0,0 +1,97 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var _ = Describe("WorkerStatus", func() {
+	var (
+		machineImage1 = worker.MachineImage{Pool: "pool1", Name: "coreos", Version: "2023.5.0", ID: "ami-1234"}
+		machineImage2 = worker.MachineImage{Pool: "pool2", Name: "coreos", Version: "2135.6.0", ID: "ami-5678"}
+
+		machineDeployments = worker.MachineDeployments{
+			{Name: "shoot--foo--bar-pool1-z1", ClassName: "shoot--foo--bar-pool1-z1-abcde", MachineImage: machineImage1},
+			{Name: "shoot--foo--bar-pool1-z2", ClassName: "shoot--foo--bar-pool1-z2-abcde", MachineImage: machineImage1},
+			{Name: "shoot--foo--bar-pool2-z1", ClassName: "shoot--foo--bar-pool2-z1-fghij", MachineImage: machineImage2},
+		}
+		usedClassNames = sets.NewString("shoot--foo--bar-pool1-z1-abcde", "shoot--foo--bar-pool1-z2-abcde", "shoot--foo--bar-pool2-z1-fghij")
+	)
+
+	Describe("#NewWorkerStatus", func() {
+		It("should compute the typed status", func() {
+			status := worker.NewWorkerStatus(machineDeployments, usedClassNames)
+
+			Expect(status.APIVersion).To(Equal(worker.WorkerStatusAPIVersion))
+			Expect(status.Kind).To(Equal(worker.WorkerStatusKind))
+			Expect(status.MachineImages).To(Equal([]worker.MachineImage{machineImage1, machineImage2}))
+			Expect(status.MachineDeployments).To(Equal([]worker.MachineDeploymentStatus{
+				{Name: "shoot--foo--bar-pool1-z1", ClassName: "shoot--foo--bar-pool1-z1-abcde"},
+				{Name: "shoot--foo--bar-pool1-z2", ClassName: "shoot--foo--bar-pool1-z2-abcde"},
+				{Name: "shoot--foo--bar-pool2-z1", ClassName: "shoot--foo--bar-pool2-z1-fghij"},
+			}))
+		})
+
+		It("should only report the machine images of the machine classes which are still referenced", func() {
+			status := worker.NewWorkerStatus(machineDeployments, sets.NewString("shoot--foo--bar-pool2-z1-fghij"))
+
+			Expect(status.MachineImages).To(Equal([]worker.MachineImage{machineImage2}))
+		})
+	})
+
+	Describe("#UsesMachineImage", func() {
+		It("should detect whether a machine image is used", func() {
+			status := worker.NewWorkerStatus(machineDeployments, usedClassNames)
+
+			Expect(status.UsesMachineImage("coreos", "2023.5.0")).To(BeTrue())
+			Expect(status.UsesMachineImage("coreos", "1967.6.0")).To(BeFalse())
+			Expect(status.UsesMachineImage("ubuntu", "2023.5.0")).To(BeFalse())
+		})
+	})
+
+	Describe("#WorkerStatusFromWorker", func() {
+		It("should return nil if no status has been persisted", func() {
+			status, err := worker.WorkerStatusFromWorker(&extensionsv1alpha1.Worker{})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(BeNil())
+		})
+
+		It("should decode the encoded status", func() {
+			status := worker.NewWorkerStatus(machineDeployments, usedClassNames)
+			state, err := status.Encode()
+			Expect(err).NotTo(HaveOccurred())
+
+			w := &extensionsv1alpha1.Worker{}
+			w.Status.State = state
+
+			Expect(worker.WorkerStatusFromWorker(w)).To(Equal(status))
+		})
+
+		It("should fail if the state cannot be decoded", func() {
+			w := &extensionsv1alpha1.Worker{}
+			w.Status.State = "foo"
+
+			_, err := worker.WorkerStatusFromWorker(w)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
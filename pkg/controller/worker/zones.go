@@ -0,0 +1,154 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// AnnotationExcludedZones is the annotation on a Worker containing a comma-separated list of zones which are
+// temporarily excluded from the distribution of machines, e.g. during an outage of the cloud provider in these zones.
+// The machines of the excluded zones are distributed over the remaining zones of a worker pool so that its total
+// capacity is kept.
+const AnnotationExcludedZones = "worker.extensions.gardener.cloud/excluded-zones"
+
+// ExcludedZonesFromAnnotations returns the zones which are excluded by the given annotations of a Worker.
+func ExcludedZonesFromAnnotations(annotations map[string]string) sets.String {
+	excludedZones := sets.NewString()
+	for _, zone := range strings.Split(annotations[AnnotationExcludedZones], ",") {
+		if zone = strings.TrimSpace(zone); len(zone) > 0 {
+			excludedZones.Insert(zone)
+		}
+	}
+	return excludedZones
+}
+
+// ZoneDistribution distributes the machines of a worker pool over its zones according to the weights of the zones.
+// Without weights and excluded zones it distributes the machines evenly, see `DistributeOverZones` and
+// `DistributePositiveIntOrPercent`.
+type ZoneDistribution struct {
+	weights     []int
+	totalWeight int
+}
+
+// NewZoneDistribution creates a new distribution for the given zones. Zones without weight have a weight of 1.
+// Excluded zones do not get any machines, unless all zones are excluded in which case the exclusion is ignored.
+func NewZoneDistribution(zones []string, weights map[string]int, excludedZones sets.String) *ZoneDistribution {
+	d := &ZoneDistribution{weights: make([]int, len(zones))}
+
+	for i, zone := range zones {
+		weight, ok := weights[zone]
+		if !ok {
+			weight = 1
+		}
+		if !excludedZones.Has(zone) && weight > 0 {
+			d.weights[i] = weight
+			d.totalWeight += weight
+		}
+	}
+
+	if d.totalWeight == 0 {
+		for i := range d.weights {
+			d.weights[i] = 1
+		}
+		d.totalWeight = len(zones)
+	}
+
+	return d
+}
+
+// evenZoneDistribution creates a new distribution for the given number of zones which all have the same weight.
+func evenZoneDistribution(zoneSize int) *ZoneDistribution {
+	d := &ZoneDistribution{weights: make([]int, zoneSize), totalWeight: zoneSize}
+	for i := range d.weights {
+		d.weights[i] = 1
+	}
+	return d
+}
+
+// Distribute determines how many of the given number of nodes (<size>) should be placed in the zone with the given
+// index. The nodes are distributed proportionally to the weights of the zones. The remaining nodes are assigned to the
+// zones with the largest remainders, and in case of equal remainders to the zones with the lower indices.
+func (d *ZoneDistribution) Distribute(zoneIndex, size int) int {
+	if zoneIndex < 0 || zoneIndex >= len(d.weights) {
+		return 0
+	}
+
+	var (
+		shares     = make([]int, len(d.weights))
+		remainders = make([]int, len(d.weights))
+		order      = make([]int, len(d.weights))
+		assigned   int
+	)
+
+	for i, weight := range d.weights {
+		shares[i] = size * weight / d.totalWeight
+		remainders[i] = size * weight % d.totalWeight
+		order[i] = i
+		assigned += shares[i]
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return remainders[order[i]] > remainders[order[j]]
+	})
+	for _, i := range order[:size-assigned] {
+		shares[i]++
+	}
+
+	return shares[zoneIndex]
+}
+
+// DistributeMinMax distributes the given minimum and maximum over the zones and returns them for the zone with the
+// given index. It ensures that the maximum of a zone is never lower than its minimum.
+func (d *ZoneDistribution) DistributeMinMax(zoneIndex, minimum, maximum int) (int, int) {
+	zoneMinimum, zoneMaximum := d.Distribute(zoneIndex, minimum), d.Distribute(zoneIndex, maximum)
+	if zoneMaximum < zoneMinimum {
+		zoneMaximum = zoneMinimum
+	}
+	return zoneMinimum, zoneMaximum
+}
+
+// DistributePositiveIntOrPercent distributes a given int or percentage value over the zones in relation to the given
+// total value. In case the share of the zone of the total value is integral the percentage is returned unchanged.
+// Otherwise, the percentage is adapted to the ratio between the actual and the exact share of the zone.
+func (d *ZoneDistribution) DistributePositiveIntOrPercent(zoneIndex int, intOrPercent intstr.IntOrString, total int) intstr.IntOrString {
+	if intOrPercent.Type != intstr.String {
+		return intstr.FromInt(d.Distribute(zoneIndex, int(intOrPercent.IntVal)))
+	}
+
+	percent := intOrPercent.StrVal
+	percents, err := strconv.Atoi(percent[:len(percent)-1])
+	if err != nil {
+		panic(fmt.Sprintf("given value %q is not a percent value", percent))
+	}
+
+	if zoneIndex < 0 || zoneIndex >= len(d.weights) || total*d.weights[zoneIndex]%d.totalWeight == 0 {
+		return intstr.FromString(percent)
+	}
+
+	var (
+		zoneTotal = d.Distribute(zoneIndex, total)
+		exact     = float64(total*d.weights[zoneIndex]) / float64(d.totalWeight)
+	)
+	// Optimistic rounding up, this will cause an actual max surge / max unavailable percentage to be a bit higher.
+	return intstr.FromString(fmt.Sprintf("%d%%", int(math.Ceil(float64(zoneTotal)/exact*float64(percents)))))
+}
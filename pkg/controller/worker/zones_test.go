@@ -0,0 +1,106 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/worker"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Zones", func() {
+	var zones = []string{"zone-a", "zone-b", "zone-c"}
+
+	DescribeTable("#ExcludedZonesFromAnnotations",
+		func(annotations map[string]string, expectation sets.String) {
+			Expect(worker.ExcludedZonesFromAnnotations(annotations)).To(Equal(expectation))
+		},
+
+		Entry("no annotations", nil, sets.NewString()),
+		Entry("empty annotation", map[string]string{worker.AnnotationExcludedZones: ""}, sets.NewString()),
+		Entry("single zone", map[string]string{worker.AnnotationExcludedZones: "zone-a"}, sets.NewString("zone-a")),
+		Entry("multiple zones", map[string]string{worker.AnnotationExcludedZones: "zone-a, zone-b,"}, sets.NewString("zone-a", "zone-b")),
+	)
+
+	Describe("#ZoneDistribution", func() {
+		It("should distribute like DistributeOverZones without weights and excluded zones", func() {
+			for zoneSize := 1; zoneSize <= len(zones); zoneSize++ {
+				distribution := worker.NewZoneDistribution(zones[:zoneSize], nil, nil)
+
+				for size := 0; size <= 10; size++ {
+					for zoneIndex := 0; zoneIndex < zoneSize; zoneIndex++ {
+						description := fmt.Sprintf("zone %d of %d, size %d", zoneIndex, zoneSize, size)
+						Expect(distribution.Distribute(zoneIndex, size)).To(Equal(worker.DistributeOverZones(zoneIndex, size, zoneSize)), description)
+						Expect(distribution.DistributePositiveIntOrPercent(zoneIndex, intstr.FromString("75%"), size)).To(Equal(worker.DistributePositiveIntOrPercent(zoneIndex, intstr.FromString("75%"), zoneSize, size)), description)
+					}
+				}
+			}
+		})
+
+		DescribeTable("#Distribute",
+			func(weights map[string]int, excludedZones sets.String, size int, expectation []int) {
+				distribution := worker.NewZoneDistribution(zones, weights, excludedZones)
+
+				var result []int
+				for zoneIndex := range zones {
+					result = append(result, distribution.Distribute(zoneIndex, size))
+				}
+				Expect(result).To(Equal(expectation))
+			},
+
+			Entry("weights", map[string]int{"zone-a": 2, "zone-b": 1, "zone-c": 1}, nil, 8, []int{4, 2, 2}),
+			Entry("weights with remainder", map[string]int{"zone-a": 1, "zone-b": 3}, nil, 6, []int{1, 4, 1}),
+			Entry("excluded zone", nil, sets.NewString("zone-b"), 5, []int{3, 0, 2}),
+			Entry("excluded zone with weights", map[string]int{"zone-a": 3}, sets.NewString("zone-c"), 8, []int{6, 2, 0}),
+			Entry("all zones excluded", nil, sets.NewString(zones...), 5, []int{2, 2, 1}),
+		)
+
+		It("should return zero for unknown zone indices", func() {
+			Expect(worker.NewZoneDistribution(zones, nil, nil).Distribute(3, 5)).To(BeZero())
+		})
+
+		It("should never return a maximum lower than the minimum", func() {
+			distribution := worker.NewZoneDistribution(zones, map[string]int{"zone-a": 1, "zone-b": 1, "zone-c": 2}, nil)
+
+			for minimum := 0; minimum <= 10; minimum++ {
+				for maximum := minimum; maximum <= 10; maximum++ {
+					for zoneIndex := range zones {
+						zoneMinimum, zoneMaximum := distribution.DistributeMinMax(zoneIndex, minimum, maximum)
+						Expect(zoneMaximum).To(BeNumerically(">=", zoneMinimum))
+					}
+				}
+			}
+		})
+
+		DescribeTable("#DistributePositiveIntOrPercent",
+			func(weights map[string]int, excludedZones sets.String, zoneIndex int, intOrPercent intstr.IntOrString, total int, expectation intstr.IntOrString) {
+				Expect(worker.NewZoneDistribution(zones, weights, excludedZones).DistributePositiveIntOrPercent(zoneIndex, intOrPercent, total)).To(Equal(expectation))
+			},
+
+			Entry("positive int with weights", map[string]int{"zone-a": 2}, nil, 0, intstr.FromInt(4), 8, intstr.FromInt(2)),
+			Entry("percent with integral share", map[string]int{"zone-a": 2}, nil, 0, intstr.FromString("50%"), 8, intstr.FromString("50%")),
+			Entry("percent with non-integral share (rounded up)", map[string]int{"zone-a": 2}, nil, 1, intstr.FromString("50%"), 10, intstr.FromString("60%")),
+			Entry("percent with non-integral share (rounded down)", map[string]int{"zone-a": 2}, nil, 2, intstr.FromString("50%"), 10, intstr.FromString("40%")),
+			Entry("percent for excluded zone", nil, sets.NewString("zone-a"), 0, intstr.FromString("50%"), 5, intstr.FromString("50%")),
+		)
+	})
+})
@@ -0,0 +1,250 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// planPodSuffix is the suffix of the name of the Pod that executes the Terraform plan.
+	planPodSuffix = ".tf-plan-pod"
+	// planTimeout is the maximum time to wait for the Terraform plan Pod to complete.
+	planTimeout = 10 * time.Minute
+
+	// 'terraform plan -detailed-exitcode' (as executed by the Terraformer's 'validate' script) exits with 0 if
+	// there are no changes and with 2 if the plan succeeded and there is a diff.
+	planExitCodeNoChanges int32 = 0
+	planExitCodeChanges   int32 = 2
+)
+
+var (
+	ansiEscapeRegexp  = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+	planSummaryRegexp = regexp.MustCompile(`(?m)^\s*(Plan: \d+ to add, \d+ to change, \d+ to destroy\.)`)
+	planChangeRegexp  = regexp.MustCompile(`(?m)^\s*(-/\+|\+/-|<=|\+|-|~)\s+([\w-]+\.[^\s]+)`)
+)
+
+// plan runs 'terraform plan' against the current configuration, variables and state of the Terraformer in a
+// dedicated Pod and returns the parsed result. It expects the Terraformer to be initialized and its service
+// account to exist, i.e. the configuration must have been applied at least once.
+func (t *terraformer) plan(ctx context.Context) (*Plan, error) {
+	ctx, cancel := context.WithTimeout(ctx, planTimeout)
+	defer cancel()
+
+	pod := t.planPod()
+	if err := t.deletePod(ctx, pod); err != nil {
+		return nil, err
+	}
+	if err := t.client.Create(ctx, pod); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := t.deletePod(context.TODO(), pod); err != nil {
+			t.logger.Errorf("Could not delete Terraform plan Pod '%s': %v", pod.Name, err)
+		}
+	}()
+
+	exitCode, err := t.waitForPlanPod(ctx, pod)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := kubernetes.GetPodLogs(t.coreV1Client.Pods(pod.Namespace), pod.Name, &corev1.PodLogOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve the logs of Terraform plan Pod '%s': %v", pod.Name, err)
+	}
+
+	switch exitCode {
+	case planExitCodeNoChanges:
+		return &Plan{}, nil
+	case planExitCodeChanges:
+		return parsePlan(string(logs)), nil
+	default:
+		return nil, fmt.Errorf("Terraform plan Pod '%s' failed with exit code %d", pod.Name, exitCode)
+	}
+}
+
+func (t *terraformer) planPod() *corev1.Pod {
+	var (
+		prefix                        = fmt.Sprintf("%s.%s", t.name, t.purpose)
+		activeDeadlineSeconds         = int64(planTimeout / time.Second)
+		terminationGracePeriodSeconds = int64(30)
+	)
+
+	env := []corev1.EnvVar{
+		{Name: "MAX_BACKOFF_SEC", Value: "60"},
+		{Name: "MAX_TIME_SEC", Value: "1800"},
+		{Name: "TF_STATE_CONFIG_MAP_NAME", Value: prefix + common.TerraformerStateSuffix},
+	}
+	for k, v := range t.variablesEnvironment {
+		env = append(env, corev1.EnvVar{Name: k, Value: v})
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: t.namespace,
+			Name:      prefix + planPodSuffix,
+			Labels: map[string]string{
+				"networking.gardener.cloud/to-dns":              "allowed",
+				"networking.gardener.cloud/to-private-networks": "allowed",
+				"networking.gardener.cloud/to-public-networks":  "allowed",
+				"networking.gardener.cloud/to-seed-apiserver":   "allowed",
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:         corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds: &activeDeadlineSeconds,
+			Containers: []corev1.Container{
+				{
+					Name:            "terraform",
+					Image:           t.image,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					Command:         []string{"sh", "-c", "sh /terraform.sh validate"},
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("50m"),
+							corev1.ResourceMemory: resource.MustParse("200Mi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("200m"),
+							corev1.ResourceMemory: resource.MustParse("512Mi"),
+						},
+					},
+					Env: env,
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "tf", MountPath: "/tf"},
+						{Name: "tfvars", MountPath: "/tfvars"},
+						{Name: "tfstate", MountPath: "/tf-state-in"},
+					},
+				},
+			},
+			ServiceAccountName:            "terraformer",
+			TerminationGracePeriodSeconds: &terminationGracePeriodSeconds,
+			Volumes: []corev1.Volume{
+				{
+					Name: "tf",
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{Name: prefix + common.TerraformerConfigSuffix},
+						},
+					},
+				},
+				{
+					Name: "tfvars",
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: prefix + common.TerraformerVariablesSuffix,
+						},
+					},
+				},
+				{
+					Name: "tfstate",
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{Name: prefix + common.TerraformerStateSuffix},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (t *terraformer) waitForPlanPod(ctx context.Context, pod *corev1.Pod) (int32, error) {
+	var exitCode int32
+
+	if err := wait.PollImmediateUntil(5*time.Second, func() (bool, error) {
+		t.logger.Infof("Waiting for Terraform plan Pod '%s' to be completed...", pod.Name)
+		if err := t.client.Get(ctx, kutil.Key(pod.Namespace, pod.Name), pod); err != nil {
+			return false, err
+		}
+
+		if (pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed) && len(pod.Status.ContainerStatuses) > 0 {
+			if terminated := pod.Status.ContainerStatuses[0].State.Terminated; terminated != nil {
+				exitCode = terminated.ExitCode
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}, ctx.Done()); err != nil {
+		return 0, fmt.Errorf("error waiting for Terraform plan Pod '%s' to be completed: %v", pod.Name, err)
+	}
+
+	return exitCode, nil
+}
+
+func (t *terraformer) deletePod(ctx context.Context, pod *corev1.Pod) error {
+	if err := t.client.Delete(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: pod.Namespace, Name: pod.Name}}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return wait.PollImmediateUntil(time.Second, func() (bool, error) {
+		if err := t.client.Get(ctx, kutil.Key(pod.Namespace, pod.Name), &corev1.Pod{}); err != nil {
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	}, ctx.Done())
+}
+
+// String returns a human-readable description of the plan.
+func (p *Plan) String() string {
+	if !p.HasChanges {
+		return "No changes."
+	}
+
+	var parts []string
+	if len(p.Summary) > 0 {
+		parts = append(parts, p.Summary)
+	}
+	if len(p.Changes) > 0 {
+		parts = append(parts, fmt.Sprintf("Changes: %s", strings.Join(p.Changes, ", ")))
+	}
+	if len(parts) == 0 {
+		return "Unknown changes."
+	}
+	return strings.Join(parts, " ")
+}
+
+// parsePlan parses the output of 'terraform plan' into a Plan.
+func parsePlan(output string) *Plan {
+	output = ansiEscapeRegexp.ReplaceAllString(output, "")
+
+	plan := &Plan{HasChanges: true}
+	for _, match := range planChangeRegexp.FindAllStringSubmatch(output, -1) {
+		plan.Changes = append(plan.Changes, fmt.Sprintf("%s %s", match[1], match[2]))
+	}
+	if match := planSummaryRegexp.FindStringSubmatch(output); match != nil {
+		plan.Summary = match[1]
+	}
+
+	return plan
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan", func() {
+	Describe("#String", func() {
+		It("should describe a plan without changes", func() {
+			Expect((&Plan{}).String()).To(Equal("No changes."))
+		})
+
+		It("should describe a plan with changes", func() {
+			plan := &Plan{
+				HasChanges: true,
+				Changes:    []string{"+ aws_route.public", "~ aws_security_group.nodes"},
+				Summary:    "Plan: 1 to add, 1 to change, 0 to destroy.",
+			}
+
+			Expect(plan.String()).To(Equal("Plan: 1 to add, 1 to change, 0 to destroy. Changes: + aws_route.public, ~ aws_security_group.nodes"))
+		})
+	})
+
+	Describe("#parsePlan", func() {
+		It("should parse the changes and the summary of the plan", func() {
+			output := "Refreshing Terraform state in-memory prior to plan...\n" +
+				"\n" +
+				"An execution plan has been generated and is shown below.\n" +
+				"Resource actions are indicated with the following symbols:\n" +
+				"  \x1b[32m+\x1b[0m create\n" +
+				"  \x1b[33m~\x1b[0m update in-place\n" +
+				"\x1b[31m-\x1b[0m/\x1b[32m+\x1b[0m destroy and then create replacement\n" +
+				"\n" +
+				"Terraform will perform the following actions:\n" +
+				"\n" +
+				"  \x1b[32m+\x1b[0m aws_route.public\n" +
+				"      id:                     <computed>\n" +
+				"  \x1b[33m~\x1b[0m aws_security_group.nodes\n" +
+				"      ingress.#:              \"3\" => \"4\"\n" +
+				"-/+ aws_subnet.nodes_z0 (new resource required)\n" +
+				"\n" +
+				"Plan: 2 to add, 1 to change, 1 to destroy.\n"
+
+			Expect(parsePlan(output)).To(Equal(&Plan{
+				HasChanges: true,
+				Changes: []string{
+					"+ aws_route.public",
+					"~ aws_security_group.nodes",
+					"-/+ aws_subnet.nodes_z0",
+				},
+				Summary: "Plan: 2 to add, 1 to change, 1 to destroy.",
+			}))
+		})
+
+		It("should report changes even if the output cannot be parsed", func() {
+			Expect(parsePlan("foo")).To(Equal(&Plan{HasChanges: true}))
+		})
+	})
+})
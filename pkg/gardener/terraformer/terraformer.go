@@ -15,6 +15,11 @@
 package terraformer
 
 import (
+	"context"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/metrics"
+
 	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/sirupsen/logrus"
 	"k8s.io/client-go/kubernetes/typed/core/v1"
@@ -24,26 +29,55 @@ import (
 
 type terraformer struct {
 	tf *gardenerterraformer.Terraformer
+
+	logger       logrus.FieldLogger
+	client       client.Client
+	coreV1Client v1.CoreV1Interface
+
+	purpose              string
+	namespace            string
+	name                 string
+	image                string
+	variablesEnvironment map[string]string
 }
 
 // SetVariablesEnvironment implements Terraformer.
 func (t *terraformer) SetVariablesEnvironment(tfVarsEnvironment map[string]string) Interface {
-	return &terraformer{t.tf.SetVariablesEnvironment(tfVarsEnvironment)}
+	out := *t
+	out.tf = t.tf.SetVariablesEnvironment(tfVarsEnvironment)
+	out.variablesEnvironment = tfVarsEnvironment
+	return &out
 }
 
 // InitializeWith implements Terraformer.
 func (t *terraformer) InitializeWith(initializer Initializer) Interface {
-	return &terraformer{t.tf.InitializeWith(initializer.Initialize)}
+	out := *t
+	out.tf = t.tf.InitializeWith(initializer.Initialize)
+	return &out
 }
 
 // Apply implements Terraformer.
 func (t *terraformer) Apply() error {
-	return t.tf.Apply()
+	startTime := time.Now()
+	err := t.tf.Apply()
+	metrics.RecordTerraformerRun(t.purpose, "apply", startTime, err)
+	return err
 }
 
 // Destroy implements Terraformer.
 func (t *terraformer) Destroy() error {
-	return t.tf.Destroy()
+	startTime := time.Now()
+	err := t.tf.Destroy()
+	metrics.RecordTerraformerRun(t.purpose, "destroy", startTime, err)
+	return err
+}
+
+// Plan implements Terraformer.
+func (t *terraformer) Plan(ctx context.Context) (*Plan, error) {
+	startTime := time.Now()
+	plan, err := t.plan(ctx)
+	metrics.RecordTerraformerRun(t.purpose, "plan", startTime, err)
+	return plan, err
 }
 
 // GetStateOutputVariables implements Terraformer.
@@ -67,17 +101,33 @@ type factory struct{}
 
 // NewForConfig implements Factory.
 func (factory) NewForConfig(logger logrus.FieldLogger, config *rest.Config, purpose, namespace, name, image string) (Interface, error) {
-	tf, err := gardenerterraformer.NewForConfig(logger, config, purpose, namespace, name, image)
+	c, err := client.New(config, client.Options{})
 	if err != nil {
 		return nil, err
 	}
 
-	return &terraformer{tf}, nil
+	coreV1Client, err := v1.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return factory{}.New(logger, c, coreV1Client, purpose, namespace, name, image), nil
 }
 
 // New implements Factory.
 func (factory) New(logger logrus.FieldLogger, client client.Client, coreV1Client v1.CoreV1Interface, purpose, namespace, name, image string) Interface {
-	return &terraformer{gardenerterraformer.New(logger, client, coreV1Client, purpose, namespace, name, image)}
+	return &terraformer{
+		tf: gardenerterraformer.New(logger, client, coreV1Client, purpose, namespace, name, image),
+
+		logger:       logger,
+		client:       client,
+		coreV1Client: coreV1Client,
+
+		purpose:   purpose,
+		namespace: namespace,
+		name:      name,
+		image:     image,
+	}
 }
 
 // DefaultInitializer implements Factory.
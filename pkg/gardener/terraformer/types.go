@@ -15,6 +15,8 @@
 package terraformer
 
 import (
+	"context"
+
 	gardenerterraformer "github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/sirupsen/logrus"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -28,10 +30,22 @@ type Interface interface {
 	InitializeWith(initializer Initializer) Interface
 	Apply() error
 	Destroy() error
+	Plan(ctx context.Context) (*Plan, error)
 	GetStateOutputVariables(variables ...string) (map[string]string, error)
 	ConfigExists() (bool, error)
 }
 
+// Plan is the result of a Terraform plan.
+type Plan struct {
+	// HasChanges indicates whether applying the Terraform configuration would change any resources.
+	HasChanges bool
+	// Changes are the planned resource changes, each one consisting of the action symbol and the resource address,
+	// e.g. '~ aws_security_group.nodes'.
+	Changes []string
+	// Summary is the summary line of the plan, e.g. 'Plan: 1 to add, 0 to change, 0 to destroy.'.
+	Summary string
+}
+
 // Factory is a factory that can produce Interface and Initializer.
 type Factory interface {
 	NewForConfig(logger logrus.FieldLogger, config *rest.Config, purpose, namespace, name, image string) (Interface, error)
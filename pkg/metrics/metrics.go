@@ -0,0 +1,239 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace = "gardener_extensions"
+
+	labelKind         = "kind"
+	labelType         = "type"
+	labelOperation    = "operation"
+	labelResult       = "result"
+	labelErrorCode    = "error_code"
+	labelPurpose      = "purpose"
+	labelTerraformCmd = "command"
+
+	// ResultSucceeded is the result label value of a successful operation.
+	ResultSucceeded = "succeeded"
+	// ResultError is the result label value of a failed operation.
+	ResultError = "error"
+	// ResultRequeued is the result label value of an operation that is still in progress and was requeued.
+	ResultRequeued = "requeued"
+
+	// ErrorCodeUnknown is the error code label value of errors that do not carry a Gardener error code.
+	ErrorCodeUnknown = "unknown"
+)
+
+var (
+	// OperationDuration is a histogram of the durations of the operations (reconcile, delete) performed by the
+	// actuators of the extension controllers.
+	OperationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "operation_duration_seconds",
+			Help:      "Duration of the operations performed by the actuators of the extension controllers.",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300, 600, 1200, 1800},
+		},
+		[]string{labelKind, labelType, labelOperation, labelResult},
+	)
+
+	// OperationErrors is a counter of the errors returned by the actuators of the extension controllers.
+	OperationErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "operation_errors_total",
+			Help:      "Total number of errors returned by the actuators of the extension controllers.",
+		},
+		[]string{labelKind, labelType, labelOperation, labelErrorCode},
+	)
+
+	// ObjectsInErrorState is a collector of the number of extension objects whose last operation is in state `Error`.
+	// It counts the objects of the kinds added with AddObjectsInErrorStateKind whenever it is scraped.
+	ObjectsInErrorState = &objectsInErrorStateCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "objects_in_error_state"),
+			"Number of extension objects whose last operation is in state Error.",
+			[]string{labelKind, labelType},
+			nil,
+		),
+		kinds: make(map[string]objectsInErrorStateKind),
+	}
+
+	// TerraformerDuration is a histogram of the durations of the terraformer runs.
+	TerraformerDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "terraformer_duration_seconds",
+			Help:      "Duration of the terraformer apply and destroy runs.",
+			Buckets:   []float64{10, 30, 60, 120, 300, 600, 1200, 1800, 3600},
+		},
+		[]string{labelPurpose, labelTerraformCmd, labelResult},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		OperationDuration,
+		OperationErrors,
+		ObjectsInErrorState,
+		TerraformerDuration,
+	)
+}
+
+type objectsInErrorStateKind struct {
+	reader client.Reader
+	list   runtime.Object
+}
+
+// objectsInErrorStateCollector computes the number of extension objects in state `Error` from the objects in the
+// cache, so that objects which are deleted or fixed by others are not counted anymore.
+type objectsInErrorStateCollector struct {
+	desc *prometheus.Desc
+
+	lock  sync.RWMutex
+	kinds map[string]objectsInErrorStateKind
+}
+
+// AddObjectsInErrorStateKind adds the given extension kind to the ObjectsInErrorState collector. Its objects are
+// listed into a copy of <list> with the given reader, typically the cache-backed client of the manager.
+func AddObjectsInErrorStateKind(kind string, reader client.Reader, list runtime.Object) {
+	ObjectsInErrorState.lock.Lock()
+	defer ObjectsInErrorState.lock.Unlock()
+
+	ObjectsInErrorState.kinds[kind] = objectsInErrorStateKind{reader, list}
+}
+
+// Describe implements prometheus.Collector.
+func (c *objectsInErrorStateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect implements prometheus.Collector.
+func (c *objectsInErrorStateCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	for kind, k := range c.kinds {
+		counts, err := countObjectsInErrorState(k.reader, k.list.DeepCopyObject())
+		if err != nil {
+			ch <- prometheus.NewInvalidMetric(c.desc, err)
+			continue
+		}
+
+		for providerType, count := range counts {
+			ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count), kind, providerType)
+		}
+	}
+}
+
+// countObjectsInErrorState lists the extension objects into <list> and counts those whose last operation is in
+// state `Error` by their type. Types without objects in state `Error` are counted with zero.
+func countObjectsInErrorState(reader client.Reader, list runtime.Object) (map[string]int, error) {
+	if err := reader.List(context.TODO(), &client.ListOptions{}, list); err != nil {
+		return nil, err
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int)
+	for _, item := range items {
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(item)
+		if err != nil {
+			return nil, err
+		}
+
+		providerType, _, _ := unstructured.NestedString(obj, "spec", "type")
+		state, _, _ := unstructured.NestedString(obj, "status", "lastOperation", "state")
+
+		if state == string(gardencorev1alpha1.LastOperationStateError) {
+			counts[providerType]++
+		} else if _, ok := counts[providerType]; !ok {
+			counts[providerType] = 0
+		}
+	}
+	return counts, nil
+}
+
+// RecordOperation records the duration and the result of an operation of the given kind and provider type that was
+// started at <start> and returned <err>.
+//
+// A RequeueAfterError without a cause is not considered as failure but as an operation that is still in progress.
+func RecordOperation(kind, providerType string, operation gardencorev1alpha1.LastOperationType, start time.Time, err error) {
+	result := operationResult(err)
+	OperationDuration.WithLabelValues(kind, providerType, string(operation), result).Observe(time.Since(start).Seconds())
+
+	if result == ResultError {
+		for _, code := range errorCodes(err) {
+			OperationErrors.WithLabelValues(kind, providerType, string(operation), code).Inc()
+		}
+	}
+}
+
+// RecordTerraformerRun records the duration and the result of a terraformer run of the given purpose and command
+// (e.g., `apply` or `destroy`) that was started at <start> and returned <err>.
+func RecordTerraformerRun(purpose, command string, start time.Time, err error) {
+	result := ResultSucceeded
+	if err != nil {
+		result = ResultError
+	}
+	TerraformerDuration.WithLabelValues(purpose, command, result).Observe(time.Since(start).Seconds())
+}
+
+func operationResult(err error) string {
+	if err == nil {
+		return ResultSucceeded
+	}
+	if requeueAfter, ok := err.(*controllererror.RequeueAfterError); ok && requeueAfter.Cause == nil {
+		return ResultRequeued
+	}
+	return ResultError
+}
+
+func errorCodes(err error) []string {
+	if requeueAfter, ok := err.(*controllererror.RequeueAfterError); ok {
+		err = requeueAfter.Cause
+	}
+
+	codes := gardencorev1alpha1helper.ExtractErrorCodes(err)
+	if len(codes) == 0 {
+		return []string{ErrorCodeUnknown}
+	}
+
+	out := make([]string, 0, len(codes))
+	for _, code := range codes {
+		out = append(out, string(code))
+	}
+	return out
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	. "github.com/gardener/gardener-extensions/pkg/metrics"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func collectGaugeValues(collector prometheus.Collector) map[string]float64 {
+	ch := make(chan prometheus.Metric, 10)
+	collector.Collect(ch)
+	close(ch)
+
+	values := make(map[string]float64)
+	for m := range ch {
+		metric := &dto.Metric{}
+		Expect(m.Write(metric)).To(Succeed())
+
+		var labels []string
+		for _, label := range metric.GetLabel() {
+			labels = append(labels, label.GetValue())
+		}
+		values[strings.Join(labels, "/")] = metric.GetGauge().GetValue()
+	}
+	return values
+}
+
+func counterValue(counter prometheus.Counter) float64 {
+	metric := &dto.Metric{}
+	Expect(counter.Write(metric)).To(Succeed())
+	return metric.GetCounter().GetValue()
+}
+
+var _ = Describe("Metrics", func() {
+	const (
+		kind         = "Worker"
+		providerType = "test"
+		operation    = gardencorev1alpha1.LastOperationTypeReconcile
+	)
+
+	Describe("#ObjectsInErrorState", func() {
+		It("should count the cached objects in error state by kind and type", func() {
+			scheme := runtime.NewScheme()
+			Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+			worker := func(name, providerType string, state gardencorev1alpha1.LastOperationState) runtime.Object {
+				return &extensionsv1alpha1.Worker{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name},
+					Spec:       extensionsv1alpha1.WorkerSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: providerType}},
+					Status: extensionsv1alpha1.WorkerStatus{DefaultStatus: extensionsv1alpha1.DefaultStatus{
+						LastOperation: &gardencorev1alpha1.LastOperation{State: state},
+					}},
+				}
+			}
+
+			c := fake.NewFakeClientWithScheme(scheme,
+				worker("foo", providerType, gardencorev1alpha1.LastOperationStateError),
+				worker("bar", providerType, gardencorev1alpha1.LastOperationStateError),
+				worker("baz", providerType, gardencorev1alpha1.LastOperationStateSucceeded),
+				worker("qux", "other", gardencorev1alpha1.LastOperationStateSucceeded),
+			)
+			AddObjectsInErrorStateKind(kind, c, &extensionsv1alpha1.WorkerList{})
+
+			Expect(collectGaugeValues(ObjectsInErrorState)).To(Equal(map[string]float64{
+				kind + "/" + providerType: 2,
+				kind + "/other":           0,
+			}))
+
+			Expect(c.Delete(context.TODO(), worker("foo", providerType, ""))).To(Succeed())
+
+			Expect(collectGaugeValues(ObjectsInErrorState)).To(HaveKeyWithValue(kind+"/"+providerType, float64(1)))
+		})
+	})
+
+	Describe("#RecordOperation", func() {
+		It("should count the errors by error code", func() {
+			var (
+				unauthorized = OperationErrors.WithLabelValues(kind, providerType, string(operation), string(gardencorev1alpha1.ErrorInfraUnauthorized))
+				unknown      = OperationErrors.WithLabelValues(kind, providerType, string(operation), ErrorCodeUnknown)
+
+				unauthorizedBefore = counterValue(unauthorized)
+				unknownBefore      = counterValue(unknown)
+			)
+
+			RecordOperation(kind, providerType, operation, time.Now(), &controllererror.RequeueAfterError{
+				Cause:        gardencorev1alpha1helper.DetermineError("AuthFailure: unauthorized"),
+				RequeueAfter: time.Second,
+			})
+			RecordOperation(kind, providerType, operation, time.Now(), errors.New("error"))
+			RecordOperation(kind, providerType, operation, time.Now(), &controllererror.RequeueAfterError{RequeueAfter: time.Second})
+
+			Expect(counterValue(unauthorized)).To(Equal(unauthorizedBefore + 1))
+			Expect(counterValue(unknown)).To(Equal(unknownBefore + 1))
+		})
+	})
+})
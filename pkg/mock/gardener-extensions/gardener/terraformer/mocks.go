@@ -5,6 +5,7 @@
 package terraformer
 
 import (
+	context "context"
 	terraformer "github.com/gardener/gardener-extensions/pkg/gardener/terraformer"
 	terraformer0 "github.com/gardener/gardener/pkg/operation/terraformer"
 	gomock "github.com/golang/mock/gomock"
@@ -114,6 +115,21 @@ func (mr *MockInterfaceMockRecorder) InitializeWith(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitializeWith", reflect.TypeOf((*MockInterface)(nil).InitializeWith), arg0)
 }
 
+// Plan mocks base method
+func (m *MockInterface) Plan(arg0 context.Context) (*terraformer.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", arg0)
+	ret0, _ := ret[0].(*terraformer.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan
+func (mr *MockInterfaceMockRecorder) Plan(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockInterface)(nil).Plan), arg0)
+}
+
 // SetVariablesEnvironment mocks base method
 func (m *MockInterface) SetVariablesEnvironment(arg0 map[string]string) terraformer.Interface {
 	m.ctrl.T.Helper()
@@ -0,0 +1,127 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"net"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// CIDR is a CIDR in string notation together with the field path it has been specified at.
+type CIDR struct {
+	cidr    string
+	fldPath *field.Path
+	ipNet   *net.IPNet
+}
+
+// NewCIDR creates a new CIDR for the given string and field path.
+// If the given string can not be parsed, all checks except ValidateParse are no-ops.
+func NewCIDR(cidr string, fldPath *field.Path) *CIDR {
+	_, ipNet, _ := net.ParseCIDR(cidr)
+	return &CIDR{cidr, fldPath, ipNet}
+}
+
+// GetCIDR returns the CIDR in string notation.
+func (c *CIDR) GetCIDR() string {
+	return c.cidr
+}
+
+// GetFieldPath returns the field path of the CIDR.
+func (c *CIDR) GetFieldPath() *field.Path {
+	return c.fldPath
+}
+
+// GetIPNet returns the parsed network of the CIDR or nil if it could not be parsed.
+func (c *CIDR) GetIPNet() *net.IPNet {
+	return c.ipNet
+}
+
+// ValidateParse returns an error if the CIDR can not be parsed.
+func (c *CIDR) ValidateParse() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if c.ipNet == nil {
+		allErrs = append(allErrs, field.Invalid(c.fldPath, c.cidr, "invalid CIDR address"))
+	}
+
+	return allErrs
+}
+
+// ValidateSubset returns an error for each of the given CIDRs that is not fully contained in this CIDR.
+func (c *CIDR) ValidateSubset(subsets ...*CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if c.ipNet == nil {
+		return allErrs
+	}
+
+	for _, subset := range subsets {
+		if subset == nil || subset.ipNet == nil {
+			continue
+		}
+
+		if !c.ipNet.Contains(subset.ipNet.IP) || !c.ipNet.Contains(lastIP(subset.ipNet)) {
+			allErrs = append(allErrs, field.Invalid(subset.fldPath, subset.cidr, fmt.Sprintf("must be a subset of %q (%s)", c.fldPath.String(), c.cidr)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateNotOverlap returns an error for each of the given CIDRs that overlaps with this CIDR.
+func (c *CIDR) ValidateNotOverlap(others ...*CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if c.ipNet == nil {
+		return allErrs
+	}
+
+	for _, other := range others {
+		if other == nil || other.ipNet == nil {
+			continue
+		}
+
+		if c.ipNet.Contains(other.ipNet.IP) || other.ipNet.Contains(c.ipNet.IP) {
+			allErrs = append(allErrs, field.Invalid(other.fldPath, other.cidr, fmt.Sprintf("must not overlap with %q (%s)", c.fldPath.String(), c.cidr)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateCIDRsNotOverlap returns an error for each pair of the given CIDRs that overlap.
+func ValidateCIDRsNotOverlap(cidrs ...*CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i := range cidrs {
+		if cidrs[i] == nil {
+			continue
+		}
+		allErrs = append(allErrs, cidrs[i].ValidateNotOverlap(cidrs[i+1:]...)...)
+	}
+
+	return allErrs
+}
+
+// lastIP returns the last IP address of the given network.
+func lastIP(ipNet *net.IPNet) net.IP {
+	ip := make(net.IP, len(ipNet.IP))
+	for i := range ipNet.IP {
+		ip[i] = ipNet.IP[i] | ^ipNet.Mask[i]
+	}
+	return ip
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("CIDR", func() {
+	var fldPath = field.NewPath("networks")
+
+	Describe("#ValidateParse", func() {
+		It("should accept a valid CIDR", func() {
+			Expect(NewCIDR("10.0.0.0/16", fldPath).ValidateParse()).To(BeEmpty())
+		})
+
+		It("should reject an invalid CIDR", func() {
+			errorList := NewCIDR("10.0.0.0/33", fldPath).ValidateParse()
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateSubset", func() {
+		It("should accept a subset", func() {
+			vpc := NewCIDR("10.0.0.0/16", fldPath.Child("vpc"))
+
+			Expect(vpc.ValidateSubset(NewCIDR("10.0.1.0/24", fldPath.Child("workers")))).To(BeEmpty())
+		})
+
+		It("should reject a CIDR outside of the network", func() {
+			vpc := NewCIDR("10.0.0.0/16", fldPath.Child("vpc"))
+
+			errorList := vpc.ValidateSubset(NewCIDR("10.1.0.0/24", fldPath.Child("workers")))
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.workers"),
+			}))))
+		})
+
+		It("should reject a CIDR that is larger than the network", func() {
+			vpc := NewCIDR("10.0.0.0/16", fldPath.Child("vpc"))
+
+			errorList := vpc.ValidateSubset(NewCIDR("10.0.0.0/8", fldPath.Child("workers")))
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.workers"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateCIDRsNotOverlap", func() {
+		It("should accept disjoint CIDRs", func() {
+			Expect(ValidateCIDRsNotOverlap(
+				NewCIDR("10.0.0.0/24", fldPath.Index(0)),
+				NewCIDR("10.0.1.0/24", fldPath.Index(1)),
+				NewCIDR("10.0.2.0/23", fldPath.Index(2)),
+			)).To(BeEmpty())
+		})
+
+		It("should reject overlapping CIDRs", func() {
+			errorList := ValidateCIDRsNotOverlap(
+				NewCIDR("10.0.0.0/24", fldPath.Index(0)),
+				NewCIDR("10.0.1.0/24", fldPath.Index(1)),
+				NewCIDR("10.0.0.0/23", fldPath.Index(2)),
+			)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks[2]"),
+				})),
+			))
+		})
+
+		It("should ignore unparseable CIDRs", func() {
+			Expect(ValidateCIDRsNotOverlap(
+				NewCIDR("foo", fldPath.Index(0)),
+				NewCIDR("10.0.0.0/24", fldPath.Index(1)),
+			)).To(BeEmpty())
+		})
+	})
+})
@@ -126,6 +126,17 @@ func (s *ServerBuilder) AddToManager(mgr manager.Manager) error {
 // with the given kind, provider, and name, applicable to objects of all given types,
 // executing the given handler, and bound to the given manager.
 func NewWebhook(mgr manager.Manager, kind Kind, provider, name string, types []runtime.Object, handler admission.Handler) (*admission.Webhook, error) {
+	return newWebhook(mgr, builder.NewWebhookBuilder().Mutating(), kind, provider, name, types, handler)
+}
+
+// NewValidatingWebhook creates a new validating webhook for create and update operations
+// with the given kind, provider, and name, applicable to objects of all given types,
+// executing the given handler, and bound to the given manager.
+func NewValidatingWebhook(mgr manager.Manager, kind Kind, provider, name string, types []runtime.Object, handler admission.Handler) (*admission.Webhook, error) {
+	return newWebhook(mgr, builder.NewWebhookBuilder().Validating(), kind, provider, name, types, handler)
+}
+
+func newWebhook(mgr manager.Manager, b *builder.WebhookBuilder, kind Kind, provider, name string, types []runtime.Object, handler admission.Handler) (*admission.Webhook, error) {
 	// Build namespace selector from the webhook kind and provider
 	namespaceSelector, err := buildSelector(kind, provider)
 	if err != nil {
@@ -143,10 +154,9 @@ func NewWebhook(mgr manager.Manager, kind Kind, provider, name string, types []r
 	}
 
 	// Build webhook
-	return builder.NewWebhookBuilder().
+	return b.
 		Name(name + "." + provider + "." + NameSuffix).
 		Path("/" + name).
-		Mutating().
 		FailurePolicy(admissionregistrationv1beta1.Fail).
 		NamespaceSelector(namespaceSelector).
 		Rules(rules...).
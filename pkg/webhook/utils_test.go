@@ -136,6 +136,39 @@ var _ = Describe("Options", func() {
 			}))
 		})
 	})
+
+	Describe("#NewValidatingWebhook", func() {
+		It("should create the correct validating Shoot webhook for deployments", func() {
+			// Create mock RESTMapper
+			mapper = mockmeta.NewMockRESTMapper(ctrl)
+			mapper.EXPECT().RESTMapping(schema.GroupKind{Group: "apps", Kind: "Deployment"}, "v1").Return(&meta.RESTMapping{
+				Resource: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+			}, nil)
+
+			// Create mock manager
+			mgr = mockmanager.NewMockManager(ctrl)
+			mgr.EXPECT().GetScheme().Return(scheme)
+			mgr.EXPECT().GetRESTMapper().Return(mapper)
+
+			webhook, err := NewValidatingWebhook(mgr, ShootKind, provider, "validation", []runtime.Object{&appsv1.Deployment{}}, handler)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(webhook).To(Equal(&admission.Webhook{
+				Name: "validation.aws.extensions.gardener.cloud",
+				Type: types.WebhookTypeValidating,
+				Path: "/validation",
+				Rules: []admissionregistrationv1beta1.RuleWithOperations{
+					ruleWithOperations("apps", "v1", "deployments"),
+				},
+				FailurePolicy: failurePolicyTypePtr(admissionregistrationv1beta1.Fail),
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: ShootProviderLabel, Operator: metav1.LabelSelectorOpIn, Values: []string{provider}},
+					},
+				},
+				Handlers: []admission.Handler{handler},
+			}))
+		})
+	})
 })
 
 func ruleWithOperations(apiGroup, apiVersion, resource string) admissionregistrationv1beta1.RuleWithOperations {
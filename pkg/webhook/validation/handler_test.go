@@ -0,0 +1,206 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+
+	mockmanager "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/manager"
+	mocktypes "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/webhook/admission/types"
+	mockvalidation "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/webhook/validation"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission/types"
+)
+
+var _ = Describe("Handler", func() {
+	const (
+		name      = "foo"
+		namespace = "default"
+	)
+
+	var (
+		ctrl    *gomock.Controller
+		mgr     *mockmanager.MockManager
+		decoder *mocktypes.MockDecoder
+
+		objTypes = []runtime.Object{&corev1.Service{}}
+		svc      = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		}
+
+		req = types.Request{
+			AdmissionRequest: &admissionv1beta1.AdmissionRequest{
+				Kind:      metav1.GroupVersionKind{Group: "", Version: "v1", Kind: "Service"},
+				Name:      name,
+				Namespace: namespace,
+				Operation: admissionv1beta1.Create,
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		// Build scheme
+		scheme := runtime.NewScheme()
+		_ = corev1.AddToScheme(scheme)
+
+		// Create mock manager
+		mgr = mockmanager.NewMockManager(ctrl)
+		mgr.EXPECT().GetScheme().Return(scheme)
+
+		// Create mock decoder
+		decoder = mocktypes.NewMockDecoder(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#Handle", func() {
+		It("should return an allowing response if the resource is valid", func() {
+			decoder.EXPECT().Decode(req, &corev1.Service{}).DoAndReturn(decoderDecode(svc))
+
+			// Create mock validator
+			validator := mockvalidation.NewMockValidator(ctrl)
+			validator.EXPECT().Validate(context.TODO(), svc, nil).Return(nil)
+
+			// Create handler
+			h, err := newHandler(mgr, objTypes, validator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			h.decoder = decoder
+
+			// Call Handle and check response
+			resp := h.Handle(context.TODO(), req)
+			Expect(resp).To(Equal(types.Response{
+				Response: &admissionv1beta1.AdmissionResponse{
+					Allowed: true,
+				},
+			}))
+		})
+
+		It("should return a denying response if the resource is invalid", func() {
+			decoder.EXPECT().Decode(req, &corev1.Service{}).DoAndReturn(decoderDecode(svc))
+
+			// Create mock validator
+			validator := mockvalidation.NewMockValidator(ctrl)
+			validator.EXPECT().Validate(context.TODO(), svc, nil).Return(errors.New("test error"))
+
+			// Create handler
+			h, err := newHandler(mgr, objTypes, validator, logger)
+			Expect(err).NotTo(HaveOccurred())
+			h.decoder = decoder
+
+			// Call Handle and check response
+			resp := h.Handle(context.TODO(), req)
+			Expect(resp).To(Equal(types.Response{
+				Response: &admissionv1beta1.AdmissionResponse{
+					Allowed: false,
+					Result: &metav1.Status{
+						Reason: "test error",
+					},
+				},
+			}))
+		})
+
+		It("should not validate a resource which is being deleted", func() {
+			deletedSvc := svc.DeepCopy()
+			deletedSvc.DeletionTimestamp = &metav1.Time{}
+			decoder.EXPECT().Decode(req, &corev1.Service{}).DoAndReturn(decoderDecode(deletedSvc))
+
+			// Create handler with a mock validator which must not be called
+			h, err := newHandler(mgr, objTypes, mockvalidation.NewMockValidator(ctrl), logger)
+			Expect(err).NotTo(HaveOccurred())
+			h.decoder = decoder
+
+			// Call Handle and check response
+			resp := h.Handle(context.TODO(), req)
+			Expect(resp.Response.Allowed).To(BeTrue())
+		})
+
+		Context("update", func() {
+			var (
+				updateReq types.Request
+				oldReq    types.Request
+				oldSvc    *corev1.Service
+			)
+
+			BeforeEach(func() {
+				updateReq = types.Request{AdmissionRequest: req.AdmissionRequest.DeepCopy()}
+				updateReq.AdmissionRequest.Operation = admissionv1beta1.Update
+				updateReq.AdmissionRequest.OldObject = runtime.RawExtension{Raw: []byte("old")}
+
+				oldReq = types.Request{AdmissionRequest: updateReq.AdmissionRequest.DeepCopy()}
+				oldReq.AdmissionRequest.Object = updateReq.AdmissionRequest.OldObject
+
+				oldSvc = svc.DeepCopy()
+			})
+
+			It("should not validate the resource if its spec has not changed", func() {
+				newSvc := svc.DeepCopy()
+				newSvc.Labels = map[string]string{"foo": "bar"}
+				decoder.EXPECT().Decode(updateReq, &corev1.Service{}).DoAndReturn(decoderDecode(newSvc))
+				decoder.EXPECT().Decode(oldReq, &corev1.Service{}).DoAndReturn(decoderDecode(oldSvc))
+
+				// Create handler with a mock validator which must not be called
+				h, err := newHandler(mgr, objTypes, mockvalidation.NewMockValidator(ctrl), logger)
+				Expect(err).NotTo(HaveOccurred())
+				h.decoder = decoder
+
+				// Call Handle and check response
+				resp := h.Handle(context.TODO(), updateReq)
+				Expect(resp.Response.Allowed).To(BeTrue())
+			})
+
+			It("should validate the resource if its spec has changed", func() {
+				newSvc := svc.DeepCopy()
+				newSvc.Spec.Type = corev1.ServiceTypeLoadBalancer
+				decoder.EXPECT().Decode(updateReq, &corev1.Service{}).DoAndReturn(decoderDecode(newSvc))
+				decoder.EXPECT().Decode(oldReq, &corev1.Service{}).DoAndReturn(decoderDecode(oldSvc))
+
+				// Create mock validator
+				validator := mockvalidation.NewMockValidator(ctrl)
+				validator.EXPECT().Validate(context.TODO(), newSvc, oldSvc).Return(errors.New("test error"))
+
+				// Create handler
+				h, err := newHandler(mgr, objTypes, validator, logger)
+				Expect(err).NotTo(HaveOccurred())
+				h.decoder = decoder
+
+				// Call Handle and check response
+				resp := h.Handle(context.TODO(), updateReq)
+				Expect(resp.Response.Allowed).To(BeFalse())
+			})
+		})
+	})
+})
+
+func decoderDecode(result runtime.Object) interface{} {
+	return func(ar types.Request, obj runtime.Object) error {
+		switch obj.(type) {
+		case *corev1.Service:
+			*obj.(*corev1.Service) = *result.(*corev1.Service)
+		}
+		return nil
+	}
+}
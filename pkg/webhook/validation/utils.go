@@ -0,0 +1,36 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// DecodeRawExtension decodes the given raw extension into the given object using the given decoder.
+// If the raw extension is nil or cannot be decoded, a field error for the given path is returned.
+func DecodeRawExtension(decoder runtime.Decoder, raw *runtime.RawExtension, into runtime.Object, fldPath *field.Path) *field.Error {
+	if raw == nil {
+		return field.Required(fldPath, "must be set")
+	}
+
+	if _, _, err := decoder.Decode(raw.Raw, nil, into); err != nil {
+		return field.Invalid(fldPath, string(raw.Raw), fmt.Sprintf("could not be decoded: %v", err))
+	}
+
+	return nil
+}
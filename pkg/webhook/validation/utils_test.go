@@ -0,0 +1,71 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/webhook/validation"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("Utils", func() {
+	var (
+		decoder runtime.Decoder
+		fldPath = field.NewPath("spec", "providerConfig")
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+	})
+
+	Describe("#DecodeRawExtension", func() {
+		It("should decode the raw extension", func() {
+			cluster := &extensionsv1alpha1.Cluster{}
+
+			err := DecodeRawExtension(decoder, &runtime.RawExtension{
+				Raw: []byte(`{"apiVersion":"extensions.gardener.cloud/v1alpha1","kind":"Cluster","metadata":{"name":"foo"}}`),
+			}, cluster, fldPath)
+
+			Expect(err).To(BeNil())
+			Expect(cluster.Name).To(Equal("foo"))
+		})
+
+		It("should require the raw extension", func() {
+			err := DecodeRawExtension(decoder, nil, &extensionsv1alpha1.Cluster{}, fldPath)
+
+			Expect(err).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig"),
+			})))
+		})
+
+		It("should return an error if the raw extension cannot be decoded", func() {
+			err := DecodeRawExtension(decoder, &runtime.RawExtension{Raw: []byte(`{"apiVersion":"foo/v1","kind":"Bar"}`)}, &extensionsv1alpha1.Cluster{}, fldPath)
+
+			Expect(err).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig"),
+			})))
+		})
+	})
+})
@@ -0,0 +1,63 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+const (
+	// WebhookName is the webhook name.
+	WebhookName = "validation"
+)
+
+var logger = log.Log.WithName("validation-webhook")
+
+// AddArgs are arguments for adding a validation webhook to a manager.
+type AddArgs struct {
+	// Provider is the provider of this webhook.
+	Provider string
+	// Types is a list of resource types.
+	Types []runtime.Object
+	// Validator is a validator to be used by the admission handler.
+	Validator Validator
+}
+
+// Add creates a new validation webhook and adds it to the given Manager.
+// The webhook is applied to all shoot namespaces that have the Shoot provider label of the given provider.
+func Add(mgr manager.Manager, args AddArgs) (webhook.Webhook, error) {
+	logger := logger.WithValues("provider", args.Provider)
+
+	// Create handler
+	handler, err := newHandler(mgr, args.Types, args.Validator, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create webhook
+	logger.Info("Creating validation webhook", "name", WebhookName)
+	wh, err := extensionswebhook.NewValidatingWebhook(mgr, extensionswebhook.ShootKind, args.Provider, WebhookName, args.Types, handler)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create validation webhook")
+	}
+
+	return wh, nil
+}